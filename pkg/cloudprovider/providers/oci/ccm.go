@@ -24,11 +24,16 @@ import (
 
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/informers"
+	coreinformers "k8s.io/client-go/informers/core/v1"
 	clientset "k8s.io/client-go/kubernetes"
 	listersv1 "k8s.io/client-go/listers/core/v1"
+	metadataclient "k8s.io/client-go/metadata"
+	"k8s.io/client-go/metadata/metadatainformer"
 	"k8s.io/client-go/tools/cache"
 	cloudprovider "k8s.io/cloud-provider"
 
@@ -75,6 +80,9 @@ type CloudProvider struct {
 	metricPusher  *metrics.MetricPusher
 
 	lbLocks *loadBalancerLocks
+	// drainResyncs holds the services with a pending resync of the backends
+	// their load balancers drain.
+	drainResyncs *loadBalancerLocks
 }
 
 func (cp *CloudProvider) InstancesV2() (cloudprovider.InstancesV2, bool) {
@@ -143,6 +151,7 @@ func NewCloudProvider(config *providercfg.Config) (cloudprovider.Interface, erro
 		instanceCache: cache.NewTTLStore(instanceCacheKeyFn, time.Duration(24)*time.Hour),
 		metricPusher:  metricPusher,
 		lbLocks:       NewLoadBalancerLocks(),
+		drainResyncs:  NewLoadBalancerLocks(),
 	}, nil
 }
 
@@ -191,6 +200,12 @@ func (cp *CloudProvider) Initialize(clientBuilder cloudprovider.ControllerClient
 
 	go nodeInfoController.Run(wait.NeverStop)
 
+	if !cp.config.LoadBalancer.Disabled {
+		if err := cp.startTLSSecretController(clientBuilder, serviceInformer); err != nil {
+			utilruntime.HandleError(fmt.Errorf("failed to start TLS secret controller: %v", err))
+		}
+	}
+
 	cp.logger.Info("Waiting for node informer cache to sync")
 	if !cache.WaitForCacheSync(wait.NeverStop, nodeInformer.Informer().HasSynced, serviceInformer.Informer().HasSynced) {
 		utilruntime.HandleError(fmt.Errorf("Timed out waiting for informers to sync"))
@@ -210,6 +225,36 @@ func (cp *CloudProvider) Initialize(clientBuilder cloudprovider.ControllerClient
 	}
 }
 
+// startTLSSecretController starts the TLS secret controller with an informer
+// on the metadata of the secrets, so that the contents of the secrets in the
+// cluster are not cached.
+func (cp *CloudProvider) startTLSSecretController(clientBuilder cloudprovider.ControllerClientBuilder, serviceInformer coreinformers.ServiceInformer) error {
+	restConfig, err := clientBuilder.Config("cloud-controller-manager")
+	if err != nil {
+		return err
+	}
+	metadataClient, err := metadataclient.NewForConfig(restConfig)
+	if err != nil {
+		return err
+	}
+	secretInformer := metadatainformer.NewFilteredMetadataInformer(
+		metadataClient,
+		v1.SchemeGroupVersion.WithResource("secrets"),
+		metav1.NamespaceAll,
+		5*time.Minute,
+		cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+		nil)
+	tlsSecretController := NewTLSSecretController(
+		secretInformer,
+		serviceInformer,
+		cp.kubeclient,
+		cp,
+		cp.logger)
+	go secretInformer.Informer().Run(wait.NeverStop)
+	go tlsSecretController.Run(wait.NeverStop)
+	return nil
+}
+
 // ProviderName returns the cloud-provider ID.
 func (cp *CloudProvider) ProviderName() string {
 	return ProviderName()
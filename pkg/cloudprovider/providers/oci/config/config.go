@@ -66,6 +66,17 @@ const (
 	ManagementModeNone = "None"
 )
 
+const (
+	// IPv4Family denotes IPv4 node addresses in nodeAddressFamilies.
+	IPv4Family = "IPv4"
+	// IPv6Family denotes IPv6 node addresses in nodeAddressFamilies.
+	IPv6Family = "IPv6"
+)
+
+// DefaultNodeAddressFamilies reports the IPv4 addresses of a node ahead of its
+// IPv6 addresses.
+var DefaultNodeAddressFamilies = []string{IPv4Family, IPv6Family}
+
 // LoadBalancerConfig holds the configuration options for OCI load balancers.
 type LoadBalancerConfig struct {
 	// Disabled disables the creation of a load balancer.
@@ -151,6 +162,11 @@ type Config struct {
 	// cluster resides.
 	VCNID string `yaml:"vcn"`
 
+	// NodeAddressFamilies are the IP families whose addresses are reported as
+	// node addresses, in the order they are reported. Kubelet uses the first
+	// InternalIP of a node as its primary address. Defaults to IPv4, IPv6.
+	NodeAddressFamilies []string `yaml:"nodeAddressFamilies"`
+
 	// Metadata service to help fill in certain fields
 	metadataSvc metadata.Interface
 }
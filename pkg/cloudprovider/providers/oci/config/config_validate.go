@@ -70,6 +70,24 @@ func validateLoadBalancerConfig(c *Config, fldPath *field.Path) field.ErrorList
 	return allErrs
 }
 
+// validateNodeAddressFamilies checks that nodeAddressFamilies only lists
+// supported IP families, each at most once.
+func validateNodeAddressFamilies(families []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	seen := sets.NewString()
+	for i, family := range families {
+		if family != IPv4Family && family != IPv6Family {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Index(i), family, []string{IPv4Family, IPv6Family}))
+			continue
+		}
+		if seen.Has(family) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), family))
+		}
+		seen.Insert(family)
+	}
+	return allErrs
+}
+
 // ValidateConfig validates the OCI Cloud Provider config file.
 func ValidateConfig(c *Config) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -83,6 +101,8 @@ func ValidateConfig(c *Config) field.ErrorList {
 		allErrs = append(allErrs, validateLoadBalancerConfig(c, field.NewPath("loadBalancer"))...)
 	}
 
+	allErrs = append(allErrs, validateNodeAddressFamilies(c.NodeAddressFamilies, field.NewPath("nodeAddressFamilies"))...)
+
 	// Validate metric config if the metrics block is not empty
 	if c.Metrics != nil {
 		if len(c.Metrics.CompartmentID) == 0 {
@@ -22,6 +22,7 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/core"
 	"k8s.io/apimachinery/pkg/labels"
 
+	providercfg "github.com/oracle/oci-cloud-controller-manager/pkg/cloudprovider/providers/oci/config"
 	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
 	"github.com/pkg/errors"
 	api "k8s.io/api/core/v1"
@@ -67,6 +68,7 @@ func (cp *CloudProvider) getCompartmentIDByInstanceID(instanceID string) (string
 
 func (cp *CloudProvider) extractNodeAddresses(ctx context.Context, instanceID string) ([]api.NodeAddress, error) {
 	addresses := []api.NodeAddress{}
+	ipv4Addresses := []api.NodeAddress{}
 	compartmentID, err := cp.getCompartmentIDByInstanceID(instanceID)
 	if err != nil {
 		return nil, err
@@ -86,7 +88,7 @@ func (cp *CloudProvider) extractNodeAddresses(ctx context.Context, instanceID st
 		if ip == nil {
 			return nil, fmt.Errorf("instance has invalid private address: %q", *vnic.PrivateIp)
 		}
-		addresses = append(addresses, api.NodeAddress{Type: api.NodeInternalIP, Address: ip.String()})
+		ipv4Addresses = append(ipv4Addresses, api.NodeAddress{Type: api.NodeInternalIP, Address: ip.String()})
 	}
 
 	if vnic.PublicIp != nil && *vnic.PublicIp != "" {
@@ -94,7 +96,24 @@ func (cp *CloudProvider) extractNodeAddresses(ctx context.Context, instanceID st
 		if ip == nil {
 			return nil, errors.Errorf("instance has invalid public address: %q", *vnic.PublicIp)
 		}
-		addresses = append(addresses, api.NodeAddress{Type: api.NodeExternalIP, Address: ip.String()})
+		ipv4Addresses = append(ipv4Addresses, api.NodeAddress{Type: api.NodeExternalIP, Address: ip.String()})
+	}
+
+	families := cp.config.NodeAddressFamilies
+	if len(families) == 0 {
+		families = providercfg.DefaultNodeAddressFamilies
+	}
+	for _, family := range families {
+		switch family {
+		case providercfg.IPv4Family:
+			addresses = append(addresses, ipv4Addresses...)
+		case providercfg.IPv6Family:
+			ipv6Addresses, err := cp.extractIPv6NodeAddresses(ctx, vnic)
+			if err != nil {
+				return nil, err
+			}
+			addresses = append(addresses, ipv6Addresses...)
+		}
 	}
 
 	// Changing this can have wide reaching impact.
@@ -120,6 +139,31 @@ func (cp *CloudProvider) extractNodeAddresses(ctx context.Context, instanceID st
 	return addresses, nil
 }
 
+// extractIPv6NodeAddresses returns the available IPv6 addresses of the VNIC as
+// InternalIP node addresses. VNICs without IPv6 addresses are not listed.
+func (cp *CloudProvider) extractIPv6NodeAddresses(ctx context.Context, vnic *core.Vnic) ([]api.NodeAddress, error) {
+	addresses := []api.NodeAddress{}
+	if len(vnic.Ipv6Addresses) == 0 || vnic.Id == nil {
+		return addresses, nil
+	}
+
+	ipv6s, err := cp.client.Networking().ListIpv6s(ctx, *vnic.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, "ListIpv6s")
+	}
+	for _, ipv6 := range ipv6s {
+		if ipv6.LifecycleState != core.Ipv6LifecycleStateAvailable || ipv6.IpAddress == nil {
+			continue
+		}
+		ip := net.ParseIP(*ipv6.IpAddress)
+		if ip == nil || ip.To4() != nil {
+			return nil, errors.Errorf("instance has invalid IPv6 address: %q", *ipv6.IpAddress)
+		}
+		addresses = append(addresses, api.NodeAddress{Type: api.NodeInternalIP, Address: ip.String()})
+	}
+	return addresses, nil
+}
+
 // NodeAddresses returns the addresses of the specified instance.
 // TODO(roberthbailey): This currently is only used in such a way that it
 // returns the address of the calling instance. We should do a rename to
@@ -80,12 +80,42 @@ var (
 			HostnameLabel: common.String("no-vcn-dns-label"),
 			SubnetId:      common.String("subnetwithnovcndnslabel"),
 		},
+		"dual-stack": {
+			Id:            common.String("ocid1.vnic.dual-stack"),
+			PrivateIp:     common.String("10.0.0.1"),
+			PublicIp:      common.String("0.0.0.1"),
+			Ipv6Addresses: []string{"2001:db8::1", "2001:db8::2"},
+			SubnetId:      common.String("subnetwithdnslabel"),
+		},
+		"invalid-ipv6": {
+			Id:            common.String("ocid1.vnic.invalid-ipv6"),
+			PrivateIp:     common.String("10.0.0.1"),
+			Ipv6Addresses: []string{"10.0.0.2"},
+			SubnetId:      common.String("subnetwithdnslabel"),
+		},
+	}
+
+	vnicIpv6s = map[string][]core.Ipv6{
+		"ocid1.vnic.dual-stack": {
+			{IpAddress: common.String("2001:db8::1"), LifecycleState: core.Ipv6LifecycleStateAvailable},
+			{IpAddress: common.String("2001:db8::2"), LifecycleState: core.Ipv6LifecycleStateAvailable},
+			{IpAddress: common.String("2001:db8::3"), LifecycleState: core.Ipv6LifecycleStateTerminating},
+		},
+		"ocid1.vnic.invalid-ipv6": {
+			{IpAddress: common.String("10.0.0.2"), LifecycleState: core.Ipv6LifecycleStateAvailable},
+		},
 	}
 
 	instances = map[string]*core.Instance{
 		"basic-complete": {
 			CompartmentId: common.String("default"),
 		},
+		"dual-stack": {
+			CompartmentId: common.String("default"),
+		},
+		"invalid-ipv6": {
+			CompartmentId: common.String("default"),
+		},
 		"no-external-ip": {
 			CompartmentId: common.String("default"),
 		},
@@ -123,6 +153,10 @@ var (
 		},
 	}
 	subnets = map[string]*core.Subnet{
+		"reserved-ip-subnet": {
+			Id:        common.String("reserved-ip-subnet"),
+			CidrBlock: common.String("10.0.10.0/24"),
+		},
 		"subnetwithdnslabel": {
 			Id:       common.String("subnetwithdnslabel"),
 			DnsLabel: common.String("subnetwithdnslabel"),
@@ -348,11 +382,11 @@ func (MockComputeClient) FindVolumeAttachment(ctx context.Context, compartmentID
 	return nil, nil
 }
 
-func (MockComputeClient) AttachParavirtualizedVolume(ctx context.Context, instanceID, volumeID string, isPvEncryptionInTransitEnabled bool) (core.VolumeAttachment, error) {
+func (MockComputeClient) AttachParavirtualizedVolume(ctx context.Context, instanceID, volumeID string, isPvEncryptionInTransitEnabled, isReadOnly, isShareable bool) (core.VolumeAttachment, error) {
 	return nil, nil
 }
 
-func (MockComputeClient) AttachVolume(ctx context.Context, instanceID, volumeID string) (core.VolumeAttachment, error) {
+func (MockComputeClient) AttachVolume(ctx context.Context, instanceID, volumeID string, isReadOnly, isShareable bool) (core.VolumeAttachment, error) {
 	return nil, nil
 }
 
@@ -368,7 +402,11 @@ func (MockComputeClient) WaitForVolumeDetached(ctx context.Context, attachmentID
 	return nil
 }
 
-func (c *MockComputeClient) FindActiveVolumeAttachment(ctx context.Context, compartmentID, volumeID string) (core.VolumeAttachment, error) {
+func (c *MockComputeClient) FindActiveVolumeAttachment(ctx context.Context, compartmentID, volumeID, instanceID string) (core.VolumeAttachment, error) {
+	return nil, nil
+}
+
+func (c *MockComputeClient) ListVolumeAttachments(ctx context.Context, compartmentID, volumeID string) ([]core.VolumeAttachment, error) {
 	return nil, nil
 }
 
@@ -393,10 +431,88 @@ func (c *MockBlockStorageClient) GetVolumeBackup(ctx context.Context, id string)
 	}, nil
 }
 
+func (c *MockBlockStorageClient) GetVolumeBackupInRegion(ctx context.Context, id, region string) (*core.VolumeBackup, error) {
+	return &core.VolumeBackup{
+		Id: &id,
+	}, nil
+}
+
 func (c *MockBlockStorageClient) GetVolumeBackupsByName(ctx context.Context, snapshotName, compartmentID string) ([]core.VolumeBackup, error) {
 	return []core.VolumeBackup{}, nil
 }
 
+func (c *MockBlockStorageClient) ListVolumeBackups(ctx context.Context, compartmentID, volumeID string, limit int, page string) ([]core.VolumeBackup, string, error) {
+	return []core.VolumeBackup{}, "", nil
+}
+
+func (c *MockBlockStorageClient) CopyVolumeBackup(ctx context.Context, id, destinationRegion, displayName string) (*core.VolumeBackup, error) {
+	return &core.VolumeBackup{}, nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeBackupCopy(ctx context.Context, sourceVolumeBackup *core.VolumeBackup, region string) (*core.VolumeBackup, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeBackupPolicy(ctx context.Context, id string) (*core.VolumeBackupPolicy, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeBackupPoliciesByName(ctx context.Context, policyName, compartmentID string) ([]core.VolumeBackupPolicy, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) CreateVolumeBackupPolicyAssignment(ctx context.Context, volumeID, policyID string) (*core.VolumeBackupPolicyAssignment, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) DeleteVolumeBackupPolicyAssignment(ctx context.Context, id string) error {
+	return nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeBackupPolicyAssignments(ctx context.Context, volumeID string) ([]core.VolumeBackupPolicyAssignment, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) AwaitVolumeGroupAvailableOrTimeout(ctx context.Context, id string) (*core.VolumeGroup, error) {
+	return &core.VolumeGroup{}, nil
+}
+
+func (c *MockBlockStorageClient) CreateVolumeGroup(ctx context.Context, details core.CreateVolumeGroupDetails) (*core.VolumeGroup, error) {
+	return &core.VolumeGroup{}, nil
+}
+
+func (c *MockBlockStorageClient) DeleteVolumeGroup(ctx context.Context, id string) error {
+	return nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeGroup(ctx context.Context, id string) (*core.VolumeGroup, error) {
+	return &core.VolumeGroup{}, nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeGroupsByName(ctx context.Context, volumeGroupName, compartmentID string) ([]core.VolumeGroup, error) {
+	return []core.VolumeGroup{}, nil
+}
+
+func (c *MockBlockStorageClient) AwaitVolumeGroupBackupAvailableOrTimeout(ctx context.Context, id string) (*core.VolumeGroupBackup, error) {
+	return &core.VolumeGroupBackup{}, nil
+}
+
+func (c *MockBlockStorageClient) CreateVolumeGroupBackup(ctx context.Context, details core.CreateVolumeGroupBackupDetails) (*core.VolumeGroupBackup, error) {
+	return &core.VolumeGroupBackup{}, nil
+}
+
+func (c *MockBlockStorageClient) DeleteVolumeGroupBackup(ctx context.Context, id string) error {
+	return nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeGroupBackup(ctx context.Context, id string) (*core.VolumeGroupBackup, error) {
+	return &core.VolumeGroupBackup{}, nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeGroupBackupsByName(ctx context.Context, groupSnapshotName, compartmentID string) ([]core.VolumeGroupBackup, error) {
+	return []core.VolumeGroupBackup{}, nil
+}
+
 // MockVirtualNetworkClient mocks VirtualNetwork client implementation
 type MockVirtualNetworkClient struct {
 }
@@ -409,6 +525,10 @@ func (c *MockVirtualNetworkClient) GetPrivateIp(ctx context.Context, id string)
 	return nil, nil
 }
 
+func (c *MockVirtualNetworkClient) ListIpv6s(ctx context.Context, vnicId string) ([]core.Ipv6, error) {
+	return vnicIpv6s[vnicId], nil
+}
+
 func (c *MockVirtualNetworkClient) GetSubnet(ctx context.Context, id string) (*core.Subnet, error) {
 	if subnet, ok := subnets[id]; ok {
 		return subnet, nil
@@ -473,6 +593,46 @@ func (c *MockLoadBalancerClient) CreateCertificate(ctx context.Context, lbID str
 	return "", nil
 }
 
+func (c *MockLoadBalancerClient) DeleteCertificate(ctx context.Context, lbID string, name string) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) CreateSSLCipherSuite(ctx context.Context, lbID string, suite *client.GenericSslCipherSuite) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) UpdateSSLCipherSuite(ctx context.Context, lbID string, suite *client.GenericSslCipherSuite) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) DeleteSSLCipherSuite(ctx context.Context, lbID string, name string) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) CreateRuleSet(ctx context.Context, lbID string, ruleSet *client.GenericRuleSet) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) UpdateRuleSet(ctx context.Context, lbID string, ruleSet *client.GenericRuleSet) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) DeleteRuleSet(ctx context.Context, lbID string, name string) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) CreateRoutingPolicy(ctx context.Context, lbID string, policy *client.GenericRoutingPolicy) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) UpdateRoutingPolicy(ctx context.Context, lbID string, policy *client.GenericRoutingPolicy) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) DeleteRoutingPolicy(ctx context.Context, lbID string, name string) (string, error) {
+	return "", nil
+}
+
 func (c *MockLoadBalancerClient) CreateBackendSet(ctx context.Context, lbID string, name string, details *client.GenericBackendSetDetails) (string, error) {
 	return "", nil
 }
@@ -579,6 +739,46 @@ func (c *MockNetworkLoadBalancerClient) CreateCertificate(ctx context.Context, l
 	return "", nil
 }
 
+func (c *MockNetworkLoadBalancerClient) DeleteCertificate(ctx context.Context, lbID string, name string) (string, error) {
+	return "", nil
+}
+
+func (c *MockNetworkLoadBalancerClient) CreateSSLCipherSuite(ctx context.Context, lbID string, suite *client.GenericSslCipherSuite) (string, error) {
+	return "", nil
+}
+
+func (c *MockNetworkLoadBalancerClient) UpdateSSLCipherSuite(ctx context.Context, lbID string, suite *client.GenericSslCipherSuite) (string, error) {
+	return "", nil
+}
+
+func (c *MockNetworkLoadBalancerClient) DeleteSSLCipherSuite(ctx context.Context, lbID string, name string) (string, error) {
+	return "", nil
+}
+
+func (c *MockNetworkLoadBalancerClient) CreateRuleSet(ctx context.Context, lbID string, ruleSet *client.GenericRuleSet) (string, error) {
+	return "", nil
+}
+
+func (c *MockNetworkLoadBalancerClient) UpdateRuleSet(ctx context.Context, lbID string, ruleSet *client.GenericRuleSet) (string, error) {
+	return "", nil
+}
+
+func (c *MockNetworkLoadBalancerClient) DeleteRuleSet(ctx context.Context, lbID string, name string) (string, error) {
+	return "", nil
+}
+
+func (c *MockNetworkLoadBalancerClient) CreateRoutingPolicy(ctx context.Context, lbID string, policy *client.GenericRoutingPolicy) (string, error) {
+	return "", nil
+}
+
+func (c *MockNetworkLoadBalancerClient) UpdateRoutingPolicy(ctx context.Context, lbID string, policy *client.GenericRoutingPolicy) (string, error) {
+	return "", nil
+}
+
+func (c *MockNetworkLoadBalancerClient) DeleteRoutingPolicy(ctx context.Context, lbID string, name string) (string, error) {
+	return "", nil
+}
+
 func (c *MockNetworkLoadBalancerClient) CreateBackendSet(ctx context.Context, lbID string, name string, details *client.GenericBackendSetDetails) (string, error) {
 	return "", nil
 }
@@ -679,6 +879,42 @@ func (c MockFileStorageClient) GetMountTargetSummaryByDisplayName(ctx context.Co
 	return false, nil, nil
 }
 
+func (c MockFileStorageClient) CreateSnapshot(ctx context.Context, details filestorage.CreateSnapshotDetails) (*filestorage.Snapshot, error) {
+	return nil, nil
+}
+
+func (c MockFileStorageClient) GetSnapshot(ctx context.Context, id string) (*filestorage.Snapshot, error) {
+	return nil, nil
+}
+
+func (c MockFileStorageClient) GetSnapshotsByName(ctx context.Context, fileSystemID, name string) ([]filestorage.SnapshotSummary, error) {
+	return nil, nil
+}
+
+func (c MockFileStorageClient) ListSnapshots(ctx context.Context, fileSystemID string, limit int, page string) ([]filestorage.SnapshotSummary, string, error) {
+	return nil, "", nil
+}
+
+func (c MockFileStorageClient) DeleteSnapshot(ctx context.Context, id string) error {
+	return nil
+}
+
+func (c MockFileStorageClient) EnableQuotaRules(ctx context.Context, fileSystemID string) error {
+	return nil
+}
+
+func (c MockFileStorageClient) CreateFileSystemQuotaRule(ctx context.Context, fileSystemID string, limitInGigabytes int) (*filestorage.QuotaRule, error) {
+	return &filestorage.QuotaRule{}, nil
+}
+
+func (c MockFileStorageClient) GetFileSystemQuotaRule(ctx context.Context, fileSystemID string) (*filestorage.QuotaRuleSummary, error) {
+	return nil, nil
+}
+
+func (c MockFileStorageClient) UpdateQuotaRule(ctx context.Context, fileSystemID, quotaRuleID string, limitInGigabytes int) (*filestorage.QuotaRule, error) {
+	return &filestorage.QuotaRule{}, nil
+}
+
 func (MockFileStorageClient) AwaitMountTargetActive(ctx context.Context, logger *zap.SugaredLogger, id string) (*filestorage.MountTarget, error) {
 	return nil, nil
 }
@@ -773,10 +1009,11 @@ func (m mockInstanceCache) Resync() error {
 
 func TestExtractNodeAddresses(t *testing.T) {
 	testCases := []struct {
-		name string
-		in   string
-		out  []v1.NodeAddress
-		err  error
+		name     string
+		in       string
+		families []string
+		out      []v1.NodeAddress
+		err      error
 	}{
 		{
 			name: "basic-complete",
@@ -848,17 +1085,55 @@ func TestExtractNodeAddresses(t *testing.T) {
 			},
 			err: nil,
 		},
-	}
-
-	cp := &CloudProvider{
-		client:        MockOCIClient{},
-		config:        &providercfg.Config{CompartmentID: "testCompartment"},
-		NodeLister:    &mockNodeLister{},
-		instanceCache: &mockInstanceCache{},
+		{
+			name: "dual-stack",
+			in:   "dual-stack",
+			out: []v1.NodeAddress{
+				{Type: v1.NodeInternalIP, Address: "10.0.0.1"},
+				{Type: v1.NodeExternalIP, Address: "0.0.0.1"},
+				{Type: v1.NodeInternalIP, Address: "2001:db8::1"},
+				{Type: v1.NodeInternalIP, Address: "2001:db8::2"},
+			},
+			err: nil,
+		},
+		{
+			name:     "dual-stack-ipv6-first",
+			in:       "dual-stack",
+			families: []string{providercfg.IPv6Family, providercfg.IPv4Family},
+			out: []v1.NodeAddress{
+				{Type: v1.NodeInternalIP, Address: "2001:db8::1"},
+				{Type: v1.NodeInternalIP, Address: "2001:db8::2"},
+				{Type: v1.NodeInternalIP, Address: "10.0.0.1"},
+				{Type: v1.NodeExternalIP, Address: "0.0.0.1"},
+			},
+			err: nil,
+		},
+		{
+			name:     "dual-stack-ipv4-only",
+			in:       "dual-stack",
+			families: []string{providercfg.IPv4Family},
+			out: []v1.NodeAddress{
+				{Type: v1.NodeInternalIP, Address: "10.0.0.1"},
+				{Type: v1.NodeExternalIP, Address: "0.0.0.1"},
+			},
+			err: nil,
+		},
+		{
+			name: "invalid-ipv6",
+			in:   "invalid-ipv6",
+			out:  nil,
+			err:  errors.New(`instance has invalid IPv6 address: "10.0.0.2"`),
+		},
 	}
 
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
+			cp := &CloudProvider{
+				client:        MockOCIClient{},
+				config:        &providercfg.Config{CompartmentID: "testCompartment", NodeAddressFamilies: tt.families},
+				NodeLister:    &mockNodeLister{},
+				instanceCache: &mockInstanceCache{},
+			}
 			result, err := cp.extractNodeAddresses(context.Background(), tt.in)
 			if err != nil && err.Error() != tt.err.Error() {
 				t.Errorf("extractNodeAddresses(context, %+v) got error %v, expected %v", tt.in, err, tt.err)
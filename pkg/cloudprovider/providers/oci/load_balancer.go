@@ -17,8 +17,10 @@ package oci
 import (
 	"context"
 	"fmt"
+	"net"
 	"reflect"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -54,6 +56,12 @@ const (
 	NetworkLoadBalancingPolicyFiveTuple  = "FIVE_TUPLE"
 )
 
+// Defines the cookie based session persistence supported for load balancers.
+const (
+	SessionPersistenceLBCookie  = "lb-cookie"
+	SessionPersistenceAppCookie = "app-cookie"
+)
+
 var LbOperationAlreadyExists = errors.New("An operation for the service is already in progress.")
 
 // DefaultLoadBalancerBEProtocol defines the default protocol for load
@@ -110,6 +118,9 @@ type CloudLoadBalancerProvider struct {
 	logger       *zap.SugaredLogger
 	metricPusher *metrics.MetricPusher
 	config       *providercfg.Config
+	// resyncBackendsAfter updates the backends of the load balancer of a
+	// service once the delay has passed.
+	resyncBackendsAfter func(service *v1.Service, delay time.Duration)
 }
 
 func (cp *CloudProvider) getLoadBalancerProvider(ctx context.Context, svc *v1.Service) (CloudLoadBalancerProvider, error) {
@@ -135,11 +146,12 @@ func (cp *CloudProvider) getLoadBalancerProvider(ctx context.Context, svc *v1.Se
 		return CloudLoadBalancerProvider{}, errors.New(fmt.Sprintf("Error creating Workload Identity based %s Client. Perhaps you are using an OKE BASIC_CLUSTER?", lbType))
 	}
 	return CloudLoadBalancerProvider{
-		client:       cp.client,
-		lbClient:     lbClient,
-		logger:       cp.logger,
-		metricPusher: cp.metricPusher,
-		config:       cp.config,
+		client:              cp.client,
+		lbClient:            lbClient,
+		logger:              cp.logger,
+		metricPusher:        cp.metricPusher,
+		config:              cp.config,
+		resyncBackendsAfter: cp.resyncLoadBalancerBackendsAfter,
 	}, nil
 }
 
@@ -236,6 +248,26 @@ func getReservedIpOcidByIpAddress(ctx context.Context, ipAddress string, n clien
 	return publicIp.Id, nil
 }
 
+// validatePrivateIpInSubnets checks that the private IP address assigned to
+// an internal network load balancer is in one of its subnets.
+func validatePrivateIpInSubnets(ctx context.Context, ipAddress string, subnetIDs []string, n client.NetworkingInterface) error {
+	ip := net.ParseIP(ipAddress)
+	for _, subnetID := range subnetIDs {
+		subnet, err := n.GetSubnet(ctx, subnetID)
+		if err != nil {
+			return err
+		}
+		if subnet.CidrBlock == nil {
+			continue
+		}
+		_, cidr, err := net.ParseCIDR(*subnet.CidrBlock)
+		if err == nil && cidr.Contains(ip) {
+			return nil
+		}
+	}
+	return errors.Errorf("private IP %s is not in the subnets of the load balancer", ipAddress)
+}
+
 // getSubnetsForNodes returns the de-duplicated subnets in which the given
 // internal IP addresses reside.
 func getSubnetsForNodes(ctx context.Context, nodes []*v1.Node, client client.Interface) ([]*core.Subnet, error) {
@@ -307,21 +339,41 @@ func (cp *CloudProvider) readSSLSecret(ns, name string) (*certificateData, error
 	if err != nil {
 		return nil, err
 	}
+	return certificateDataFromSecret(secret)
+}
+
+// readCACertificate returns the CA bundle held by the ca.crt key of a secret.
+func (cp *CloudProvider) readCACertificate(ns, name string) ([]byte, error) {
+	secret, err := cp.kubeclient.CoreV1().Secrets(ns).Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	caCert, ok := secret.Data[SSLCAFileName]
+	if !ok {
+		return nil, errors.Errorf("%s not found in secret %s/%s", SSLCAFileName, secret.Namespace, secret.Name)
+	}
+	return caCert, nil
+}
+
+// certificateDataFromSecret extracts the certificate and private key of a
+// Kubernetes TLS Secret.
+func certificateDataFromSecret(secret *v1.Secret) (*certificateData, error) {
 	var ok bool
 	var cacert, cert, key, pass []byte
 	cacert = secret.Data[SSLCAFileName]
 	if cert, ok = secret.Data[SSLCertificateFileName]; !ok {
-		return nil, errors.Errorf("%s not found in secret %s/%s", SSLCertificateFileName, ns, name)
+		return nil, errors.Errorf("%s not found in secret %s/%s", SSLCertificateFileName, secret.Namespace, secret.Name)
 	}
 	if key, ok = secret.Data[SSLPrivateKeyFileName]; !ok {
-		return nil, errors.Errorf("%s not found in secret %s/%s", SSLPrivateKeyFileName, ns, name)
+		return nil, errors.Errorf("%s not found in secret %s/%s", SSLPrivateKeyFileName, secret.Namespace, secret.Name)
 	}
 	pass = secret.Data[SSLPassphrase]
 	return &certificateData{CACert: cacert, PublicCert: cert, PrivateKey: key, Passphrase: pass}, nil
 }
 
 // ensureSSLCertificate creates a OCI SSL certificate to the given load
-// balancer, if it doesn't already exist.
+// balancer, if it doesn't already exist. Certificate names are derived from
+// the secret contents so a renewed secret results in a new certificate.
 func (clb *CloudLoadBalancerProvider) ensureSSLCertificates(ctx context.Context, lb *client.GenericLoadBalancer, spec *LBSpec) error {
 	logger := clb.logger.With("loadBalancerID", *lb.Id)
 	// Get all required certificates
@@ -349,6 +401,316 @@ func (clb *CloudLoadBalancerProvider) ensureSSLCertificates(ctx context.Context,
 	return nil
 }
 
+// ensureSSLCipherSuites creates the custom SSL cipher suites of the spec on
+// the given load balancer, or updates their ciphers if they already exist.
+func (clb *CloudLoadBalancerProvider) ensureSSLCipherSuites(ctx context.Context, lb *client.GenericLoadBalancer, spec *LBSpec) error {
+	suites, err := spec.SSLCipherSuites()
+	if err != nil {
+		return err
+	}
+
+	for _, suite := range suites {
+		logger := clb.logger.With("loadBalancerID", *lb.Id, "cipherSuiteName", *suite.Name)
+		var wrID string
+		if actual, ok := lb.SslCipherSuites[*suite.Name]; !ok {
+			wrID, err = clb.lbClient.CreateSSLCipherSuite(ctx, *lb.Id, &suite)
+		} else if !reflect.DeepEqual(actual.Ciphers, suite.Ciphers) {
+			wrID, err = clb.lbClient.UpdateSSLCipherSuite(ctx, *lb.Id, &suite)
+		} else {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		logger.With("workRequestID", wrID).Info("Await workrequest for SSL cipher suite")
+		if _, err = clb.lbClient.AwaitWorkRequest(ctx, wrID); err != nil {
+			return err
+		}
+		logger.Info("Workrequest for SSL cipher suite succeeded")
+	}
+	return nil
+}
+
+// ensureRuleSets creates the rule sets of the spec on the given load balancer,
+// or updates their rules if they already exist.
+func (clb *CloudLoadBalancerProvider) ensureRuleSets(ctx context.Context, lb *client.GenericLoadBalancer, spec *LBSpec) error {
+	ruleSets, err := spec.RuleSets()
+	if err != nil {
+		return err
+	}
+
+	for _, ruleSet := range ruleSets {
+		logger := clb.logger.With("loadBalancerID", *lb.Id, "ruleSetName", *ruleSet.Name)
+		var wrID string
+		if actual, ok := lb.RuleSets[*ruleSet.Name]; !ok {
+			wrID, err = clb.lbClient.CreateRuleSet(ctx, *lb.Id, &ruleSet)
+		} else if !reflect.DeepEqual(actual.Items, ruleSet.Items) {
+			wrID, err = clb.lbClient.UpdateRuleSet(ctx, *lb.Id, &ruleSet)
+		} else {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		logger.With("workRequestID", wrID).Info("Await workrequest for rule set")
+		if _, err = clb.lbClient.AwaitWorkRequest(ctx, wrID); err != nil {
+			return err
+		}
+		logger.Info("Workrequest for rule set succeeded")
+	}
+	return nil
+}
+
+// deleteUnusedRuleSets deletes the rule sets created by the CCM that are not
+// in the spec. Like certificates, they can only be deleted once the listeners
+// no longer refer to them.
+func (clb *CloudLoadBalancerProvider) deleteUnusedRuleSets(ctx context.Context, lb *client.GenericLoadBalancer, spec *LBSpec) {
+	ruleSets, err := spec.RuleSets()
+	if err != nil {
+		return
+	}
+	for _, name := range getUnusedRuleSets(lb, ruleSets) {
+		logger := clb.logger.With("loadBalancerID", *lb.Id, "ruleSetName", name)
+		wrID, err := clb.lbClient.DeleteRuleSet(ctx, *lb.Id, name)
+		if err != nil {
+			logger.With(zap.Error(err)).Warn("Failed to delete unused rule set")
+			continue
+		}
+		logger.With("workRequestID", wrID).Info("Await workrequest for delete rule set")
+		if _, err = clb.lbClient.AwaitWorkRequest(ctx, wrID); err != nil {
+			logger.With(zap.Error(err)).Warn("Workrequest for rule set delete failed")
+			continue
+		}
+		logger.Info("Workrequest for rule set delete succeeded")
+	}
+}
+
+// getUnusedRuleSets returns the sorted names of the rule sets of the load
+// balancer that carry the CCM name prefix and are not in the given rule sets.
+// Rule sets created in the console or by other tools are left alone.
+func getUnusedRuleSets(lb *client.GenericLoadBalancer, ruleSets map[string]client.GenericRuleSet) []string {
+	unused := sets.NewString()
+	for name := range lb.RuleSets {
+		if _, ok := ruleSets[name]; !ok && strings.HasPrefix(name, ruleSetNamePrefix) {
+			unused.Insert(name)
+		}
+	}
+	return unused.List()
+}
+
+// ensureRoutingPolicies creates the routing policies of the spec on the given
+// load balancer, or updates their rules if they already exist.
+func (clb *CloudLoadBalancerProvider) ensureRoutingPolicies(ctx context.Context, lb *client.GenericLoadBalancer, spec *LBSpec) error {
+	policies, err := spec.RoutingPolicies()
+	if err != nil {
+		return err
+	}
+
+	for _, policy := range policies {
+		logger := clb.logger.With("loadBalancerID", *lb.Id, "routingPolicyName", *policy.Name)
+		var wrID string
+		if actual, ok := lb.RoutingPolicies[*policy.Name]; !ok {
+			wrID, err = clb.lbClient.CreateRoutingPolicy(ctx, *lb.Id, &policy)
+		} else if !reflect.DeepEqual(actual.Rules, policy.Rules) {
+			wrID, err = clb.lbClient.UpdateRoutingPolicy(ctx, *lb.Id, &policy)
+		} else {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		logger.With("workRequestID", wrID).Info("Await workrequest for routing policy")
+		if _, err = clb.lbClient.AwaitWorkRequest(ctx, wrID); err != nil {
+			return err
+		}
+		logger.Info("Workrequest for routing policy succeeded")
+	}
+	return nil
+}
+
+// hasRoutedBackendSets returns whether the backend sets the routing policies
+// of the spec forward to exist on the load balancer.
+func hasRoutedBackendSets(lb *client.GenericLoadBalancer, spec *LBSpec) bool {
+	policies, err := spec.RoutingPolicies()
+	if err != nil {
+		// Let ensureRoutingPolicies surface the error.
+		return true
+	}
+	for _, policy := range policies {
+		for _, rule := range policy.Rules {
+			if _, ok := lb.BackendSets[*rule.BackendSetName]; !ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// withActualRoutingPolicies returns a copy of the spec whose listeners keep
+// the routing policies they currently use on the load balancer.
+func withActualRoutingPolicies(lb *client.GenericLoadBalancer, spec *LBSpec) *LBSpec {
+	s := *spec
+	s.Listeners = make(map[string]client.GenericListener, len(spec.Listeners))
+	for name, listener := range spec.Listeners {
+		listener.RoutingPolicyName = nil
+		if actual, ok := lb.Listeners[name]; ok {
+			listener.RoutingPolicyName = actual.RoutingPolicyName
+		}
+		s.Listeners[name] = listener
+	}
+	return &s
+}
+
+// updateRoutingPolicies applies the routing policies of the spec once the
+// backend sets they forward to have been created, and attaches them to the
+// listeners. It returns the updated load balancer.
+func (clb *CloudLoadBalancerProvider) updateRoutingPolicies(ctx context.Context, lbID string, spec *LBSpec) (*client.GenericLoadBalancer, error) {
+	lb, err := clb.lbClient.GetLoadBalancer(ctx, lbID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get load balancer %q", lbID)
+	}
+	if err = clb.ensureRoutingPolicies(ctx, lb, spec); err != nil {
+		return nil, errors.Wrap(err, "ensuring routing policies")
+	}
+	if err = clb.updateLoadBalancer(ctx, lb, spec); err != nil {
+		return nil, err
+	}
+	return lb, nil
+}
+
+// deleteUnusedRoutingPolicies deletes the routing policies created by the CCM
+// that are not in the spec once the listeners no longer use them.
+func (clb *CloudLoadBalancerProvider) deleteUnusedRoutingPolicies(ctx context.Context, lb *client.GenericLoadBalancer, spec *LBSpec) {
+	policies, err := spec.RoutingPolicies()
+	if err != nil {
+		return
+	}
+	for _, name := range getUnusedRoutingPolicies(lb, policies) {
+		logger := clb.logger.With("loadBalancerID", *lb.Id, "routingPolicyName", name)
+		wrID, err := clb.lbClient.DeleteRoutingPolicy(ctx, *lb.Id, name)
+		if err != nil {
+			logger.With(zap.Error(err)).Warn("Failed to delete unused routing policy")
+			continue
+		}
+		logger.With("workRequestID", wrID).Info("Await workrequest for delete routing policy")
+		if _, err = clb.lbClient.AwaitWorkRequest(ctx, wrID); err != nil {
+			logger.With(zap.Error(err)).Warn("Workrequest for routing policy delete failed")
+			continue
+		}
+		logger.Info("Workrequest for routing policy delete succeeded")
+	}
+}
+
+// getUnusedRoutingPolicies returns the sorted names of the routing policies of
+// the load balancer that carry the CCM name prefix and are not in the given
+// policies. Routing policies created in the console or by other tools are left
+// alone.
+func getUnusedRoutingPolicies(lb *client.GenericLoadBalancer, policies map[string]client.GenericRoutingPolicy) []string {
+	unused := sets.NewString()
+	for name := range lb.RoutingPolicies {
+		if _, ok := policies[name]; !ok && strings.HasPrefix(name, routingPolicyNamePrefix) {
+			unused.Insert(name)
+		}
+	}
+	return unused.List()
+}
+
+// deleteUnusedSSLCertificates deletes the certificates created by the CCM that
+// none of the listeners or backend sets of the spec refer to. Failures are
+// logged and retried on the next sync as the load balancer itself is already
+// up to date.
+func (clb *CloudLoadBalancerProvider) deleteUnusedSSLCertificates(ctx context.Context, lb *client.GenericLoadBalancer, spec *LBSpec) {
+	for _, name := range getUnusedSSLCertificates(lb, spec) {
+		logger := clb.logger.With("loadBalancerID", *lb.Id, "certificateName", name)
+		wrID, err := clb.lbClient.DeleteCertificate(ctx, *lb.Id, name)
+		if err != nil {
+			logger.With(zap.Error(err)).Warn("Failed to delete unused certificate")
+			continue
+		}
+		logger.With("workRequestID", wrID).Info("Await workrequest for delete certificate")
+		if _, err = clb.lbClient.AwaitWorkRequest(ctx, wrID); err != nil {
+			logger.With(zap.Error(err)).Warn("Workrequest for certificate delete failed")
+			continue
+		}
+		logger.Info("Workrequest for certificate delete succeeded")
+	}
+}
+
+// deleteUnusedSSLCipherSuites deletes the custom cipher suites created by the
+// CCM that none of the listeners of the spec use any more. Failures are logged
+// and retried on the next sync.
+func (clb *CloudLoadBalancerProvider) deleteUnusedSSLCipherSuites(ctx context.Context, lb *client.GenericLoadBalancer, spec *LBSpec) {
+	for _, name := range getUnusedSSLCipherSuites(lb, spec) {
+		logger := clb.logger.With("loadBalancerID", *lb.Id, "cipherSuiteName", name)
+		wrID, err := clb.lbClient.DeleteSSLCipherSuite(ctx, *lb.Id, name)
+		if err != nil {
+			logger.With(zap.Error(err)).Warn("Failed to delete unused SSL cipher suite")
+			continue
+		}
+		logger.With("workRequestID", wrID).Info("Await workrequest for delete SSL cipher suite")
+		if _, err = clb.lbClient.AwaitWorkRequest(ctx, wrID); err != nil {
+			logger.With(zap.Error(err)).Warn("Workrequest for SSL cipher suite delete failed")
+			continue
+		}
+		logger.Info("Workrequest for SSL cipher suite delete succeeded")
+	}
+}
+
+// getUnusedSSLCipherSuites returns the sorted names of the cipher suites of the
+// load balancer that carry the CCM name prefix and are not used by the spec.
+func getUnusedSSLCipherSuites(lb *client.GenericLoadBalancer, spec *LBSpec) []string {
+	inUse := sets.NewString()
+	for _, listener := range spec.Listeners {
+		if listener.SslConfiguration != nil && listener.SslConfiguration.CipherSuiteName != nil {
+			inUse.Insert(*listener.SslConfiguration.CipherSuiteName)
+		}
+	}
+
+	unused := sets.NewString()
+	for name := range lb.SslCipherSuites {
+		if strings.HasPrefix(name, sslCipherSuiteNamePrefix) && !inUse.Has(name) {
+			unused.Insert(name)
+		}
+	}
+	return unused.List()
+}
+
+// getUnusedSSLCertificates returns the sorted names of the certificates of the
+// load balancer that are owned by the CCM and are not used by the spec. The CCM
+// owns the certificates that carry its name prefix, and the certificates that
+// earlier releases named after the SSL secrets of the service. Certificates
+// uploaded by users are left alone.
+func getUnusedSSLCertificates(lb *client.GenericLoadBalancer, spec *LBSpec) []string {
+	owned := sets.NewString()
+	if spec.SSLConfig != nil {
+		for _, secretName := range []string{spec.SSLConfig.ListenerSSLSecretName, spec.SSLConfig.BackendSetSSLSecretName} {
+			if secretName != "" {
+				owned.Insert(secretName)
+			}
+		}
+	}
+
+	inUse := sets.NewString()
+	for _, listener := range spec.Listeners {
+		if listener.SslConfiguration != nil && listener.SslConfiguration.CertificateName != nil {
+			inUse.Insert(*listener.SslConfiguration.CertificateName)
+		}
+	}
+	for _, backendSet := range spec.BackendSets {
+		if backendSet.SslConfiguration != nil && backendSet.SslConfiguration.CertificateName != nil {
+			inUse.Insert(*backendSet.SslConfiguration.CertificateName)
+		}
+	}
+
+	unused := sets.NewString()
+	for name := range lb.Certificates {
+		if (strings.HasPrefix(name, sslCertificateNamePrefix) || owned.Has(name)) && !inUse.Has(name) {
+			unused.Insert(name)
+		}
+	}
+	return unused.List()
+}
+
 // createLoadBalancer creates a new OCI load balancer based on the given spec.
 func (clb *CloudLoadBalancerProvider) createLoadBalancer(ctx context.Context, spec *LBSpec) (lbStatus *v1.LoadBalancerStatus, lbOCID string, err error) {
 	logger := clb.logger.With("loadBalancerName", spec.Name, "loadBalancerType", getLoadBalancerType(spec.service))
@@ -370,6 +732,28 @@ func (clb *CloudLoadBalancerProvider) createLoadBalancer(ctx context.Context, sp
 	if err != nil {
 		return nil, "", errors.Wrap(err, "get certificates")
 	}
+	sslCipherSuites, err := spec.SSLCipherSuites()
+	if err != nil {
+		return nil, "", errors.Wrap(err, "get SSL cipher suites")
+	}
+	ruleSets, err := spec.RuleSets()
+	if err != nil {
+		return nil, "", errors.Wrap(err, "get rule sets")
+	}
+	routingPolicies, err := spec.RoutingPolicies()
+	if err != nil {
+		return nil, "", errors.Wrap(err, "get routing policies")
+	}
+	// Routing policies cannot be created with the load balancer, the
+	// listeners are created without them and updated once they exist.
+	listeners := spec.Listeners
+	if len(routingPolicies) != 0 {
+		listeners = make(map[string]client.GenericListener, len(spec.Listeners))
+		for name, listener := range spec.Listeners {
+			listener.RoutingPolicyName = nil
+			listeners[name] = listener
+		}
+	}
 
 	details := client.GenericCreateLoadBalancerDetails{
 		CompartmentId:           &clb.config.CompartmentID,
@@ -378,8 +762,10 @@ func (clb *CloudLoadBalancerProvider) createLoadBalancer(ctx context.Context, sp
 		IsPrivate:               &spec.Internal,
 		SubnetIds:               spec.Subnets,
 		BackendSets:             spec.BackendSets,
-		Listeners:               spec.Listeners,
+		Listeners:               listeners,
 		Certificates:            certs,
+		SslCipherSuites:         sslCipherSuites,
+		RuleSets:                ruleSets,
 		NetworkSecurityGroupIds: spec.NetworkSecurityGroupIds,
 		FreeformTags:            spec.FreeformTags,
 		DefinedTags:             spec.DefinedTags,
@@ -397,7 +783,17 @@ func (clb *CloudLoadBalancerProvider) createLoadBalancer(ctx context.Context, sp
 		}
 	}
 
-	if spec.LoadBalancerIP != "" {
+	if spec.LoadBalancerIP != "" && spec.Internal {
+		// The private IP is assigned by address on creation; internal load
+		// balancers have no reserved private IPs.
+		if spec.Type != NLB {
+			return nil, "", errors.Errorf("a private IP address can only be assigned to an internal network load balancer")
+		}
+		if err = validatePrivateIpInSubnets(ctx, spec.LoadBalancerIP, spec.Subnets, clb.client.Networking()); err != nil {
+			return nil, "", err
+		}
+		details.AssignedPrivateIpv4 = &spec.LoadBalancerIP
+	} else if spec.LoadBalancerIP != "" {
 		reservedIpOCID, err := getReservedIpOcidByIpAddress(ctx, spec.LoadBalancerIP, clb.client.Networking())
 		if err != nil {
 			return nil, "", err
@@ -432,6 +828,24 @@ func (clb *CloudLoadBalancerProvider) createLoadBalancer(ctx context.Context, sp
 	}
 
 	logger.With("loadBalancerID", *lb.Id).Info("Load balancer created")
+
+	if len(routingPolicies) != 0 {
+		if err = clb.ensureRoutingPolicies(ctx, lb, spec); err != nil {
+			return nil, "", errors.Wrap(err, "ensuring routing policies")
+		}
+		for name, listener := range spec.Listeners {
+			if listener.RoutingPolicyName == nil {
+				continue
+			}
+			wrID, err := clb.lbClient.UpdateListener(ctx, *lb.Id, name, &listener)
+			if err != nil {
+				return nil, "", errors.Wrapf(err, "attaching routing policy to listener %q", name)
+			}
+			if _, err = clb.lbClient.AwaitWorkRequest(ctx, wrID); err != nil {
+				return nil, "", errors.Wrapf(err, "awaiting routing policy of listener %q", name)
+			}
+		}
+	}
 	status, err := loadBalancerToStatus(lb)
 
 	if status != nil && len(status.Ingress) > 0 {
@@ -800,6 +1214,44 @@ func (cp *CloudProvider) EnsureLoadBalancer(ctx context.Context, clusterName str
 
 			return nil, errors.Wrap(err, "ensuring ssl certificates")
 		}
+		if err := lbProvider.ensureSSLCipherSuites(ctx, lb, spec); err != nil {
+			logger.With(zap.Error(err)).Error("Failed to ensure ssl cipher suites")
+			errorType = util.GetError(err)
+			lbMetricDimension = util.GetMetricDimensionForComponent(errorType, util.LoadBalancerType)
+			dimensionsMap[metrics.ComponentDimension] = lbMetricDimension
+			metrics.SendMetricData(cp.metricPusher, getMetric(loadBalancerType, Update), time.Since(startTime).Seconds(), dimensionsMap)
+
+			return nil, errors.Wrap(err, "ensuring ssl cipher suites")
+		}
+	}
+
+	// Rule sets and routing policies have to exist before listeners can
+	// refer to them.
+	var deferRoutingPolicies bool
+	if loadBalancerType == LB {
+		if err := lbProvider.ensureRuleSets(ctx, lb, spec); err != nil {
+			logger.With(zap.Error(err)).Error("Failed to ensure rule sets")
+			errorType = util.GetError(err)
+			lbMetricDimension = util.GetMetricDimensionForComponent(errorType, util.LoadBalancerType)
+			dimensionsMap[metrics.ComponentDimension] = lbMetricDimension
+			metrics.SendMetricData(cp.metricPusher, getMetric(loadBalancerType, Update), time.Since(startTime).Seconds(), dimensionsMap)
+
+			return nil, errors.Wrap(err, "ensuring rule sets")
+		}
+		// Routing rules can only forward to existing backend sets, so routes
+		// to new service ports are applied after the load balancer update.
+		deferRoutingPolicies = !hasRoutedBackendSets(lb, spec)
+		if !deferRoutingPolicies {
+			if err := lbProvider.ensureRoutingPolicies(ctx, lb, spec); err != nil {
+				logger.With(zap.Error(err)).Error("Failed to ensure routing policies")
+				errorType = util.GetError(err)
+				lbMetricDimension = util.GetMetricDimensionForComponent(errorType, util.LoadBalancerType)
+				dimensionsMap[metrics.ComponentDimension] = lbMetricDimension
+				metrics.SendMetricData(cp.metricPusher, getMetric(loadBalancerType, Update), time.Since(startTime).Seconds(), dimensionsMap)
+
+				return nil, errors.Wrap(err, "ensuring routing policies")
+			}
+		}
 	}
 
 	// If network partition, do not proceed
@@ -810,7 +1262,11 @@ func (cp *CloudProvider) EnsureLoadBalancer(ctx context.Context, clusterName str
 		return nil, nil
 	}
 
-	if err := lbProvider.updateLoadBalancer(ctx, lb, spec); err != nil {
+	updateSpec := spec
+	if deferRoutingPolicies {
+		updateSpec = withActualRoutingPolicies(lb, spec)
+	}
+	if err := lbProvider.updateLoadBalancer(ctx, lb, updateSpec); err != nil {
 		errorType = util.GetError(err)
 		lbMetricDimension = util.GetMetricDimensionForComponent(errorType, util.LoadBalancerType)
 		logger.With(zap.Error(err)).Error("Failed to update LoadBalancer")
@@ -818,6 +1274,26 @@ func (cp *CloudProvider) EnsureLoadBalancer(ctx context.Context, clusterName str
 		metrics.SendMetricData(cp.metricPusher, getMetric(loadBalancerType, Update), time.Since(startTime).Seconds(), dimensionsMap)
 		return nil, err
 	}
+	if deferRoutingPolicies {
+		if lb, err = lbProvider.updateRoutingPolicies(ctx, *lb.Id, spec); err != nil {
+			errorType = util.GetError(err)
+			lbMetricDimension = util.GetMetricDimensionForComponent(errorType, util.LoadBalancerType)
+			logger.With(zap.Error(err)).Error("Failed to update routing policies")
+			dimensionsMap[metrics.ComponentDimension] = lbMetricDimension
+			metrics.SendMetricData(cp.metricPusher, getMetric(loadBalancerType, Update), time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, err
+		}
+	}
+
+	// Listeners and backend sets no longer use the certificates of rotated
+	// or removed secrets, nor removed cipher suites, rule sets and routing
+	// policies, once the load balancer has been updated.
+	if loadBalancerType == LB {
+		lbProvider.deleteUnusedSSLCertificates(ctx, lb, spec)
+		lbProvider.deleteUnusedSSLCipherSuites(ctx, lb, spec)
+		lbProvider.deleteUnusedRuleSets(ctx, lb, spec)
+		lbProvider.deleteUnusedRoutingPolicies(ctx, lb, spec)
+	}
 
 	syncTime := time.Since(startTime).Seconds()
 	logger.Info("Successfully updated loadbalancer")
@@ -917,15 +1393,23 @@ func (clb *CloudLoadBalancerProvider) updateLoadBalancer(ctx context.Context, lb
 	start := time.Now()
 	logger := clb.logger.With("loadBalancerID", lbID, "compartmentID", clb.config.CompartmentID, "loadBalancerType", getLoadBalancerType(spec.service), "serviceName", spec.service.Name)
 
-	var actualPublicReservedIP *string
+	var actualReservedIP *string
 
-	//identify the public reserved IP in IP addresses list
+	//identify the public reserved IP, or the assigned private IP of an
+	//internal load balancer, in IP addresses list
 	for _, ip := range lb.IpAddresses {
-		if ip.IpAddress == nil {
+		if ip.IpAddress == nil || ip.IsPublic == nil {
 			continue // should never happen but appears to when EnsureLoadBalancer is called with 0 nodes.
 		}
+		if spec.Internal {
+			if !*ip.IsPublic && *ip.IpAddress == spec.LoadBalancerIP {
+				actualReservedIP = ip.IpAddress
+				break
+			}
+			continue
+		}
 		if ip.ReservedIp != nil && *ip.IsPublic {
-			actualPublicReservedIP = ip.IpAddress
+			actualReservedIP = ip.IpAddress
 			break
 		}
 	}
@@ -958,6 +1442,7 @@ func (clb *CloudLoadBalancerProvider) updateLoadBalancer(ctx context.Context, lb
 			return err
 		}
 	}
+	backendSetActions = clb.drainRemovedBackends(ctx, lb, spec, backendSetActions)
 	actions := sortAndCombineActions(logger, backendSetActions, listenerActions)
 	for _, action := range actions {
 		switch a := action.(type) {
@@ -985,7 +1470,6 @@ func (clb *CloudLoadBalancerProvider) updateLoadBalancer(ctx context.Context, lb
 			}
 		}
 	}
-
 	// Check if the customer managed LB NSGs have changed
 	nsgChanged := hasLoadBalancerNetworkSecurityGroupsChanged(ctx, lb.NetworkSecurityGroupIds, spec.NetworkSecurityGroupIds)
 	if nsgChanged {
@@ -1008,8 +1492,8 @@ func (clb *CloudLoadBalancerProvider) updateLoadBalancer(ctx context.Context, lb
 	}
 
 	// Check if the reservedIP has changed in spec
-	if spec.LoadBalancerIP != "" || actualPublicReservedIP != nil {
-		if actualPublicReservedIP == nil || *actualPublicReservedIP != spec.LoadBalancerIP {
+	if spec.LoadBalancerIP != "" || actualReservedIP != nil {
+		if actualReservedIP == nil || *actualReservedIP != spec.LoadBalancerIP {
 			return errors.Errorf("The Load Balancer service reserved IP cannot be updated after the Load Balancer is created.")
 		}
 	}
@@ -1052,6 +1536,7 @@ func (clb *CloudLoadBalancerProvider) updateLoadBalancerBackends(ctx context.Con
 		return errors.Wrap(err, "get subnets for nodes")
 	}
 
+	backendSetActions = clb.drainRemovedBackends(ctx, lb, spec, backendSetActions)
 	for _, action := range backendSetActions {
 		switch a := action.(type) {
 		case *BackendSetAction:
@@ -1067,6 +1552,75 @@ func (clb *CloudLoadBalancerProvider) updateLoadBalancerBackends(ctx context.Con
 	return nil
 }
 
+// drainRemovedBackends keeps the backends that the backend set updates remove
+// as draining until the drain period of the service has passed, and schedules
+// a resync of the backends for when the next of them can be removed. The drain
+// starts are recorded in the backend drain tag of the load balancer first; if
+// that fails the backends are removed without draining rather than blocking
+// the update. It returns the actions to apply.
+func (clb *CloudLoadBalancerProvider) drainRemovedBackends(ctx context.Context, lb *client.GenericLoadBalancer, spec *LBSpec, actions []Action) []Action {
+	var (
+		now         = time.Now()
+		recorded    = parseBackendDrainTag(lb.FreeformTags[backendDrainTagKey])
+		drainStarts = make(map[string]int64)
+		next        time.Duration
+		result      = make([]Action, 0, len(actions))
+	)
+	for _, action := range actions {
+		a, ok := action.(*BackendSetAction)
+		if !ok || a.Type() != Update {
+			result = append(result, action)
+			continue
+		}
+		backendSet, remaining := getDrainingBackendSet(lb.BackendSets[a.Name()], a.BackendSet, recorded, drainStarts, spec.BackendDrainPeriod, now)
+		if remaining > 0 && (next == 0 || remaining < next) {
+			next = remaining
+		}
+		// Drop the updates that only remove backends which are draining already.
+		if !hasBackendSetChanged(clb.logger, lb.BackendSets[a.Name()], backendSet) {
+			continue
+		}
+		draining := *a
+		draining.BackendSet = backendSet
+		result = append(result, &draining)
+	}
+
+	logger := clb.logger.With("loadBalancerID", *lb.Id, "serviceName", spec.service.Name)
+	if err := clb.updateBackendDrainTags(ctx, lb, drainStarts); err != nil {
+		logger.With(zap.Error(err)).Warn("Failed to record the drain starts of removed backends, removing them without draining")
+		return actions
+	}
+	if next > 0 && clb.resyncBackendsAfter != nil {
+		logger.With("drainPeriod", spec.BackendDrainPeriod).Infof("Draining removed backends, resyncing backends in %s", next)
+		clb.resyncBackendsAfter(spec.service, next)
+	}
+	return result
+}
+
+// updateBackendDrainTags records the drain starts of the draining nodes in the
+// backend drain tag of the load balancer, so that their backends are removed
+// once the drain period has passed even if the controller restarts in between.
+// The load balancer is only updated when the draining nodes change.
+func (clb *CloudLoadBalancerProvider) updateBackendDrainTags(ctx context.Context, lb *client.GenericLoadBalancer, drainStarts map[string]int64) error {
+	if lb.FreeformTags[backendDrainTagKey] == formatBackendDrainTag(drainStarts) {
+		return nil
+	}
+	tags := getBackendDrainTags(lb.FreeformTags, drainStarts)
+
+	wrID, err := clb.lbClient.UpdateLoadBalancer(ctx, *lb.Id, &client.GenericUpdateLoadBalancerDetails{
+		FreeformTags: tags,
+		DefinedTags:  lb.DefinedTags,
+	})
+	if err != nil {
+		return errors.Wrap(err, "updating backend drain tags")
+	}
+	if _, err = clb.lbClient.AwaitWorkRequest(ctx, wrID); err != nil {
+		return errors.Wrap(err, "awaiting backend drain tags update")
+	}
+	lb.FreeformTags = tags
+	return nil
+}
+
 func updateSecurityListsInCriticalSection(ctx context.Context, spec *LBSpec, lbSubnets, nodeSubnets []*core.Subnet) (err error) {
 	updateRulesMutex.Lock()
 	defer updateRulesMutex.Unlock()
@@ -1367,6 +1921,87 @@ func (cp *CloudProvider) getNodesByIPs(backendIPs []string) ([]*v1.Node, error)
 	return nodes, nil
 }
 
+// getLoadBalancerNodes returns the nodes the service controller passes to
+// EnsureLoadBalancer: all nodes that are not being deleted, excluded from
+// load balancers or tainted for removal by the cluster autoscaler.
+func (cp *CloudProvider) getLoadBalancerNodes() ([]*v1.Node, error) {
+	nodeList, err := cp.NodeLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []*v1.Node
+	for _, node := range nodeList {
+		if !node.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if _, excluded := node.Labels[v1.LabelNodeExcludeBalancers]; excluded {
+			continue
+		}
+		tainted := false
+		for _, taint := range node.Spec.Taints {
+			if taint.Key == toBeDeletedTaint {
+				tainted = true
+				break
+			}
+		}
+		if !tainted {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes, nil
+}
+
+// resyncLoadBalancerBackendsAfter updates the backends of the load balancer
+// of the service once the delay has passed, so that the backends it drains are
+// removed when their drain period is over. Only one resync is pending per
+// service; the update schedules the next one while backends are draining.
+func (cp *CloudProvider) resyncLoadBalancerBackendsAfter(service *v1.Service, delay time.Duration) {
+	key := fmt.Sprintf("%s/%s", service.Namespace, service.Name)
+	if cp.drainResyncs == nil || !cp.drainResyncs.TryAcquire(key) {
+		return
+	}
+	time.AfterFunc(delay, func() {
+		cp.drainResyncs.Release(key)
+		logger := cp.logger.With("service", key)
+
+		ctx := context.Background()
+		svc, err := cp.kubeclient.CoreV1().Services(service.Namespace).Get(ctx, service.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return
+		}
+		if err == nil {
+			if svc.DeletionTimestamp != nil || len(svc.Status.LoadBalancer.Ingress) == 0 {
+				return
+			}
+			var nodes []*v1.Node
+			if nodes, err = cp.getLoadBalancerNodes(); err == nil {
+				err = cp.UpdateLoadBalancer(ctx, "", svc, nodes)
+			}
+		}
+		if err != nil {
+			logger.With(zap.Error(err)).Warn("Failed to resync the backends of draining load balancer, retrying")
+			cp.resyncLoadBalancerBackendsAfter(service, drainResyncRetryPeriod)
+		}
+	})
+}
+
+// resyncLoadBalancer updates the load balancer of the service like the
+// service controller does, for changes the service controller does not watch
+// such as renewed TLS secrets. Load balancers that are not provisioned yet are
+// left to the service controller.
+func (cp *CloudProvider) resyncLoadBalancer(ctx context.Context, service *v1.Service) error {
+	if len(service.Status.LoadBalancer.Ingress) == 0 {
+		return nil
+	}
+	nodes, err := cp.getLoadBalancerNodes()
+	if err != nil {
+		return errors.Wrap(err, "listing load balancer nodes")
+	}
+	_, err = cp.EnsureLoadBalancer(ctx, "", service, nodes)
+	return err
+}
+
 // EnsureLoadBalancerDeleted deletes the specified load balancer if it exists,
 // returning nil if the load balancer specified either didn't exist or was
 // successfully deleted.
@@ -15,16 +15,22 @@
 package oci
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"net"
 	"net/http"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	apiservice "k8s.io/kubernetes/pkg/api/v1/service"
 
 	"github.com/oracle/oci-cloud-controller-manager/pkg/cloudprovider/providers/oci/config"
@@ -47,6 +53,94 @@ const (
 
 const ProtocolTypeMixed = "TCP_AND_UDP"
 
+// sslCertificateHashLength is the number of hex digits of the content hash
+// appended to the name of a load balancer certificate.
+const sslCertificateHashLength = 16
+
+// sslCertificateNamePrefix marks the load balancer certificates created by the
+// CCM, only those are deleted once no listener or backend set uses them.
+const sslCertificateNamePrefix = "oci-ccm-"
+
+// predefinedSSLCipherSuites are the cipher suites OCI provides on every load
+// balancer.
+var predefinedSSLCipherSuites = sets.NewString(
+	"oci-default-ssl-cipher-suite-v1",
+	"oci-modern-ssl-cipher-suite-v1",
+	"oci-compatible-ssl-cipher-suite-v1",
+	"oci-wider-compatible-ssl-cipher-suite-v1",
+	"oci-customized-ssl-cipher-suite",
+	"oci-default-http2-ssl-cipher-suite-v1",
+	"oci-default-http2-tls-13-ssl-cipher-suite-v1",
+	"oci-default-http2-tls-12-13-ssl-cipher-suite-v1",
+	"oci-tls-13-recommended-ssl-cipher-suite-v1",
+	"oci-tls-12-13-wider-ssl-cipher-suite-v1",
+	"oci-tls-11-12-13-wider-ssl-cipher-suite-v1",
+)
+
+// sslProtocols are the TLS versions a load balancer listener can negotiate.
+var sslProtocols = sets.NewString("TLSv1", "TLSv1.1", "TLSv1.2", "TLSv1.3")
+
+// defaultSSLProtocols are the TLS versions OCI enables on a listener that
+// does not set any.
+var defaultSSLProtocols = []string{"TLSv1.2"}
+
+const (
+	// defaultSSLCipherSuiteName is the cipher suite OCI uses on a listener
+	// that does not set one.
+	defaultSSLCipherSuiteName = "oci-default-ssl-cipher-suite-v1"
+	// defaultSSLServerOrderPreference is the server order preference OCI
+	// uses on a listener that does not set one.
+	defaultSSLServerOrderPreference = "ENABLED"
+	// sslCipherSuiteNamePrefix marks the custom cipher suites created by the
+	// CCM, only those are deleted once no listener uses them and only the
+	// listeners using them get the OCI defaults back when the annotations are
+	// removed.
+	sslCipherSuiteNamePrefix = "ccm-"
+)
+
+const (
+	// httpRedirectPort is the port of the HTTP listener that redirects to
+	// HTTPS.
+	httpRedirectPort = 80
+	// ruleSetNamePrefix marks the rule sets created by the CCM, only those
+	// are deleted once no listener uses them.
+	ruleSetNamePrefix = "oci_ccm_"
+	// httpRedirectRuleSetName is the name of the rule set of the HTTP
+	// listener that redirects to HTTPS.
+	httpRedirectRuleSetName = ruleSetNamePrefix + "redirect_http_to_https"
+	// httpHeaderRuleSetName is the name of the rule set that adds and
+	// removes the HTTP headers of every other listener.
+	httpHeaderRuleSetName = ruleSetNamePrefix + "http_headers"
+	// routingPolicyNamePrefix marks the routing policies created by the CCM,
+	// only those are deleted once no listener uses them.
+	routingPolicyNamePrefix = "oci_ccm_"
+	// routingPolicyName is the name of the routing policy of the listeners
+	// built from the routes annotation.
+	routingPolicyName = routingPolicyNamePrefix + "routes"
+)
+
+const (
+	// toBeDeletedTaint is the taint the cluster autoscaler puts on a node
+	// before it deletes it.
+	toBeDeletedTaint = "ToBeDeletedByClusterAutoscaler"
+	// backendDrainTagKey is the freeform tag of a load balancer that records
+	// when the nodes of its removed backends started draining.
+	backendDrainTagKey = "oci-ccm-backend-drain"
+	// maxBackendDrainTagLength is the maximum length of a freeform tag value,
+	// which bounds the number of nodes that can drain at the same time.
+	maxBackendDrainTagLength = 256
+	// maxBackendDrainPeriodSeconds bounds the drain period of the backends
+	// of departing nodes.
+	maxBackendDrainPeriodSeconds = 3600
+	// drainResyncRetryPeriod is how long to wait before retrying a failed
+	// resync of the backends of a draining load balancer.
+	drainResyncRetryPeriod = time.Minute
+)
+
+// defaultListenerVerifyDepth is the depth of the client certificate chain
+// verified by a listener when mutual TLS is enabled without a verify depth.
+const defaultListenerVerifyDepth = 1
+
 const (
 	// ServiceAnnotationLoadBalancerInternal is a service annotation for
 	// specifying that a load balancer should be internal.
@@ -95,6 +189,74 @@ const (
 	// See: https://kubernetes.io/docs/concepts/services-networking/ingress/#tls
 	ServiceAnnotationLoadBalancerTLSBackendSetSecret = "service.beta.kubernetes.io/oci-load-balancer-tls-backendset-secret"
 
+	// ServiceAnnotationLoadBalancerListenerCertificateIds is a Service annotation for
+	// specifying the OCID of the OCI Certificates service certificate to install on the
+	// load balancer listeners which have SSL enabled, instead of a TLS secret.
+	ServiceAnnotationLoadBalancerListenerCertificateIds = "oci.oraclecloud.com/oci-load-balancer-listener-certificate-ids"
+
+	// ServiceAnnotationLoadBalancerListenerTrustedCAIds is a Service annotation for
+	// specifying a comma separated list of OCI Certificates service CA or CA bundle
+	// OCIDs trusted by the load balancer listeners which have SSL enabled.
+	ServiceAnnotationLoadBalancerListenerTrustedCAIds = "oci.oraclecloud.com/oci-load-balancer-listener-trusted-ca-ids"
+
+	// ServiceAnnotationLoadBalancerListenerVerifyPeerCertificate is a Service annotation for
+	// enabling verification of client certificates (mutual TLS) on the load balancer
+	// listeners which have SSL enabled.
+	ServiceAnnotationLoadBalancerListenerVerifyPeerCertificate = "oci.oraclecloud.com/oci-load-balancer-listener-verify-peer-certificate"
+
+	// ServiceAnnotationLoadBalancerListenerVerifyDepth is a Service annotation for
+	// specifying the maximum depth of the client certificate chain verified by the listeners.
+	ServiceAnnotationLoadBalancerListenerVerifyDepth = "oci.oraclecloud.com/oci-load-balancer-listener-verify-depth"
+
+	// ServiceAnnotationLoadBalancerListenerClientCASecret is a Service annotation for
+	// specifying a secret, in the form <namespace>/<secretName>, whose ca.crt holds the
+	// CA bundle the listeners verify client certificates against.
+	ServiceAnnotationLoadBalancerListenerClientCASecret = "oci.oraclecloud.com/oci-load-balancer-listener-client-ca-secret"
+
+	// ServiceAnnotationLoadBalancerSSLCipherSuite is a Service annotation for specifying
+	// the cipher suite of the load balancer listeners which have SSL enabled. It is either
+	// a predefined OCI cipher suite or the name of the custom cipher suite defined by
+	// ServiceAnnotationLoadBalancerSSLCiphers.
+	ServiceAnnotationLoadBalancerSSLCipherSuite = "oci.oraclecloud.com/oci-load-balancer-ssl-cipher-suite"
+
+	// ServiceAnnotationLoadBalancerSSLCiphers is a Service annotation for specifying a
+	// comma separated list of ciphers of a custom cipher suite created on the load balancer.
+	ServiceAnnotationLoadBalancerSSLCiphers = "oci.oraclecloud.com/oci-load-balancer-ssl-ciphers"
+
+	// ServiceAnnotationLoadBalancerSSLProtocols is a Service annotation for specifying a
+	// comma separated list of TLS versions the SSL listeners accept, e.g. "TLSv1.2,TLSv1.3".
+	ServiceAnnotationLoadBalancerSSLProtocols = "oci.oraclecloud.com/oci-load-balancer-ssl-protocols"
+
+	// ServiceAnnotationLoadBalancerSSLServerOrderPreference is a Service annotation for
+	// specifying whether the SSL listeners prefer their own cipher order over the client's.
+	// Valid values are "ENABLED" and "DISABLED".
+	ServiceAnnotationLoadBalancerSSLServerOrderPreference = "oci.oraclecloud.com/oci-load-balancer-ssl-server-order-preference"
+
+	// ServiceAnnotationLoadBalancerRedirectHTTPToHTTPS is a Service annotation for
+	// making the listener on port 80 redirect all requests to the SSL listener.
+	ServiceAnnotationLoadBalancerRedirectHTTPToHTTPS = "oci.oraclecloud.com/oci-load-balancer-redirect-http-to-https"
+
+	// ServiceAnnotationLoadBalancerAddRequestHeaders is a Service annotation for specifying
+	// a comma separated list of "Name:Value" HTTP headers added to requests sent to the backends.
+	ServiceAnnotationLoadBalancerAddRequestHeaders = "oci.oraclecloud.com/oci-load-balancer-add-request-headers"
+
+	// ServiceAnnotationLoadBalancerRemoveRequestHeaders is a Service annotation for specifying
+	// a comma separated list of HTTP headers removed from requests sent to the backends.
+	ServiceAnnotationLoadBalancerRemoveRequestHeaders = "oci.oraclecloud.com/oci-load-balancer-remove-request-headers"
+
+	// ServiceAnnotationLoadBalancerAddResponseHeaders is a Service annotation for specifying
+	// a comma separated list of "Name:Value" HTTP headers added to responses sent to the clients.
+	ServiceAnnotationLoadBalancerAddResponseHeaders = "oci.oraclecloud.com/oci-load-balancer-add-response-headers"
+
+	// ServiceAnnotationLoadBalancerRemoveResponseHeaders is a Service annotation for specifying
+	// a comma separated list of HTTP headers removed from responses sent to the clients.
+	ServiceAnnotationLoadBalancerRemoveResponseHeaders = "oci.oraclecloud.com/oci-load-balancer-remove-response-headers"
+
+	// ServiceAnnotationLoadBalancerRoutes is a Service annotation for specifying a comma
+	// separated list of "[host][/path]=port" routes that forward the requests matching the
+	// host and path prefix to the backend set of the service port.
+	ServiceAnnotationLoadBalancerRoutes = "oci.oraclecloud.com/oci-load-balancer-routes"
+
 	// ServiceAnnotationLoadBalancerConnectionIdleTimeout is the annotation used
 	// on the service to specify the idle connection timeout.
 	ServiceAnnotationLoadBalancerConnectionIdleTimeout = "service.beta.kubernetes.io/oci-load-balancer-connection-idle-timeout"
@@ -120,6 +282,28 @@ const (
 	// returns within this timeout period.
 	ServiceAnnotationLoadBalancerHealthCheckTimeout = "service.beta.kubernetes.io/oci-load-balancer-health-check-timeout"
 
+	// ServiceAnnotationLoadBalancerHealthCheckProtocol is a Service annotation for
+	// specifying the protocol ("HTTP", "HTTPS" or "TCP") of a health check of the application
+	// instead of kube-proxy.
+	ServiceAnnotationLoadBalancerHealthCheckProtocol = "service.beta.kubernetes.io/oci-load-balancer-health-check-protocol"
+
+	// ServiceAnnotationLoadBalancerHealthCheckPath is a Service annotation for
+	// specifying the URL path of an HTTP health check of the application.
+	ServiceAnnotationLoadBalancerHealthCheckPath = "service.beta.kubernetes.io/oci-load-balancer-health-check-path"
+
+	// ServiceAnnotationLoadBalancerHealthCheckPort is a Service annotation for
+	// specifying the backend port of a health check of the application. The port of each
+	// backend is checked if it is not set.
+	ServiceAnnotationLoadBalancerHealthCheckPort = "service.beta.kubernetes.io/oci-load-balancer-health-check-port"
+
+	// ServiceAnnotationLoadBalancerHealthCheckReturnCode is a Service annotation for
+	// specifying the status code an HTTP health check expects.
+	ServiceAnnotationLoadBalancerHealthCheckReturnCode = "service.beta.kubernetes.io/oci-load-balancer-health-check-return-code"
+
+	// ServiceAnnotationLoadBalancerHealthCheckResponseBodyRegex is a Service annotation for
+	// specifying a regular expression the response body of an HTTP health check has to match.
+	ServiceAnnotationLoadBalancerHealthCheckResponseBodyRegex = "service.beta.kubernetes.io/oci-load-balancer-health-check-response-body-regex"
+
 	// ServiceAnnotationLoadBalancerBEProtocol is a Service annotation for specifying the
 	// load balancer listener backend protocol ("TCP", "HTTP").
 	// See: https://docs.cloud.oracle.com/iaas/Content/Balance/Concepts/balanceoverview.htm#concepts
@@ -133,6 +317,18 @@ const (
 	// loadbalancer traffic policy("ROUND_ROBIN", "LEAST_CONNECTION", "IP_HASH")
 	ServiceAnnotationLoadBalancerPolicy = "oci.oraclecloud.com/loadbalancer-policy"
 
+	// ServiceAnnotationLoadBalancerSessionPersistence is a service annotation for specifying
+	// cookie based session persistence ("lb-cookie", "app-cookie") for HTTP listeners
+	ServiceAnnotationLoadBalancerSessionPersistence = "oci.oraclecloud.com/loadbalancer-session-persistence"
+
+	// ServiceAnnotationLoadBalancerSessionPersistenceCookieName is a service annotation for specifying
+	// the name of the cookie used for session persistence. Required for application cookie persistence.
+	ServiceAnnotationLoadBalancerSessionPersistenceCookieName = "oci.oraclecloud.com/loadbalancer-session-persistence-cookie-name"
+
+	// ServiceAnnotationLoadBalancerSessionPersistenceDisableFallback is a service annotation for specifying
+	// whether a request is rejected rather than sent to another backend when its persisted backend is unavailable
+	ServiceAnnotationLoadBalancerSessionPersistenceDisableFallback = "oci.oraclecloud.com/loadbalancer-session-persistence-disable-fallback"
+
 	// ServiceAnnotationLoadBalancerInitialDefinedTagsOverride is a service annotation for specifying
 	// defined tags on the LB
 	ServiceAnnotationLoadBalancerInitialDefinedTagsOverride = "oci.oraclecloud.com/initial-defined-tags-override"
@@ -159,6 +355,10 @@ const (
 	// ServiceAnnotationBackendSecurityRuleManagement is a service annotation to denote management of backend Network Security Group(s)
 	// ingress / egress security rules for a given kubernetes service could be either LB or NLB
 	ServiceAnnotationBackendSecurityRuleManagement = "oci.oraclecloud.com/oci-backend-network-security-group"
+
+	// ServiceAnnotationBackendDrainPeriod is a service annotation for the number of seconds the backends of
+	// departing nodes are left draining before they are removed from the LB/NLB.
+	ServiceAnnotationBackendDrainPeriod = "oci.oraclecloud.com/backend-drain-period"
 )
 
 // NLB specific annotations
@@ -187,6 +387,26 @@ const (
 	// The maximum time, in milliseconds, to wait for a reply to a health check. A health check is successful only if a reply returns within this timeout period.
 	ServiceAnnotationNetworkLoadBalancerHealthCheckTimeout = "oci-network-load-balancer.oraclecloud.com/health-check-timeout"
 
+	// ServiceAnnotationNetworkLoadBalancerHealthCheckProtocol is a Service annotation for
+	// The protocol ("HTTP", "HTTPS" or "TCP") of a health check of the application instead of kube-proxy.
+	ServiceAnnotationNetworkLoadBalancerHealthCheckProtocol = "oci-network-load-balancer.oraclecloud.com/health-check-protocol"
+
+	// ServiceAnnotationNetworkLoadBalancerHealthCheckPath is a Service annotation for
+	// The URL path of an HTTP or HTTPS health check of the application.
+	ServiceAnnotationNetworkLoadBalancerHealthCheckPath = "oci-network-load-balancer.oraclecloud.com/health-check-path"
+
+	// ServiceAnnotationNetworkLoadBalancerHealthCheckPort is a Service annotation for
+	// The backend port of a health check of the application, the port of each backend if not set.
+	ServiceAnnotationNetworkLoadBalancerHealthCheckPort = "oci-network-load-balancer.oraclecloud.com/health-check-port"
+
+	// ServiceAnnotationNetworkLoadBalancerHealthCheckReturnCode is a Service annotation for
+	// The status code an HTTP or HTTPS health check expects.
+	ServiceAnnotationNetworkLoadBalancerHealthCheckReturnCode = "oci-network-load-balancer.oraclecloud.com/health-check-return-code"
+
+	// ServiceAnnotationNetworkLoadBalancerHealthCheckResponseBodyRegex is a Service annotation for
+	// A regular expression the response body of an HTTP or HTTPS health check has to match.
+	ServiceAnnotationNetworkLoadBalancerHealthCheckResponseBodyRegex = "oci-network-load-balancer.oraclecloud.com/health-check-response-body-regex"
+
 	// ServiceAnnotationNetworkLoadBalancerBackendPolicy is a Service annotation for
 	// The network load balancer policy for the backend set.
 	ServiceAnnotationNetworkLoadBalancerBackendPolicy = "oci-network-load-balancer.oraclecloud.com/backend-policy"
@@ -232,8 +452,25 @@ type certificateData struct {
 	Passphrase []byte
 }
 
+// hash returns a digest of the certificate contents.
+func (c *certificateData) hash() string {
+	h := sha256.New()
+	for _, data := range [][]byte{c.CACert, c.PublicCert, c.PrivateKey, c.Passphrase} {
+		h.Write([]byte(strconv.Itoa(len(data))))
+		h.Write(data)
+	}
+	return hex.EncodeToString(h.Sum(nil))[:sslCertificateHashLength]
+}
+
+// sslCertificateName returns the name of the load balancer certificate that
+// holds the given contents of a secret.
+func sslCertificateName(secretName string, cert *certificateData) string {
+	return fmt.Sprintf("%s%s-%s", sslCertificateNamePrefix, secretName, cert.hash())
+}
+
 type sslSecretReader interface {
 	readSSLSecret(ns, name string) (sslSecret *certificateData, err error)
+	readCACertificate(ns, name string) (caCert []byte, err error)
 }
 
 type noopSSLSecretReader struct{}
@@ -242,6 +479,10 @@ func (ssr noopSSLSecretReader) readSSLSecret(ns, name string) (sslSecret *certif
 	return nil, nil
 }
 
+func (ssr noopSSLSecretReader) readCACertificate(ns, name string) (caCert []byte, err error) {
+	return nil, nil
+}
+
 // SSLConfig is a description of a SSL certificate.
 type SSLConfig struct {
 	Ports sets.Int
@@ -252,6 +493,17 @@ type SSLConfig struct {
 	BackendSetSSLSecretName      string
 	BackendSetSSLSecretNamespace string
 
+	// The CA bundle of this secret replaces the CA certificate of the
+	// listener secret when client certificates are verified.
+	ListenerClientCASecretName      string
+	ListenerClientCASecretNamespace string
+	ListenerVerifyPeerCertificate   bool
+
+	// The certificate names are derived from the current contents of the
+	// secrets by resolveCertificateNames.
+	ListenerSSLCertificateName   string
+	BackendSetSSLCertificateName string
+
 	sslSecretReader
 }
 
@@ -286,7 +538,7 @@ func NewSSLConfig(secretListenerString string, secretBackendSetString string, se
 	listenerSecretName, listenerSecretNamespace := getSecretParts(secretListenerString, service)
 	backendSecretName, backendSecretNamespace := getSecretParts(secretBackendSetString, service)
 
-	return &SSLConfig{
+	sslConfig := &SSLConfig{
 		Ports:                        sets.NewInt(ports...),
 		ListenerSSLSecretName:        listenerSecretName,
 		ListenerSSLSecretNamespace:   listenerSecretNamespace,
@@ -294,6 +546,67 @@ func NewSSLConfig(secretListenerString string, secretBackendSetString string, se
 		BackendSetSSLSecretNamespace: backendSecretNamespace,
 		sslSecretReader:              ssr,
 	}
+	if service != nil {
+		sslConfig.ListenerClientCASecretName, sslConfig.ListenerClientCASecretNamespace = getSecretParts(service.Annotations[ServiceAnnotationLoadBalancerListenerClientCASecret], service)
+		// Invalid annotations are reported by validateService.
+		sslConfig.ListenerVerifyPeerCertificate, _, _ = getListenerPeerVerification(service)
+	}
+	return sslConfig
+}
+
+// listenerCertificate reads the listener secret and, if set, replaces its CA
+// certificate with the client CA bundle.
+func (c *SSLConfig) listenerCertificate() (*certificateData, error) {
+	cert, err := c.readSSLSecret(c.ListenerSSLSecretNamespace, c.ListenerSSLSecretName)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading SSL Listener Secret")
+	}
+	if cert == nil {
+		return nil, errors.Errorf("SSL Listener Secret %s/%s not found", c.ListenerSSLSecretNamespace, c.ListenerSSLSecretName)
+	}
+	if c.ListenerClientCASecretName == "" {
+		return cert, nil
+	}
+	caCert, err := c.readCACertificate(c.ListenerClientCASecretNamespace, c.ListenerClientCASecretName)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading client CA Secret")
+	}
+	if len(caCert) == 0 {
+		return nil, errors.Errorf("client CA Secret %s/%s not found", c.ListenerClientCASecretNamespace, c.ListenerClientCASecretName)
+	}
+	withClientCA := *cert
+	withClientCA.CACert = caCert
+	return &withClientCA, nil
+}
+
+// resolveCertificateNames reads the listener and backend set secrets and sets
+// the names of the certificates holding their current contents.
+func (c *SSLConfig) resolveCertificateNames() error {
+	if c == nil {
+		return nil
+	}
+	if c.ListenerSSLSecretName != "" {
+		cert, err := c.listenerCertificate()
+		if err != nil {
+			return err
+		}
+		if c.ListenerVerifyPeerCertificate && len(cert.CACert) == 0 {
+			return errors.Errorf("SSL Listener Secret %s/%s has no %s to verify client certificates against",
+				c.ListenerSSLSecretNamespace, c.ListenerSSLSecretName, SSLCAFileName)
+		}
+		c.ListenerSSLCertificateName = sslCertificateName(c.ListenerSSLSecretName, cert)
+	}
+	if c.BackendSetSSLSecretName != "" {
+		cert, err := c.readSSLSecret(c.BackendSetSSLSecretNamespace, c.BackendSetSSLSecretName)
+		if err != nil {
+			return errors.Wrap(err, "reading SSL Backend Secret")
+		}
+		if cert == nil {
+			return errors.Errorf("SSL Backend Secret %s/%s not found", c.BackendSetSSLSecretNamespace, c.BackendSetSSLSecretName)
+		}
+		c.BackendSetSSLCertificateName = sslCertificateName(c.BackendSetSSLSecretName, cert)
+	}
+	return nil
 }
 
 // LBSpec holds the data required to build a OCI load balancer from a
@@ -319,6 +632,7 @@ type LBSpec struct {
 	FreeformTags                map[string]string
 	DefinedTags                 map[string]map[string]interface{}
 	SystemTags                  map[string]map[string]interface{}
+	BackendDrainPeriod          time.Duration
 
 	service *v1.Service
 	nodes   []*v1.Node
@@ -330,6 +644,10 @@ func NewLBSpec(logger *zap.SugaredLogger, svc *v1.Service, nodes []*v1.Node, sub
 		return nil, errors.Wrap(err, "invalid service")
 	}
 
+	if err := sslConfig.resolveCertificateNames(); err != nil {
+		return nil, err
+	}
+
 	internal, err := isInternalLB(svc)
 	if err != nil {
 		return nil, err
@@ -398,6 +716,11 @@ func NewLBSpec(logger *zap.SugaredLogger, svc *v1.Service, nodes []*v1.Node, sub
 		managedNsg.backendNsgId = backendNsgOcids
 	}
 
+	backendDrainPeriod, err := getBackendDrainPeriod(svc)
+	if err != nil {
+		return nil, err
+	}
+
 	lbType := getLoadBalancerType(svc)
 
 	return &LBSpec{
@@ -423,6 +746,7 @@ func NewLBSpec(logger *zap.SugaredLogger, svc *v1.Service, nodes []*v1.Node, sub
 		FreeformTags:                lbTags.FreeformTags,
 		DefinedTags:                 lbTags.DefinedTags,
 		SystemTags:                  getResourceTrackingSysTagsFromConfig(logger, initialLBTags),
+		BackendDrainPeriod:          backendDrainPeriod,
 	}, nil
 }
 
@@ -529,6 +853,40 @@ func getManagedBackendNSG(svc *v1.Service) ([]string, error) {
 	return backendNsgList, nil
 }
 
+// SSLCipherSuites builds a map of the custom SSL cipher suites required by
+// the listeners.
+func (s *LBSpec) SSLCipherSuites() (map[string]client.GenericSslCipherSuite, error) {
+	suites := make(map[string]client.GenericSslCipherSuite)
+	if s.service == nil {
+		return suites, nil
+	}
+	policy, err := getListenerSSLPolicy(s.service)
+	if err != nil {
+		return nil, err
+	}
+	if policy != nil && policy.cipherSuite != nil {
+		suites[*policy.cipherSuite.Name] = *policy.cipherSuite
+	}
+	return suites, nil
+}
+
+// RuleSets builds a map of the rule sets required by the listeners.
+func (s *LBSpec) RuleSets() (map[string]client.GenericRuleSet, error) {
+	if s.service == nil {
+		return nil, nil
+	}
+	return getRuleSets(s.service)
+}
+
+// RoutingPolicies builds a map of the routing policies required by the
+// listeners.
+func (s *LBSpec) RoutingPolicies() (map[string]client.GenericRoutingPolicy, error) {
+	if s.service == nil {
+		return nil, nil
+	}
+	return getRoutingPolicies(s.service)
+}
+
 // Certificates builds a map of required SSL certificates.
 func (s *LBSpec) Certificates() (map[string]client.GenericCertificate, error) {
 	certs := make(map[string]client.GenericCertificate)
@@ -538,12 +896,13 @@ func (s *LBSpec) Certificates() (map[string]client.GenericCertificate, error) {
 	}
 
 	if s.SSLConfig.ListenerSSLSecretName != "" {
-		cert, err := s.SSLConfig.readSSLSecret(s.SSLConfig.ListenerSSLSecretNamespace, s.SSLConfig.ListenerSSLSecretName)
+		cert, err := s.SSLConfig.listenerCertificate()
 		if err != nil {
-			return nil, errors.Wrap(err, "reading SSL Listener Secret")
+			return nil, err
 		}
-		certs[s.SSLConfig.ListenerSSLSecretName] = client.GenericCertificate{
-			CertificateName:   &s.SSLConfig.ListenerSSLSecretName,
+		name := sslCertificateName(s.SSLConfig.ListenerSSLSecretName, cert)
+		certs[name] = client.GenericCertificate{
+			CertificateName:   &name,
 			CaCertificate:     common.String(string(cert.CACert)),
 			PublicCertificate: common.String(string(cert.PublicCert)),
 			PrivateKey:        common.String(string(cert.PrivateKey)),
@@ -556,8 +915,9 @@ func (s *LBSpec) Certificates() (map[string]client.GenericCertificate, error) {
 		if err != nil {
 			return nil, errors.Wrap(err, "reading SSL Backend Secret")
 		}
-		certs[s.SSLConfig.BackendSetSSLSecretName] = client.GenericCertificate{
-			CertificateName:   &s.SSLConfig.BackendSetSSLSecretName,
+		name := sslCertificateName(s.SSLConfig.BackendSetSSLSecretName, cert)
+		certs[name] = client.GenericCertificate{
+			CertificateName:   &name,
 			CaCertificate:     common.String(string(cert.CACert)),
 			PublicCertificate: common.String(string(cert.PublicCert)),
 			PrivateKey:        common.String(string(cert.PrivateKey)),
@@ -580,8 +940,24 @@ func validateService(svc *v1.Service) error {
 		return err
 	}
 
-	if svc.Spec.SessionAffinity != v1.ServiceAffinityNone {
-		return errors.New("OCI only supports SessionAffinity \"None\" currently")
+	if _, _, err := getSessionPersistence(svc); err != nil {
+		return err
+	}
+
+	if _, _, err := getListenerPeerVerification(svc); err != nil {
+		return err
+	}
+
+	if _, err := getListenerSSLPolicy(svc); err != nil {
+		return err
+	}
+
+	if _, err := getRuleSets(svc); err != nil {
+		return err
+	}
+
+	if _, err := getRoutingPolicies(svc); err != nil {
+		return err
 	}
 
 	return nil
@@ -649,10 +1025,15 @@ func getPorts(svc *v1.Service) (map[string]portSpec, error) {
 		if err != nil {
 			return nil, err
 		}
+		// Health checks without a port check the port of the backend.
+		healthCheckerPort := int(servicePort.NodePort)
+		if healthChecker.Port != nil {
+			healthCheckerPort = *healthChecker.Port
+		}
 		ports[backendSetName] = portSpec{
 			BackendPort:       int(servicePort.NodePort),
 			ListenerPort:      int(servicePort.Port),
-			HealthCheckerPort: *healthChecker.Port,
+			HealthCheckerPort: healthCheckerPort,
 		}
 	}
 	return ports, nil
@@ -672,43 +1053,142 @@ func getBackends(logger *zap.SugaredLogger, nodes []*v1.Node, nodePort int32) []
 			continue
 		}
 
-		backends = append(backends, client.GenericBackend{
+		backend := client.GenericBackend{
 			IpAddress: nodeAddressString,
 			Port:      common.Int(int(nodePort)),
 			Weight:    common.Int(1),
 			TargetId:  &instanceID,
-		})
+		}
+		if isNodeToBeDeleted(node) {
+			// The node is going away, so stop sending it new connections
+			// while the ones it already has complete.
+			backend.Drain = common.Bool(true)
+		}
+		backends = append(backends, backend)
 	}
 	return backends
 }
 
+// isNodeToBeDeleted reports whether the node carries a taint that marks it
+// for deletion.
+func isNodeToBeDeleted(node *v1.Node) bool {
+	for _, taint := range node.Spec.Taints {
+		if taint.Key == toBeDeletedTaint {
+			return true
+		}
+	}
+	return false
+}
+
+// getBackendDrainPeriod returns how long the backends of departing nodes are
+// drained before they are removed. The default of zero removes them at once.
+func getBackendDrainPeriod(svc *v1.Service) (time.Duration, error) {
+	value, ok := svc.Annotations[ServiceAnnotationBackendDrainPeriod]
+	if !ok {
+		return 0, nil
+	}
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds < 0 || seconds > maxBackendDrainPeriodSeconds {
+		return 0, fmt.Errorf("invalid value: %s provided for annotation: %s", value, ServiceAnnotationBackendDrainPeriod)
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
+
 func getBackendSets(logger *zap.SugaredLogger, svc *v1.Service, nodes []*v1.Node, sslCfg *SSLConfig, isPreserveSource bool) (map[string]client.GenericBackendSetDetails, error) {
 	backendSets := make(map[string]client.GenericBackendSetDetails)
 	loadbalancerPolicy, err := getLoadBalancerPolicy(svc)
 	if err != nil {
 		return nil, err
 	}
+	sessionPersistence, lbCookieSessionPersistence, err := getSessionPersistence(svc)
+	if err != nil {
+		return nil, err
+	}
 
 	for backendSetName, servicePort := range getBackendSetNamePortMap(svc) {
-		var secretName string
-		if sslCfg != nil && len(sslCfg.BackendSetSSLSecretName) != 0 {
-			secretName = sslCfg.BackendSetSSLSecretName
+		var certificateName string
+		if sslCfg != nil && len(sslCfg.BackendSetSSLCertificateName) != 0 {
+			certificateName = sslCfg.BackendSetSSLCertificateName
 		}
 		healthChecker, err := getHealthChecker(svc)
 		if err != nil {
 			return nil, err
 		}
 		backendSets[backendSetName] = client.GenericBackendSetDetails{
-			Policy:           &loadbalancerPolicy,
-			Backends:         getBackends(logger, nodes, servicePort.NodePort),
-			HealthChecker:    healthChecker,
-			IsPreserveSource: &isPreserveSource,
-			SslConfiguration: getSSLConfiguration(sslCfg, secretName, int(servicePort.Port)),
+			Policy:                                  &loadbalancerPolicy,
+			Backends:                                getBackends(logger, nodes, servicePort.NodePort),
+			HealthChecker:                           healthChecker,
+			IsPreserveSource:                        &isPreserveSource,
+			SslConfiguration:                        getSSLConfiguration(sslCfg, certificateName, int(servicePort.Port)),
+			SessionPersistenceConfiguration:         sessionPersistence,
+			LbCookieSessionPersistenceConfiguration: lbCookieSessionPersistence,
 		}
 	}
 	return backendSets, nil
 }
 
+// getSessionPersistence returns the application cookie or load balancer cookie
+// session persistence configuration requested by the service annotations. At most
+// one of the two is returned as OCI does not allow both on a backend set.
+func getSessionPersistence(svc *v1.Service) (*client.GenericSessionPersistenceConfiguration, *client.GenericLbCookieSessionPersistenceConfiguration, error) {
+	persistence, ok := svc.Annotations[ServiceAnnotationLoadBalancerSessionPersistence]
+	if !ok {
+		return nil, nil, nil
+	}
+	if getLoadBalancerType(svc) == NLB {
+		return nil, nil, fmt.Errorf("%s annotation is not supported for network load balancers", ServiceAnnotationLoadBalancerSessionPersistence)
+	}
+	if !strings.EqualFold(svc.Annotations[ServiceAnnotationLoadBalancerBEProtocol], "HTTP") {
+		return nil, nil, fmt.Errorf("%s annotation requires the %s annotation to be set to HTTP",
+			ServiceAnnotationLoadBalancerSessionPersistence, ServiceAnnotationLoadBalancerBEProtocol)
+	}
+
+	var cookieName *string
+	if name := svc.Annotations[ServiceAnnotationLoadBalancerSessionPersistenceCookieName]; name != "" {
+		cookieName = common.String(name)
+	}
+
+	var disableFallback *bool
+	if value, ok := svc.Annotations[ServiceAnnotationLoadBalancerSessionPersistenceDisableFallback]; ok {
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid value: %s provided for annotation: %s: %v",
+				value, ServiceAnnotationLoadBalancerSessionPersistenceDisableFallback, err)
+		}
+		disableFallback = common.Bool(b)
+	}
+
+	switch strings.ToLower(persistence) {
+	case SessionPersistenceAppCookie:
+		if cookieName == nil {
+			return nil, nil, fmt.Errorf("%s annotation is required for %s session persistence",
+				ServiceAnnotationLoadBalancerSessionPersistenceCookieName, SessionPersistenceAppCookie)
+		}
+		return &client.GenericSessionPersistenceConfiguration{
+			CookieName:      cookieName,
+			DisableFallback: disableFallback,
+		}, nil, nil
+	case SessionPersistenceLBCookie:
+		// The lifetime of the cookie follows the session affinity timeout of
+		// the service when one is set.
+		var maxAgeInSeconds *int
+		if svc.Spec.SessionAffinity == v1.ServiceAffinityClientIP &&
+			svc.Spec.SessionAffinityConfig != nil &&
+			svc.Spec.SessionAffinityConfig.ClientIP != nil &&
+			svc.Spec.SessionAffinityConfig.ClientIP.TimeoutSeconds != nil {
+			maxAgeInSeconds = common.Int(int(*svc.Spec.SessionAffinityConfig.ClientIP.TimeoutSeconds))
+		}
+		return nil, &client.GenericLbCookieSessionPersistenceConfiguration{
+			CookieName:      cookieName,
+			DisableFallback: disableFallback,
+			MaxAgeInSeconds: maxAgeInSeconds,
+		}, nil
+	}
+
+	return nil, nil, fmt.Errorf("invalid value: %s provided for annotation: %s. Supported values are %q and %q",
+		persistence, ServiceAnnotationLoadBalancerSessionPersistence, SessionPersistenceLBCookie, SessionPersistenceAppCookie)
+}
+
 func getHealthChecker(svc *v1.Service) (*client.GenericHealthChecker, error) {
 
 	retries, err := getHealthCheckRetries(svc)
@@ -735,21 +1215,7 @@ func getHealthChecker(svc *v1.Service) (*client.GenericHealthChecker, error) {
 		isForcePlainText = true
 	}
 
-	checkPath, checkPort := helper.GetServiceHealthCheckPathPort(svc)
-	if checkPath != "" {
-		return &client.GenericHealthChecker{
-			Protocol:         lbNodesHealthCheckProto,
-			IsForcePlainText: common.Bool(isForcePlainText),
-			UrlPath:          &checkPath,
-			Port:             common.Int(int(checkPort)),
-			Retries:          &retries,
-			IntervalInMillis: &intervalInMillis,
-			TimeoutInMillis:  &timeoutInMillis,
-			ReturnCode:       common.Int(http.StatusOK),
-		}, nil
-	}
-
-	return &client.GenericHealthChecker{
+	healthChecker := &client.GenericHealthChecker{
 		Protocol:         lbNodesHealthCheckProto,
 		IsForcePlainText: common.Bool(isForcePlainText),
 		UrlPath:          common.String(lbNodesHealthCheckPath),
@@ -758,7 +1224,102 @@ func getHealthChecker(svc *v1.Service) (*client.GenericHealthChecker, error) {
 		IntervalInMillis: &intervalInMillis,
 		TimeoutInMillis:  &timeoutInMillis,
 		ReturnCode:       common.Int(http.StatusOK),
-	}, nil
+	}
+
+	checkPath, checkPort := helper.GetServiceHealthCheckPathPort(svc)
+	if checkPath != "" {
+		healthChecker.UrlPath = &checkPath
+		healthChecker.Port = common.Int(int(checkPort))
+	}
+
+	if err := applyHealthCheckAnnotations(svc, healthChecker); err != nil {
+		return nil, err
+	}
+	return healthChecker, nil
+}
+
+// healthCheckAnnotation returns the value and the name of the load balancer
+// or network load balancer variant of a health check annotation.
+func healthCheckAnnotation(svc *v1.Service, lbAnnotation, nlbAnnotation string) (string, string, bool) {
+	name := lbAnnotation
+	if getLoadBalancerType(svc) == NLB {
+		name = nlbAnnotation
+	}
+	value, ok := svc.Annotations[name]
+	return value, name, ok
+}
+
+// applyHealthCheckAnnotations applies the health check protocol, path, port,
+// return code and response body regex annotations. Any of the protocol, path
+// or port replaces the check of kube-proxy with a check of the application.
+func applyHealthCheckAnnotations(svc *v1.Service, healthChecker *client.GenericHealthChecker) error {
+	protocol, protocolAnnotation, hasProtocol := healthCheckAnnotation(svc,
+		ServiceAnnotationLoadBalancerHealthCheckProtocol, ServiceAnnotationNetworkLoadBalancerHealthCheckProtocol)
+	path, pathAnnotation, hasPath := healthCheckAnnotation(svc,
+		ServiceAnnotationLoadBalancerHealthCheckPath, ServiceAnnotationNetworkLoadBalancerHealthCheckPath)
+	port, portAnnotation, hasPort := healthCheckAnnotation(svc,
+		ServiceAnnotationLoadBalancerHealthCheckPort, ServiceAnnotationNetworkLoadBalancerHealthCheckPort)
+	returnCode, returnCodeAnnotation, hasReturnCode := healthCheckAnnotation(svc,
+		ServiceAnnotationLoadBalancerHealthCheckReturnCode, ServiceAnnotationNetworkLoadBalancerHealthCheckReturnCode)
+	regex, regexAnnotation, hasRegex := healthCheckAnnotation(svc,
+		ServiceAnnotationLoadBalancerHealthCheckResponseBodyRegex, ServiceAnnotationNetworkLoadBalancerHealthCheckResponseBodyRegex)
+
+	if hasProtocol || hasPath || hasPort {
+		healthChecker.Protocol = lbNodesHealthCheckProto
+		healthChecker.UrlPath = common.String("/")
+		healthChecker.Port = nil
+	}
+
+	if hasProtocol {
+		switch p := strings.ToUpper(protocol); {
+		case p == "HTTP":
+		case p == "TCP":
+			healthChecker.Protocol = p
+			healthChecker.UrlPath = nil
+			healthChecker.ReturnCode = nil
+			healthChecker.IsForcePlainText = common.Bool(false)
+		case p == "HTTPS" && getLoadBalancerType(svc) == NLB:
+			healthChecker.Protocol = p
+		case p == "HTTPS":
+			// Load balancers run HTTP checks over the SSL connection of the
+			// backend set instead.
+			if _, ok := svc.Annotations[ServiceAnnotationLoadBalancerTLSBackendSetSecret]; !ok {
+				return fmt.Errorf("HTTPS health checks require the %s annotation on a load balancer", ServiceAnnotationLoadBalancerTLSBackendSetSecret)
+			}
+			healthChecker.IsForcePlainText = common.Bool(false)
+		default:
+			return fmt.Errorf("invalid value: %s provided for annotation: %s", protocol, protocolAnnotation)
+		}
+	}
+	isTCP := healthChecker.Protocol == "TCP"
+
+	if hasPath {
+		if isTCP || !strings.HasPrefix(path, "/") {
+			return fmt.Errorf("invalid value: %s provided for annotation: %s", path, pathAnnotation)
+		}
+		healthChecker.UrlPath = common.String(path)
+	}
+	if hasPort {
+		p, err := strconv.Atoi(port)
+		if err != nil || p < 1 || p > 65535 {
+			return fmt.Errorf("invalid value: %s provided for annotation: %s", port, portAnnotation)
+		}
+		healthChecker.Port = common.Int(p)
+	}
+	if hasReturnCode {
+		code, err := strconv.Atoi(returnCode)
+		if err != nil || isTCP || code < 100 || code > 599 {
+			return fmt.Errorf("invalid value: %s provided for annotation: %s", returnCode, returnCodeAnnotation)
+		}
+		healthChecker.ReturnCode = common.Int(code)
+	}
+	if hasRegex {
+		if _, err := regexp.Compile(regex); err != nil || isTCP || regex == "" {
+			return fmt.Errorf("invalid value: %s provided for annotation: %s", regex, regexAnnotation)
+		}
+		healthChecker.ResponseBodyRegex = common.String(regex)
+	}
+	return nil
 }
 
 func getHealthCheckRetries(svc *v1.Service) (int, error) {
@@ -896,6 +1457,27 @@ func getListenersOciLoadBalancer(svc *v1.Service, sslCfg *SSLConfig) (map[string
 		proxyProtocolVersion = common.Int(version)
 	}
 
+	certificateIds, trustedCAIds, err := getListenerCertificateIds(svc)
+	if err != nil {
+		return nil, err
+	}
+	verifyPeerCertificate, verifyDepth, err := getListenerPeerVerification(svc)
+	if err != nil {
+		return nil, err
+	}
+	sslPolicy, err := getListenerSSLPolicy(svc)
+	if err != nil {
+		return nil, err
+	}
+	ruleSets, err := getRuleSets(svc)
+	if err != nil {
+		return nil, err
+	}
+	routingPolicies, err := getRoutingPolicies(svc)
+	if err != nil {
+		return nil, err
+	}
+
 	listeners := make(map[string]client.GenericListener)
 	for _, servicePort := range svc.Spec.Ports {
 		protocol := string(servicePort.Protocol)
@@ -912,11 +1494,40 @@ func getListenersOciLoadBalancer(svc *v1.Service, sslCfg *SSLConfig) (map[string
 			}
 		}
 		port := int(servicePort.Port)
-		var secretName string
-		if sslCfg != nil && len(sslCfg.ListenerSSLSecretName) != 0 {
-			secretName = sslCfg.ListenerSSLSecretName
+		var certificateName string
+		if sslCfg != nil && len(sslCfg.ListenerSSLCertificateName) != 0 {
+			certificateName = sslCfg.ListenerSSLCertificateName
+		}
+		sslConfiguration := getSSLConfiguration(sslCfg, certificateName, port)
+		if len(certificateIds) != 0 && sslCfg != nil && sslCfg.Ports.Has(port) {
+			sslConfiguration = &client.GenericSslConfigurationDetails{
+				CertificateIds:                 certificateIds,
+				TrustedCertificateAuthorityIds: trustedCAIds,
+				VerifyDepth:                    common.Int(0),
+				VerifyPeerCertificate:          common.Bool(false),
+			}
+		}
+		if sslConfiguration != nil && verifyPeerCertificate {
+			sslConfiguration.VerifyPeerCertificate = common.Bool(true)
+			sslConfiguration.VerifyDepth = common.Int(verifyDepth)
+		}
+		if sslConfiguration != nil {
+			applyListenerSSLPolicy(sslConfiguration, sslPolicy)
+		}
+		var ruleSetNames []string
+		var routingPolicy *string
+		if _, ok := ruleSets[httpRedirectRuleSetName]; ok && port == httpRedirectPort {
+			// Redirects are only supported by HTTP listeners.
+			protocol = "HTTP"
+			ruleSetNames = []string{httpRedirectRuleSetName}
+		} else {
+			if _, ok := ruleSets[httpHeaderRuleSetName]; ok {
+				ruleSetNames = []string{httpHeaderRuleSetName}
+			}
+			if _, ok := routingPolicies[routingPolicyName]; ok {
+				routingPolicy = common.String(routingPolicyName)
+			}
 		}
-		sslConfiguration := getSSLConfiguration(sslCfg, secretName, port)
 		name := getListenerName(protocol, port)
 
 		listener := client.GenericListener{
@@ -925,6 +1536,8 @@ func getListenersOciLoadBalancer(svc *v1.Service, sslCfg *SSLConfig) (map[string
 			Protocol:              &protocol,
 			Port:                  &port,
 			SslConfiguration:      sslConfiguration,
+			RuleSetNames:          ruleSetNames,
+			RoutingPolicyName:     routingPolicy,
 		}
 
 		// If proxy protocol has been set, we also need to set connectionIdleTimeout
@@ -999,6 +1612,418 @@ func getListenersNetworkLoadBalancer(svc *v1.Service) (map[string]client.Generic
 	return listeners, nil
 }
 
+// getListenerCertificateIds returns the OCI Certificates service certificate
+// and trusted CA OCIDs the SSL listeners of a load balancer use in place of a
+// TLS secret.
+func getListenerCertificateIds(svc *v1.Service) ([]string, []string, error) {
+	certificateIds := splitAnnotationList(svc.Annotations[ServiceAnnotationLoadBalancerListenerCertificateIds])
+	trustedCAIds := splitAnnotationList(svc.Annotations[ServiceAnnotationLoadBalancerListenerTrustedCAIds])
+	if len(certificateIds) == 0 {
+		if len(trustedCAIds) != 0 {
+			return nil, nil, fmt.Errorf("%s annotation requires the %s annotation",
+				ServiceAnnotationLoadBalancerListenerTrustedCAIds, ServiceAnnotationLoadBalancerListenerCertificateIds)
+		}
+		return nil, nil, nil
+	}
+
+	if svc.Annotations[ServiceAnnotationLoadBalancerTLSSecret] != "" {
+		return nil, nil, fmt.Errorf("%s and %s annotations cannot be used together",
+			ServiceAnnotationLoadBalancerTLSSecret, ServiceAnnotationLoadBalancerListenerCertificateIds)
+	}
+	// Load balancer listeners accept a single certificate.
+	if len(certificateIds) != 1 {
+		return nil, nil, fmt.Errorf("%s annotation must contain a single certificate OCID", ServiceAnnotationLoadBalancerListenerCertificateIds)
+	}
+	if !strings.HasPrefix(certificateIds[0], "ocid1.certificate.") {
+		return nil, nil, fmt.Errorf("invalid certificate OCID %q provided for annotation: %s", certificateIds[0], ServiceAnnotationLoadBalancerListenerCertificateIds)
+	}
+	for _, id := range trustedCAIds {
+		if !strings.HasPrefix(id, "ocid1.certificateauthority.") && !strings.HasPrefix(id, "ocid1.cabundle.") {
+			return nil, nil, fmt.Errorf("invalid CA or CA bundle OCID %q provided for annotation: %s", id, ServiceAnnotationLoadBalancerListenerTrustedCAIds)
+		}
+	}
+	return certificateIds, trustedCAIds, nil
+}
+
+// getListenerPeerVerification returns whether the SSL listeners of a load
+// balancer verify client certificates and the depth of the verified chain.
+func getListenerPeerVerification(svc *v1.Service) (bool, int, error) {
+	verify := false
+	if value, ok := svc.Annotations[ServiceAnnotationLoadBalancerListenerVerifyPeerCertificate]; ok {
+		var err error
+		if verify, err = strconv.ParseBool(value); err != nil {
+			return false, 0, fmt.Errorf("invalid value: %s provided for annotation: %s", value, ServiceAnnotationLoadBalancerListenerVerifyPeerCertificate)
+		}
+	}
+	depthValue, hasDepth := svc.Annotations[ServiceAnnotationLoadBalancerListenerVerifyDepth]
+	_, hasClientCASecret := svc.Annotations[ServiceAnnotationLoadBalancerListenerClientCASecret]
+	if !verify {
+		if hasDepth || hasClientCASecret {
+			return false, 0, fmt.Errorf("%s and %s annotations require %s to be true",
+				ServiceAnnotationLoadBalancerListenerVerifyDepth, ServiceAnnotationLoadBalancerListenerClientCASecret,
+				ServiceAnnotationLoadBalancerListenerVerifyPeerCertificate)
+		}
+		return false, 0, nil
+	}
+
+	if !requiresCertificate(svc) {
+		return false, 0, fmt.Errorf("%s annotation requires the %s annotation on a load balancer",
+			ServiceAnnotationLoadBalancerListenerVerifyPeerCertificate, ServiceAnnotationLoadBalancerSSLPorts)
+	}
+	if svc.Annotations[ServiceAnnotationLoadBalancerTLSSecret] == "" && svc.Annotations[ServiceAnnotationLoadBalancerListenerCertificateIds] == "" {
+		return false, 0, fmt.Errorf("%s annotation requires the %s or %s annotation",
+			ServiceAnnotationLoadBalancerListenerVerifyPeerCertificate, ServiceAnnotationLoadBalancerTLSSecret,
+			ServiceAnnotationLoadBalancerListenerCertificateIds)
+	}
+	depth := defaultListenerVerifyDepth
+	if hasDepth {
+		var err error
+		if depth, err = strconv.Atoi(depthValue); err != nil || depth < 1 {
+			return false, 0, fmt.Errorf("invalid value: %s provided for annotation: %s", depthValue, ServiceAnnotationLoadBalancerListenerVerifyDepth)
+		}
+	}
+	if svc.Annotations[ServiceAnnotationLoadBalancerListenerCertificateIds] != "" {
+		if hasClientCASecret {
+			return false, 0, fmt.Errorf("%s and %s annotations cannot be used together",
+				ServiceAnnotationLoadBalancerListenerClientCASecret, ServiceAnnotationLoadBalancerListenerCertificateIds)
+		}
+		if svc.Annotations[ServiceAnnotationLoadBalancerListenerTrustedCAIds] == "" {
+			return false, 0, fmt.Errorf("%s annotation requires the %s annotation when used with %s",
+				ServiceAnnotationLoadBalancerListenerVerifyPeerCertificate, ServiceAnnotationLoadBalancerListenerTrustedCAIds,
+				ServiceAnnotationLoadBalancerListenerCertificateIds)
+		}
+	}
+	return true, depth, nil
+}
+
+// listenerSSLPolicy holds the TLS versions and ciphers negotiated by the SSL
+// listeners of a load balancer.
+type listenerSSLPolicy struct {
+	cipherSuiteName       *string
+	protocols             []string
+	serverOrderPreference string
+
+	// cipherSuite is set for custom cipher suites that have to be created on
+	// the load balancer.
+	cipherSuite *client.GenericSslCipherSuite
+}
+
+// getListenerSSLPolicy returns the TLS negotiation settings of the SSL
+// listeners, or nil when the OCI defaults apply.
+func getListenerSSLPolicy(svc *v1.Service) (*listenerSSLPolicy, error) {
+	cipherSuiteName := strings.TrimSpace(svc.Annotations[ServiceAnnotationLoadBalancerSSLCipherSuite])
+	ciphers := splitAnnotationList(svc.Annotations[ServiceAnnotationLoadBalancerSSLCiphers])
+	protocols := splitAnnotationList(svc.Annotations[ServiceAnnotationLoadBalancerSSLProtocols])
+	serverOrderPreference := strings.ToUpper(strings.TrimSpace(svc.Annotations[ServiceAnnotationLoadBalancerSSLServerOrderPreference]))
+	if cipherSuiteName == "" && len(ciphers) == 0 && len(protocols) == 0 && serverOrderPreference == "" {
+		return nil, nil
+	}
+
+	if !requiresCertificate(svc) {
+		return nil, fmt.Errorf("SSL cipher suite and protocol annotations require the %s annotation on a load balancer", ServiceAnnotationLoadBalancerSSLPorts)
+	}
+
+	policy := &listenerSSLPolicy{protocols: protocols}
+	if cipherSuiteName != "" {
+		policy.cipherSuiteName = common.String(cipherSuiteName)
+	}
+	if len(ciphers) != 0 {
+		if cipherSuiteName == "" {
+			return nil, fmt.Errorf("%s annotation requires the %s annotation to name the cipher suite",
+				ServiceAnnotationLoadBalancerSSLCiphers, ServiceAnnotationLoadBalancerSSLCipherSuite)
+		}
+		// Names starting with oci- are reserved for predefined cipher suites.
+		if strings.HasPrefix(cipherSuiteName, "oci-") {
+			return nil, fmt.Errorf("invalid custom cipher suite name: %s provided for annotation: %s, names starting with \"oci-\" are reserved",
+				cipherSuiteName, ServiceAnnotationLoadBalancerSSLCipherSuite)
+		}
+		policy.cipherSuiteName = common.String(sslCipherSuiteNamePrefix + cipherSuiteName)
+		policy.cipherSuite = &client.GenericSslCipherSuite{Name: policy.cipherSuiteName, Ciphers: ciphers}
+	} else if cipherSuiteName != "" && !predefinedSSLCipherSuites.Has(cipherSuiteName) {
+		return nil, fmt.Errorf("invalid value: %s provided for annotation: %s, valid predefined cipher suites are %s",
+			cipherSuiteName, ServiceAnnotationLoadBalancerSSLCipherSuite, strings.Join(predefinedSSLCipherSuites.List(), ", "))
+	}
+
+	for _, protocol := range protocols {
+		if !sslProtocols.Has(protocol) {
+			return nil, fmt.Errorf("invalid value: %s provided for annotation: %s, valid protocols are %s",
+				protocol, ServiceAnnotationLoadBalancerSSLProtocols, strings.Join(sslProtocols.List(), ", "))
+		}
+	}
+
+	switch serverOrderPreference {
+	case "", "ENABLED", "DISABLED":
+		policy.serverOrderPreference = serverOrderPreference
+	default:
+		return nil, fmt.Errorf("invalid value: %s provided for annotation: %s",
+			svc.Annotations[ServiceAnnotationLoadBalancerSSLServerOrderPreference], ServiceAnnotationLoadBalancerSSLServerOrderPreference)
+	}
+	return policy, nil
+}
+
+// getRuleSets returns the rule sets of the HTTP to HTTPS redirect and of the
+// HTTP header annotations, keyed by name.
+func getRuleSets(svc *v1.Service) (map[string]client.GenericRuleSet, error) {
+	httpsPort, err := getHTTPSRedirectPort(svc)
+	if err != nil {
+		return nil, err
+	}
+	headerRules, err := getHTTPHeaderRules(svc)
+	if err != nil {
+		return nil, err
+	}
+	if httpsPort == 0 && len(headerRules) == 0 {
+		return nil, nil
+	}
+
+	ruleSets := make(map[string]client.GenericRuleSet)
+	if httpsPort != 0 {
+		ruleSets[httpRedirectRuleSetName] = client.GenericRuleSet{
+			Name: common.String(httpRedirectRuleSetName),
+			Items: []client.GenericRule{
+				{
+					// OCI fills in the URI parts that are not set, so all of
+					// them are set to keep the rule set free of changes.
+					Action:       "REDIRECT",
+					PathPrefix:   common.String("/"),
+					ResponseCode: common.Int(http.StatusMovedPermanently),
+					RedirectUri: &client.GenericRedirectUri{
+						Protocol: common.String("HTTPS"),
+						Host:     common.String("{host}"),
+						Port:     common.Int(httpsPort),
+						Path:     common.String("{path}"),
+						Query:    common.String("{query}"),
+					},
+				},
+			},
+		}
+	}
+	if len(headerRules) != 0 {
+		ruleSets[httpHeaderRuleSetName] = client.GenericRuleSet{
+			Name:  common.String(httpHeaderRuleSetName),
+			Items: headerRules,
+		}
+	}
+	return ruleSets, nil
+}
+
+// getHTTPSRedirectPort returns the SSL port the listener on port 80
+// redirects to, or 0 when no redirect is requested.
+func getHTTPSRedirectPort(svc *v1.Service) (int, error) {
+	value, ok := svc.Annotations[ServiceAnnotationLoadBalancerRedirectHTTPToHTTPS]
+	if !ok {
+		return 0, nil
+	}
+	redirect, err := strconv.ParseBool(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value: %s provided for annotation: %s", value, ServiceAnnotationLoadBalancerRedirectHTTPToHTTPS)
+	}
+	if !redirect {
+		return 0, nil
+	}
+
+	if !requiresCertificate(svc) {
+		return 0, fmt.Errorf("%s annotation requires the %s annotation on a load balancer",
+			ServiceAnnotationLoadBalancerRedirectHTTPToHTTPS, ServiceAnnotationLoadBalancerSSLPorts)
+	}
+	sslPorts, err := getSSLEnabledPorts(svc)
+	if err != nil {
+		return 0, err
+	}
+	ports := sets.NewInt(sslPorts...)
+	if ports.Len() == 0 {
+		return 0, fmt.Errorf("%s annotation requires the %s annotation on a load balancer",
+			ServiceAnnotationLoadBalancerRedirectHTTPToHTTPS, ServiceAnnotationLoadBalancerSSLPorts)
+	}
+	if ports.Has(httpRedirectPort) {
+		return 0, fmt.Errorf("%s annotation requires port %d not to be an SSL port", ServiceAnnotationLoadBalancerRedirectHTTPToHTTPS, httpRedirectPort)
+	}
+	for _, servicePort := range svc.Spec.Ports {
+		if servicePort.Port == httpRedirectPort {
+			// Redirect to the lowest SSL port, usually 443.
+			return ports.List()[0], nil
+		}
+	}
+	return 0, fmt.Errorf("%s annotation requires the service to expose port %d", ServiceAnnotationLoadBalancerRedirectHTTPToHTTPS, httpRedirectPort)
+}
+
+// getHTTPHeaderRules returns the rules adding and removing the HTTP headers
+// of requests and responses.
+func getHTTPHeaderRules(svc *v1.Service) ([]client.GenericRule, error) {
+	var rules []client.GenericRule
+	for _, annotation := range []struct {
+		name   string
+		action string
+		add    bool
+	}{
+		{ServiceAnnotationLoadBalancerAddRequestHeaders, "ADD_HTTP_REQUEST_HEADER", true},
+		{ServiceAnnotationLoadBalancerRemoveRequestHeaders, "REMOVE_HTTP_REQUEST_HEADER", false},
+		{ServiceAnnotationLoadBalancerAddResponseHeaders, "ADD_HTTP_RESPONSE_HEADER", true},
+		{ServiceAnnotationLoadBalancerRemoveResponseHeaders, "REMOVE_HTTP_RESPONSE_HEADER", false},
+	} {
+		for _, header := range splitAnnotationList(svc.Annotations[annotation.name]) {
+			rule := client.GenericRule{Action: annotation.action}
+			name := header
+			if annotation.add {
+				parts := strings.SplitN(header, ":", 2)
+				if len(parts) != 2 || strings.TrimSpace(parts[1]) == "" {
+					return nil, fmt.Errorf("invalid header: %s provided for annotation: %s, headers must be of the form Name:Value", header, annotation.name)
+				}
+				name = strings.TrimSpace(parts[0])
+				rule.Value = common.String(strings.TrimSpace(parts[1]))
+			}
+			if name == "" || strings.ContainsAny(name, " \t") {
+				return nil, fmt.Errorf("invalid header name: %q provided for annotation: %s", name, annotation.name)
+			}
+			rule.Header = common.String(name)
+			rules = append(rules, rule)
+		}
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	if getLoadBalancerType(svc) != LB || !strings.EqualFold(svc.Annotations[ServiceAnnotationLoadBalancerBEProtocol], "HTTP") {
+		return nil, fmt.Errorf("HTTP header annotations require the %s annotation to be HTTP on a load balancer", ServiceAnnotationLoadBalancerBEProtocol)
+	}
+	return rules, nil
+}
+
+// route forwards the requests matching a host and path prefix to the backend
+// set of a service port.
+type route struct {
+	host           string
+	path           string
+	backendSetName string
+}
+
+// getRoutingPolicies returns the routing policy of the routes annotation,
+// keyed by name. Requests that match no route go to the default backend set
+// of the listener.
+func getRoutingPolicies(svc *v1.Service) (map[string]client.GenericRoutingPolicy, error) {
+	entries := splitAnnotationList(svc.Annotations[ServiceAnnotationLoadBalancerRoutes])
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	if getLoadBalancerType(svc) != LB || !strings.EqualFold(svc.Annotations[ServiceAnnotationLoadBalancerBEProtocol], "HTTP") {
+		return nil, fmt.Errorf("%s annotation requires the %s annotation to be HTTP on a load balancer",
+			ServiceAnnotationLoadBalancerRoutes, ServiceAnnotationLoadBalancerBEProtocol)
+	}
+
+	var routes []route
+	seen := sets.NewString()
+	for _, entry := range entries {
+		r, err := parseRoute(svc, entry)
+		if err != nil {
+			return nil, err
+		}
+		if seen.Has(r.host + r.path) {
+			return nil, fmt.Errorf("duplicate route: %s provided for annotation: %s", entry, ServiceAnnotationLoadBalancerRoutes)
+		}
+		seen.Insert(r.host + r.path)
+		routes = append(routes, r)
+	}
+
+	// The first matching rule wins, so the routes are ordered from the most
+	// to the least specific: host routes first, then longer paths first.
+	sort.SliceStable(routes, func(i, j int) bool {
+		if (routes[i].host == "") != (routes[j].host == "") {
+			return routes[i].host != ""
+		}
+		return len(routes[i].path) > len(routes[j].path)
+	})
+
+	rules := make([]client.GenericRoutingRule, 0, len(routes))
+	for i, r := range routes {
+		var conditions []string
+		if r.host != "" {
+			conditions = append(conditions, fmt.Sprintf("http.request.headers[(i 'host')] eq (i '%s')", r.host))
+		}
+		if r.path != "" {
+			conditions = append(conditions, fmt.Sprintf("http.request.url.path sw '%s'", r.path))
+		}
+		condition := conditions[0]
+		if len(conditions) > 1 {
+			condition = fmt.Sprintf("all(%s)", strings.Join(conditions, ", "))
+		}
+		rules = append(rules, client.GenericRoutingRule{
+			Name:           common.String(fmt.Sprintf("route_%d", i)),
+			Condition:      common.String(condition),
+			BackendSetName: common.String(r.backendSetName),
+		})
+	}
+	return map[string]client.GenericRoutingPolicy{
+		routingPolicyName: {
+			Name:  common.String(routingPolicyName),
+			Rules: rules,
+		},
+	}, nil
+}
+
+// parseRoute parses a "[host][/path]=port" route of the routes annotation.
+func parseRoute(svc *v1.Service, entry string) (route, error) {
+	invalid := fmt.Errorf("invalid route: %s provided for annotation: %s, routes must be of the form [host][/path]=port",
+		entry, ServiceAnnotationLoadBalancerRoutes)
+
+	i := strings.LastIndex(entry, "=")
+	if i < 0 {
+		return route{}, invalid
+	}
+	match, portValue := strings.TrimSpace(entry[:i]), strings.TrimSpace(entry[i+1:])
+	var r route
+	if j := strings.Index(match, "/"); j >= 0 {
+		r.host, r.path = match[:j], match[j:]
+	} else {
+		r.host = match
+	}
+	if r.host == "" && r.path == "" {
+		return route{}, invalid
+	}
+	if r.host != "" && len(validation.IsDNS1123Subdomain(r.host)) != 0 {
+		return route{}, fmt.Errorf("invalid host: %s provided for annotation: %s", r.host, ServiceAnnotationLoadBalancerRoutes)
+	}
+	if strings.ContainsAny(r.path, "'\\ \t") {
+		return route{}, fmt.Errorf("invalid path: %s provided for annotation: %s", r.path, ServiceAnnotationLoadBalancerRoutes)
+	}
+
+	port, err := strconv.Atoi(portValue)
+	if err != nil {
+		return route{}, invalid
+	}
+	for _, servicePort := range svc.Spec.Ports {
+		if int(servicePort.Port) == port {
+			r.backendSetName = getBackendSetName(string(servicePort.Protocol), port)
+			return r, nil
+		}
+	}
+	return route{}, fmt.Errorf("route: %s provided for annotation: %s refers to port %d which is not exposed by the service",
+		entry, ServiceAnnotationLoadBalancerRoutes, port)
+}
+
+// applyListenerSSLPolicy sets the TLS negotiation settings of an SSL listener
+// that are given by annotations. Settings without an annotation are left to
+// OCI, see withRestoredSSLPolicy for listeners the CCM customized before.
+func applyListenerSSLPolicy(sslConfiguration *client.GenericSslConfigurationDetails, policy *listenerSSLPolicy) {
+	if policy == nil {
+		return
+	}
+	sslConfiguration.CipherSuiteName = policy.cipherSuiteName
+	sslConfiguration.Protocols = policy.protocols
+	sslConfiguration.ServerOrderPreference = policy.serverOrderPreference
+}
+
+// splitAnnotationList splits a comma separated annotation value, dropping
+// empty entries.
+func splitAnnotationList(value string) []string {
+	var values []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
 func getListeners(svc *v1.Service, sslCfg *SSLConfig) (map[string]client.GenericListener, error) {
 
 	lbType := getLoadBalancerType(svc)
@@ -1182,14 +2207,26 @@ func getLoadBalancerPolicy(svc *v1.Service) (string, error) {
 		NetworkLoadBalancingPolicyFiveTuple:  struct{}{},
 	}
 
+	// ClientIP session affinity is honoured by hashing the source IP of the
+	// client, unless a load balancer keeps sessions with a cookie instead.
+	_, cookiePersistence := svc.Annotations[ServiceAnnotationLoadBalancerSessionPersistence]
+	clientIPAffinity := svc.Spec.SessionAffinity == v1.ServiceAffinityClientIP && !(lbType != NLB && cookiePersistence)
+
 	switch lbType {
 	case NLB:
 		{
 			annotationValue, annotationExists = svc.Annotations[ServiceAnnotationNetworkLoadBalancerBackendPolicy]
 			if !annotationExists {
+				if clientIPAffinity {
+					return NetworkLoadBalancingPolicyTwoTuple, nil
+				}
 				return DefaultNetworkLoadBalancerPolicy, nil
 			}
 			if _, ok := knownNLBPolicies[annotationValue]; ok {
+				if clientIPAffinity && annotationValue != NetworkLoadBalancingPolicyTwoTuple {
+					return "", fmt.Errorf("network loadbalancer policy \"%s\" conflicts with ClientIP session affinity which requires \"%s\"",
+						annotationValue, NetworkLoadBalancingPolicyTwoTuple)
+				}
 				return annotationValue, nil
 			}
 		}
@@ -1197,9 +2234,16 @@ func getLoadBalancerPolicy(svc *v1.Service) (string, error) {
 		{
 			annotationValue, annotationExists = svc.Annotations[ServiceAnnotationLoadBalancerPolicy]
 			if !annotationExists {
+				if clientIPAffinity {
+					return IPHashLoadBalancerPolicy, nil
+				}
 				return DefaultLoadBalancerPolicy, nil
 			}
 			if _, ok := knownLBPolicies[annotationValue]; ok {
+				if clientIPAffinity && annotationValue != IPHashLoadBalancerPolicy {
+					return "", fmt.Errorf("loadbalancer policy \"%s\" conflicts with ClientIP session affinity which requires \"%s\"",
+						annotationValue, IPHashLoadBalancerPolicy)
+				}
 				return annotationValue, nil
 			}
 		}
@@ -1208,25 +2252,33 @@ func getLoadBalancerPolicy(svc *v1.Service) (string, error) {
 	return "", fmt.Errorf("loadbalancer policy \"%s\" is not valid", annotationValue)
 }
 
+// getLoadBalancerIP returns the IP address of the load balancer. Public load
+// balancers take the address of a reserved public IP, internal network load
+// balancers a private IP address in their subnet.
 func getLoadBalancerIP(svc *v1.Service) (string, error) {
-	// There are no changes here wrt NLB since NLB doesn't support private ip reservation
-
 	ipAddress := svc.Spec.LoadBalancerIP
 	if ipAddress == "" {
 		return "", nil
 	}
 
 	//checks the validity of loadbalancerIP format
-	if net.ParseIP(ipAddress) == nil {
+	ip := net.ParseIP(ipAddress)
+	if ip == nil {
 		return "", fmt.Errorf("invalid value %q provided for LoadBalancerIP", ipAddress)
 	}
 
 	//checks if private loadbalancer is trying to use reservedIP
 	isInternal, err := isInternalLB(svc)
-	if isInternal {
-		return "", fmt.Errorf("invalid service: cannot create a private load balancer with Reserved IP")
+	if err != nil {
+		return "", err
+	}
+	if isInternal && getLoadBalancerType(svc) != NLB {
+		return "", fmt.Errorf("invalid service: a private IP address can only be assigned to an internal network load balancer")
+	}
+	if isInternal && ip.To4() == nil {
+		return "", fmt.Errorf("invalid service: the private IP address of an internal network load balancer has to be an IPv4 address")
 	}
-	return ipAddress, err
+	return ipAddress, nil
 }
 
 func getLoadBalancerTags(svc *v1.Service, initialTags *config.InitialTags) (*config.TagConfig, error) {
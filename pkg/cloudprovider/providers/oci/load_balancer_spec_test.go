@@ -19,13 +19,16 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/pointer"
 
 	providercfg "github.com/oracle/oci-cloud-controller-manager/pkg/cloudprovider/providers/oci/config"
 	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
@@ -36,6 +39,12 @@ var (
 	backendSecret  = "backendsecret"
 	listenerSecret = "listenersecret"
 	testNodeString = "testNodeTargetID"
+
+	// The certificate names are content hashes of the secrets of
+	// testSSLSecretReader and must not change between releases, or every
+	// certificate is rotated on upgrade.
+	backendCertificateName  = "oci-ccm-backendsecret-a5aa9c2b9e6681c3"
+	listenerCertificateName = "oci-ccm-listenersecret-6e6227668180d555"
 )
 
 var (
@@ -52,6 +61,23 @@ type mockSSLSecretReader struct {
 	}]*certificateData
 }
 
+var testSSLSecretReader = &mockSSLSecretReader{
+	returnMap: map[struct {
+		namespaceArg string
+		nameArg      string
+	}]*certificateData{
+		{nameArg: listenerSecret}: {
+			PublicCert: []byte("listenercert"),
+			PrivateKey: []byte("listenerkey"),
+		},
+		{nameArg: backendSecret}: {
+			CACert:     []byte("backendca"),
+			PublicCert: []byte("backendcert"),
+			PrivateKey: []byte("backendkey"),
+		},
+	},
+}
+
 func (ssr mockSSLSecretReader) readSSLSecret(ns, name string) (sslSecret *certificateData, err error) {
 	if ssr.returnError {
 		return nil, errors.New("Oops, something went wrong")
@@ -64,6 +90,14 @@ func (ssr mockSSLSecretReader) readSSLSecret(ns, name string) (sslSecret *certif
 	return nil, nil
 }
 
+func (ssr mockSSLSecretReader) readCACertificate(ns, name string) (caCert []byte, err error) {
+	cert, err := ssr.readSSLSecret(ns, name)
+	if cert == nil {
+		return nil, err
+	}
+	return cert.CACert, nil
+}
+
 func TestNewLBSpecSuccess(t *testing.T) {
 	enableOkeSystemTags = true
 	testCases := map[string]struct {
@@ -1471,7 +1505,7 @@ func TestNewLBSpecSuccess(t *testing.T) {
 						Port:                  common.Int(443),
 						Protocol:              common.String("TCP"),
 						SslConfiguration: &client.GenericSslConfigurationDetails{
-							CertificateName:       &listenerSecret,
+							CertificateName:       &listenerCertificateName,
 							VerifyDepth:           common.Int(0),
 							VerifyPeerCertificate: common.Bool(false),
 						},
@@ -1493,7 +1527,7 @@ func TestNewLBSpecSuccess(t *testing.T) {
 						IsPreserveSource: common.Bool(false),
 						Policy:           common.String("ROUND_ROBIN"),
 						SslConfiguration: &client.GenericSslConfigurationDetails{
-							CertificateName:       &backendSecret,
+							CertificateName:       &backendCertificateName,
 							VerifyDepth:           common.Int(0),
 							VerifyPeerCertificate: common.Bool(false),
 						},
@@ -1511,15 +1545,19 @@ func TestNewLBSpecSuccess(t *testing.T) {
 				securityListManager:         newSecurityListManagerNOOP(),
 				ManagedNetworkSecurityGroup: &ManagedNetworkSecurityGroup{frontendNsgId: "", backendNsgId: []string{}, nsgRuleManagementMode: ManagementModeNone},
 				SSLConfig: &SSLConfig{
-					Ports:                   sets.NewInt(443),
-					ListenerSSLSecretName:   listenerSecret,
-					BackendSetSSLSecretName: backendSecret,
+					Ports:                        sets.NewInt(443),
+					ListenerSSLSecretName:        listenerSecret,
+					BackendSetSSLSecretName:      backendSecret,
+					ListenerSSLCertificateName:   listenerCertificateName,
+					BackendSetSSLCertificateName: backendCertificateName,
+					sslSecretReader:              testSSLSecretReader,
 				},
 			},
 			sslConfig: &SSLConfig{
 				Ports:                   sets.NewInt(443),
 				ListenerSSLSecretName:   listenerSecret,
 				BackendSetSSLSecretName: backendSecret,
+				sslSecretReader:         testSSLSecretReader,
 			},
 		},
 		"custom health check config": {
@@ -2958,9 +2996,31 @@ func TestNewLBSpecFailure(t *testing.T) {
 			},
 			expectedErrMsg: "invalid service: OCI load balancers do not support UDP",
 		},
-		"unsupported session affinity": {
+		"session affinity conflicting with lb policy": {
+			defaultSubnetOne: "one",
+			service: &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerPolicy: LeastConnectionsLoadBalancerPolicy,
+					},
+				},
+				Spec: v1.ServiceSpec{
+					SessionAffinity: v1.ServiceAffinityClientIP,
+					Ports: []v1.ServicePort{
+						{Protocol: v1.ProtocolTCP},
+					},
+				},
+			},
+			expectedErrMsg: "loadbalancer policy \"LEAST_CONNECTIONS\" conflicts with ClientIP session affinity which requires \"IP_HASH\"",
+		},
+		"invalid session persistence": {
 			defaultSubnetOne: "one",
 			service: &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerSessionPersistence: "lb-cookie",
+					},
+				},
 				Spec: v1.ServiceSpec{
 					SessionAffinity: v1.ServiceAffinityClientIP,
 					Ports: []v1.ServicePort{
@@ -2968,7 +3028,7 @@ func TestNewLBSpecFailure(t *testing.T) {
 					},
 				},
 			},
-			expectedErrMsg: "invalid service: OCI only supports SessionAffinity \"None\" currently",
+			expectedErrMsg: "invalid service: oci.oraclecloud.com/loadbalancer-session-persistence annotation requires the service.beta.kubernetes.io/oci-load-balancer-backend-protocol annotation to be set to HTTP",
 		},
 		"invalid idle connection timeout": {
 			defaultSubnetOne: "one",
@@ -3158,7 +3218,7 @@ func TestNewLBSpecFailure(t *testing.T) {
 					Ports:           []v1.ServicePort{},
 				},
 			},
-			expectedErrMsg: `invalid service: cannot create a private load balancer with Reserved IP`,
+			expectedErrMsg: `invalid service: a private IP address can only be assigned to an internal network load balancer`,
 		},
 		"invalid defined tags": {
 			defaultSubnetOne: "one",
@@ -3420,6 +3480,12 @@ func TestCertificates(t *testing.T) {
 	listenerSecretPrivateKey := "privatekey2"
 	listenerSecretPassphrase := "passphrase2"
 
+	backendCertificateName := "oci-ccm-backendsecret-0d404de8a8aa4a8c"
+	listenerCertificateName := "oci-ccm-listenersecret-fd6633921fe279eb"
+
+	clientCACert := "clientcacert"
+	clientCACertificateName := "oci-ccm-listenersecret-13431ee28655332a"
+
 	testCases := map[string]struct {
 		lbSpec         *LBSpec
 		expectedResult map[string]client.GenericCertificate
@@ -3459,8 +3525,8 @@ func TestCertificates(t *testing.T) {
 				},
 			},
 			expectedResult: map[string]client.GenericCertificate{
-				backendSecret: {
-					CertificateName:   &backendSecret,
+				backendCertificateName: {
+					CertificateName:   &backendCertificateName,
 					CaCertificate:     &backendSecretCaCert,
 					Passphrase:        &backendSecretPassphrase,
 					PrivateKey:        &backendSecretPrivateKey,
@@ -3506,15 +3572,15 @@ func TestCertificates(t *testing.T) {
 				},
 			},
 			expectedResult: map[string]client.GenericCertificate{
-				backendSecret: {
-					CertificateName:   &backendSecret,
+				backendCertificateName: {
+					CertificateName:   &backendCertificateName,
 					CaCertificate:     &backendSecretCaCert,
 					Passphrase:        &backendSecretPassphrase,
 					PrivateKey:        &backendSecretPrivateKey,
 					PublicCertificate: &backendSecretPublicCert,
 				},
-				listenerSecret: {
-					CertificateName:   &listenerSecret,
+				listenerCertificateName: {
+					CertificateName:   &listenerCertificateName,
 					CaCertificate:     &listenerSecretCaCert,
 					Passphrase:        &listenerSecretPassphrase,
 					PrivateKey:        &listenerSecretPrivateKey,
@@ -3522,6 +3588,43 @@ func TestCertificates(t *testing.T) {
 				},
 			},
 		},
+		"Listener CA certificate replaced by the client CA secret": {
+			expectError: false,
+			lbSpec: &LBSpec{
+				SSLConfig: &SSLConfig{
+					ListenerSSLSecretName:           listenerSecret,
+					ListenerSSLSecretNamespace:      "listenernamespace",
+					ListenerClientCASecretName:      "clientca",
+					ListenerClientCASecretNamespace: "listenernamespace",
+					sslSecretReader: &mockSSLSecretReader{
+						returnError: false,
+						returnMap: map[struct {
+							namespaceArg string
+							nameArg      string
+						}]*certificateData{
+							{namespaceArg: "listenernamespace", nameArg: listenerSecret}: {
+								CACert:     []byte(listenerSecretCaCert),
+								PublicCert: []byte(listenerSecretPublicCert),
+								PrivateKey: []byte(listenerSecretPrivateKey),
+								Passphrase: []byte(listenerSecretPassphrase),
+							},
+							{namespaceArg: "listenernamespace", nameArg: "clientca"}: {
+								CACert: []byte(clientCACert),
+							},
+						},
+					},
+				},
+			},
+			expectedResult: map[string]client.GenericCertificate{
+				clientCACertificateName: {
+					CertificateName:   &clientCACertificateName,
+					CaCertificate:     &clientCACert,
+					Passphrase:        &listenerSecretPassphrase,
+					PrivateKey:        &listenerSecretPrivateKey,
+					PublicCertificate: &listenerSecretPublicCert,
+				},
+			},
+		},
 		"Error returned from SSL secret reader is handled gracefully": {
 			expectError: true,
 			lbSpec: &LBSpec{
@@ -3901,6 +4004,33 @@ func Test_getBackends(t *testing.T) {
 				{IpAddress: common.String("0.0.0.1"), Port: common.Int(80), Weight: common.Int(1), TargetId: &testNodeString},
 			},
 		},
+		{
+			name: "node to be deleted by the cluster autoscaler",
+			args: args{
+				nodes: []*v1.Node{
+					{
+						Spec: v1.NodeSpec{
+							ProviderID: testNodeString,
+							Taints: []v1.Taint{
+								{Key: "ToBeDeletedByClusterAutoscaler", Value: "1700000000", Effect: v1.TaintEffectNoSchedule},
+							},
+						},
+						Status: v1.NodeStatus{
+							Addresses: []v1.NodeAddress{
+								{
+									Address: "0.0.0.0",
+									Type:    "InternalIP",
+								},
+							},
+						},
+					},
+				},
+				nodePort: 80,
+			},
+			want: []client.GenericBackend{
+				{IpAddress: common.String("0.0.0.0"), Port: common.Int(80), Weight: common.Int(1), TargetId: &testNodeString, Drain: common.Bool(true)},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -4765,6 +4895,168 @@ func Test_getHealthChecker(t *testing.T) {
 			},
 			err: nil,
 		},
+		"application HTTP check for lb": {
+			service: &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerHealthCheckPath:              "/ready",
+						ServiceAnnotationLoadBalancerHealthCheckReturnCode:        "204",
+						ServiceAnnotationLoadBalancerHealthCheckResponseBodyRegex: "^ok$",
+					},
+				},
+			},
+			expected: &client.GenericHealthChecker{
+				Protocol:          "HTTP",
+				IsForcePlainText:  common.Bool(false),
+				UrlPath:           common.String("/ready"),
+				Retries:           common.Int(3),
+				TimeoutInMillis:   common.Int(3000),
+				IntervalInMillis:  common.Int(10000),
+				ReturnCode:        common.Int(http.StatusNoContent),
+				ResponseBodyRegex: common.String("^ok$"),
+			},
+		},
+		"application TCP check for lb": {
+			service: &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerHealthCheckProtocol: "tcp",
+						ServiceAnnotationLoadBalancerHealthCheckPort:     "30080",
+					},
+				},
+			},
+			expected: &client.GenericHealthChecker{
+				Protocol:         "TCP",
+				IsForcePlainText: common.Bool(false),
+				Port:             common.Int(30080),
+				Retries:          common.Int(3),
+				TimeoutInMillis:  common.Int(3000),
+				IntervalInMillis: common.Int(10000),
+			},
+		},
+		"application HTTPS check for lb with backend SSL": {
+			service: &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerTLSBackendSetSecret: "backend-tls",
+						ServiceAnnotationLoadBalancerHealthCheckProtocol: "HTTPS",
+					},
+				},
+			},
+			expected: &client.GenericHealthChecker{
+				Protocol:         "HTTP",
+				IsForcePlainText: common.Bool(false),
+				UrlPath:          common.String("/"),
+				Retries:          common.Int(3),
+				TimeoutInMillis:  common.Int(3000),
+				IntervalInMillis: common.Int(10000),
+				ReturnCode:       common.Int(http.StatusOK),
+			},
+		},
+		"application HTTPS check for lb without backend SSL": {
+			service: &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerHealthCheckProtocol: "HTTPS",
+					},
+				},
+			},
+			err: fmt.Errorf("HTTPS health checks require the service.beta.kubernetes.io/oci-load-balancer-tls-backendset-secret annotation on a load balancer"),
+		},
+		"application HTTPS check for nlb": {
+			service: &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerType:                       "nlb",
+						ServiceAnnotationNetworkLoadBalancerHealthCheckProtocol: "HTTPS",
+						ServiceAnnotationNetworkLoadBalancerHealthCheckPath:     "/status",
+						ServiceAnnotationNetworkLoadBalancerHealthCheckPort:     "8443",
+					},
+				},
+			},
+			expected: &client.GenericHealthChecker{
+				Protocol:         "HTTPS",
+				IsForcePlainText: common.Bool(false),
+				Port:             common.Int(8443),
+				UrlPath:          common.String("/status"),
+				Retries:          common.Int(3),
+				TimeoutInMillis:  common.Int(3000),
+				IntervalInMillis: common.Int(10000),
+				ReturnCode:       common.Int(http.StatusOK),
+			},
+		},
+		"lb annotations are ignored by nlb": {
+			service: &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerType:            "nlb",
+						ServiceAnnotationLoadBalancerHealthCheckPath: "/ready",
+					},
+				},
+			},
+			expected: &client.GenericHealthChecker{
+				Protocol:         "HTTP",
+				IsForcePlainText: common.Bool(false),
+				Port:             common.Int(10256),
+				UrlPath:          common.String("/healthz"),
+				Retries:          common.Int(3),
+				TimeoutInMillis:  common.Int(3000),
+				IntervalInMillis: common.Int(10000),
+				ReturnCode:       common.Int(http.StatusOK),
+			},
+		},
+		"invalid protocol": {
+			service: &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerHealthCheckProtocol: "UDP",
+					},
+				},
+			},
+			err: fmt.Errorf("invalid value: UDP provided for annotation: service.beta.kubernetes.io/oci-load-balancer-health-check-protocol"),
+		},
+		"path of a TCP check": {
+			service: &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerType:                       "nlb",
+						ServiceAnnotationNetworkLoadBalancerHealthCheckProtocol: "TCP",
+						ServiceAnnotationNetworkLoadBalancerHealthCheckPath:     "/ready",
+					},
+				},
+			},
+			err: fmt.Errorf("invalid value: /ready provided for annotation: oci-network-load-balancer.oraclecloud.com/health-check-path"),
+		},
+		"invalid port": {
+			service: &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerHealthCheckPort: "70000",
+					},
+				},
+			},
+			err: fmt.Errorf("invalid value: 70000 provided for annotation: service.beta.kubernetes.io/oci-load-balancer-health-check-port"),
+		},
+		"invalid return code": {
+			service: &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerHealthCheckReturnCode: "ok",
+					},
+				},
+			},
+			err: fmt.Errorf("invalid value: ok provided for annotation: service.beta.kubernetes.io/oci-load-balancer-health-check-return-code"),
+		},
+		"invalid response body regex": {
+			service: &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerHealthCheckResponseBodyRegex: "(ok",
+					},
+				},
+			},
+			err: fmt.Errorf("invalid value: (ok provided for annotation: service.beta.kubernetes.io/oci-load-balancer-health-check-response-body-regex"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -4854,72 +5146,908 @@ func Test_getListeners(t *testing.T) {
 	}
 }
 
-func Test_getSecurityListManagementMode(t *testing.T) {
+func Test_getListenerCertificateIds(t *testing.T) {
 	testCases := map[string]struct {
-		service  *v1.Service
-		expected string
+		annotations    map[string]string
+		certificateIds []string
+		trustedCAIds   []string
+		err            string
 	}{
-		"defaults - lb": {
-			service: &v1.Service{
-				ObjectMeta: metav1.ObjectMeta{
-					Annotations: map[string]string{},
-				},
+		"no annotations": {
+			annotations: map[string]string{},
+		},
+		"certificate and trusted CAs": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerListenerCertificateIds: "ocid1.certificate.oc1..aaa",
+				ServiceAnnotationLoadBalancerListenerTrustedCAIds:   "ocid1.certificateauthority.oc1..bbb, ocid1.cabundle.oc1..ccc",
 			},
-			expected: "All",
+			certificateIds: []string{"ocid1.certificate.oc1..aaa"},
+			trustedCAIds:   []string{"ocid1.certificateauthority.oc1..bbb", "ocid1.cabundle.oc1..ccc"},
 		},
-		"defaults - nlb": {
-			service: &v1.Service{
-				ObjectMeta: metav1.ObjectMeta{
-					Annotations: map[string]string{
-						ServiceAnnotationLoadBalancerType: "nlb",
-					},
-				},
+		"trusted CAs without certificate": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerListenerTrustedCAIds: "ocid1.cabundle.oc1..ccc",
 			},
-			expected: "None",
+			err: "oci.oraclecloud.com/oci-load-balancer-listener-trusted-ca-ids annotation requires the oci.oraclecloud.com/oci-load-balancer-listener-certificate-ids annotation",
 		},
-		"lb mode None": {
-			service: &v1.Service{
-				ObjectMeta: metav1.ObjectMeta{
-					Annotations: map[string]string{
-						ServiceAnnotationLoadBalancerSecurityListManagementMode: "None",
-					},
-				},
+		"certificate with tls secret": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerListenerCertificateIds: "ocid1.certificate.oc1..aaa",
+				ServiceAnnotationLoadBalancerTLSSecret:              "tls",
 			},
-			expected: "None",
+			err: "service.beta.kubernetes.io/oci-load-balancer-tls-secret and oci.oraclecloud.com/oci-load-balancer-listener-certificate-ids annotations cannot be used together",
 		},
-		"lb mode all": {
-			service: &v1.Service{
-				ObjectMeta: metav1.ObjectMeta{
-					Annotations: map[string]string{
-						ServiceAnnotationLoadBalancerSecurityListManagementMode: "All",
-					},
-				},
+		"multiple certificates": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerListenerCertificateIds: "ocid1.certificate.oc1..aaa,ocid1.certificate.oc1..bbb",
 			},
-			expected: "All",
+			err: "oci.oraclecloud.com/oci-load-balancer-listener-certificate-ids annotation must contain a single certificate OCID",
 		},
-		"lb mode frontend": {
-			service: &v1.Service{
-				ObjectMeta: metav1.ObjectMeta{
-					Annotations: map[string]string{
-						ServiceAnnotationLoadBalancerSecurityListManagementMode: "Frontend",
-					},
-				},
+		"invalid certificate": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerListenerCertificateIds: "ocid1.cabundle.oc1..aaa",
 			},
-			expected: "Frontend",
+			err: "invalid certificate OCID \"ocid1.cabundle.oc1..aaa\" provided for annotation: oci.oraclecloud.com/oci-load-balancer-listener-certificate-ids",
 		},
-		"defaults-nlb": {
-			service: &v1.Service{
-				ObjectMeta: metav1.ObjectMeta{
-					Annotations: map[string]string{
-						ServiceAnnotationLoadBalancerType: "nlb",
-					},
-				},
+		"invalid trusted CA": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerListenerCertificateIds: "ocid1.certificate.oc1..aaa",
+				ServiceAnnotationLoadBalancerListenerTrustedCAIds:   "ocid1.certificate.oc1..bbb",
 			},
-			expected: "None",
+			err: "invalid CA or CA bundle OCID \"ocid1.certificate.oc1..bbb\" provided for annotation: oci.oraclecloud.com/oci-load-balancer-listener-trusted-ca-ids",
 		},
-		"nlb mode None": {
-			service: &v1.Service{
-				ObjectMeta: metav1.ObjectMeta{
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			svc := &v1.Service{ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations}}
+			certificateIds, trustedCAIds, err := getListenerCertificateIds(svc)
+			if err != nil && err.Error() != tc.err {
+				t.Errorf("Expected error %q but got %q", tc.err, err.Error())
+			}
+			if err == nil && tc.err != "" {
+				t.Errorf("Expected error %q but got none", tc.err)
+			}
+			if !reflect.DeepEqual(certificateIds, tc.certificateIds) || !reflect.DeepEqual(trustedCAIds, tc.trustedCAIds) {
+				t.Errorf("Expected %v, %v but got %v, %v", tc.certificateIds, tc.trustedCAIds, certificateIds, trustedCAIds)
+			}
+		})
+	}
+}
+
+func Test_getListenersWithCertificateIds(t *testing.T) {
+	svc := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				ServiceAnnotationLoadBalancerListenerCertificateIds: "ocid1.certificate.oc1..aaa",
+				ServiceAnnotationLoadBalancerListenerTrustedCAIds:   "ocid1.cabundle.oc1..ccc",
+			},
+		},
+		Spec: v1.ServiceSpec{
+			Ports: []v1.ServicePort{
+				{Protocol: v1.ProtocolTCP, Port: int32(80)},
+				{Protocol: v1.ProtocolTCP, Port: int32(443)},
+			},
+		},
+	}
+	sslCfg := &SSLConfig{Ports: sets.NewInt(443)}
+	want := map[string]client.GenericListener{
+		"TCP-80": {
+			Name:                  common.String("TCP-80"),
+			Port:                  common.Int(80),
+			Protocol:              common.String("TCP"),
+			DefaultBackendSetName: common.String("TCP-80"),
+		},
+		"TCP-443": {
+			Name:                  common.String("TCP-443"),
+			Port:                  common.Int(443),
+			Protocol:              common.String("TCP"),
+			DefaultBackendSetName: common.String("TCP-443"),
+			SslConfiguration: &client.GenericSslConfigurationDetails{
+				CertificateIds:                 []string{"ocid1.certificate.oc1..aaa"},
+				TrustedCertificateAuthorityIds: []string{"ocid1.cabundle.oc1..ccc"},
+				VerifyDepth:                    common.Int(0),
+				VerifyPeerCertificate:          common.Bool(false),
+			},
+		},
+	}
+	got, err := getListeners(svc, sslCfg)
+	if err != nil {
+		t.Fatalf("getListeners() got unexpected error %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("getListeners() = %+v, \n want %+v", got, want)
+	}
+}
+
+func Test_getListenerPeerVerification(t *testing.T) {
+	testCases := map[string]struct {
+		annotations map[string]string
+		verify      bool
+		depth       int
+		err         string
+	}{
+		"disabled by default": {
+			annotations: map[string]string{},
+		},
+		"enabled with default depth": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSSLPorts:                      "443",
+				ServiceAnnotationLoadBalancerTLSSecret:                     "tls",
+				ServiceAnnotationLoadBalancerListenerVerifyPeerCertificate: "true",
+			},
+			verify: true,
+			depth:  1,
+		},
+		"enabled with depth and client CA secret": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSSLPorts:                      "443",
+				ServiceAnnotationLoadBalancerTLSSecret:                     "tls",
+				ServiceAnnotationLoadBalancerListenerVerifyPeerCertificate: "true",
+				ServiceAnnotationLoadBalancerListenerVerifyDepth:           "3",
+				ServiceAnnotationLoadBalancerListenerClientCASecret:        "client-ca",
+			},
+			verify: true,
+			depth:  3,
+		},
+		"enabled with certificate and trusted CA ids": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSSLPorts:                      "443",
+				ServiceAnnotationLoadBalancerListenerCertificateIds:        "ocid1.certificate.oc1..aaa",
+				ServiceAnnotationLoadBalancerListenerTrustedCAIds:          "ocid1.cabundle.oc1..bbb",
+				ServiceAnnotationLoadBalancerListenerVerifyPeerCertificate: "true",
+			},
+			verify: true,
+			depth:  1,
+		},
+		"invalid verify peer certificate": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerListenerVerifyPeerCertificate: "yes please",
+			},
+			err: "invalid value: yes please provided for annotation: oci.oraclecloud.com/oci-load-balancer-listener-verify-peer-certificate",
+		},
+		"invalid verify depth": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSSLPorts:                      "443",
+				ServiceAnnotationLoadBalancerTLSSecret:                     "tls",
+				ServiceAnnotationLoadBalancerListenerVerifyPeerCertificate: "true",
+				ServiceAnnotationLoadBalancerListenerVerifyDepth:           "0",
+			},
+			err: "invalid value: 0 provided for annotation: oci.oraclecloud.com/oci-load-balancer-listener-verify-depth",
+		},
+		"verify depth without verification": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerListenerVerifyDepth: "2",
+			},
+			err: "oci.oraclecloud.com/oci-load-balancer-listener-verify-depth and oci.oraclecloud.com/oci-load-balancer-listener-client-ca-secret annotations require oci.oraclecloud.com/oci-load-balancer-listener-verify-peer-certificate to be true",
+		},
+		"without ssl ports": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerTLSSecret:                     "tls",
+				ServiceAnnotationLoadBalancerListenerVerifyPeerCertificate: "true",
+			},
+			err: "oci.oraclecloud.com/oci-load-balancer-listener-verify-peer-certificate annotation requires the service.beta.kubernetes.io/oci-load-balancer-ssl-ports annotation on a load balancer",
+		},
+		"without listener certificate": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSSLPorts:                      "443",
+				ServiceAnnotationLoadBalancerListenerVerifyPeerCertificate: "true",
+			},
+			err: "oci.oraclecloud.com/oci-load-balancer-listener-verify-peer-certificate annotation requires the service.beta.kubernetes.io/oci-load-balancer-tls-secret or oci.oraclecloud.com/oci-load-balancer-listener-certificate-ids annotation",
+		},
+		"client CA secret with certificate ids": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSSLPorts:                      "443",
+				ServiceAnnotationLoadBalancerListenerCertificateIds:        "ocid1.certificate.oc1..aaa",
+				ServiceAnnotationLoadBalancerListenerTrustedCAIds:          "ocid1.cabundle.oc1..bbb",
+				ServiceAnnotationLoadBalancerListenerVerifyPeerCertificate: "true",
+				ServiceAnnotationLoadBalancerListenerClientCASecret:        "client-ca",
+			},
+			err: "oci.oraclecloud.com/oci-load-balancer-listener-client-ca-secret and oci.oraclecloud.com/oci-load-balancer-listener-certificate-ids annotations cannot be used together",
+		},
+		"certificate ids without trusted CA ids": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSSLPorts:                      "443",
+				ServiceAnnotationLoadBalancerListenerCertificateIds:        "ocid1.certificate.oc1..aaa",
+				ServiceAnnotationLoadBalancerListenerVerifyPeerCertificate: "true",
+			},
+			err: "oci.oraclecloud.com/oci-load-balancer-listener-verify-peer-certificate annotation requires the oci.oraclecloud.com/oci-load-balancer-listener-trusted-ca-ids annotation when used with oci.oraclecloud.com/oci-load-balancer-listener-certificate-ids",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			svc := &v1.Service{ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations}}
+			verify, depth, err := getListenerPeerVerification(svc)
+			if err != nil && err.Error() != tc.err {
+				t.Errorf("Expected error %q but got %q", tc.err, err.Error())
+			}
+			if err == nil && tc.err != "" {
+				t.Errorf("Expected error %q but got none", tc.err)
+			}
+			if verify != tc.verify || depth != tc.depth {
+				t.Errorf("Expected %v, %d but got %v, %d", tc.verify, tc.depth, verify, depth)
+			}
+		})
+	}
+}
+
+func Test_getListenersWithPeerVerification(t *testing.T) {
+	svc := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSSLPorts:                      "443",
+				ServiceAnnotationLoadBalancerTLSSecret:                     listenerSecret,
+				ServiceAnnotationLoadBalancerListenerVerifyPeerCertificate: "true",
+				ServiceAnnotationLoadBalancerListenerVerifyDepth:           "2",
+			},
+		},
+		Spec: v1.ServiceSpec{
+			Ports: []v1.ServicePort{
+				{Protocol: v1.ProtocolTCP, Port: int32(443)},
+			},
+		},
+	}
+	sslCfg := &SSLConfig{Ports: sets.NewInt(443), ListenerSSLCertificateName: listenerCertificateName}
+	want := map[string]client.GenericListener{
+		"TCP-443": {
+			Name:                  common.String("TCP-443"),
+			Port:                  common.Int(443),
+			Protocol:              common.String("TCP"),
+			DefaultBackendSetName: common.String("TCP-443"),
+			SslConfiguration: &client.GenericSslConfigurationDetails{
+				CertificateName:       &listenerCertificateName,
+				VerifyDepth:           common.Int(2),
+				VerifyPeerCertificate: common.Bool(true),
+			},
+		},
+	}
+	got, err := getListeners(svc, sslCfg)
+	if err != nil {
+		t.Fatalf("getListeners() got unexpected error %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("getListeners() = %+v, \n want %+v", got, want)
+	}
+}
+
+func TestResolveCertificateNamesRequiresClientCA(t *testing.T) {
+	sslCfg := &SSLConfig{
+		Ports:                         sets.NewInt(443),
+		ListenerSSLSecretName:         listenerSecret,
+		ListenerVerifyPeerCertificate: true,
+		sslSecretReader:               testSSLSecretReader,
+	}
+	want := "SSL Listener Secret /listenersecret has no ca.crt to verify client certificates against"
+	if err := sslCfg.resolveCertificateNames(); err == nil || err.Error() != want {
+		t.Errorf("resolveCertificateNames() got error %v, want %q", err, want)
+	}
+}
+
+func Test_getListenerSSLPolicy(t *testing.T) {
+	testCases := map[string]struct {
+		annotations map[string]string
+		want        *listenerSSLPolicy
+		err         string
+	}{
+		"OCI defaults": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSSLPorts: "443",
+			},
+		},
+		"predefined cipher suite and protocols": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSSLPorts:                 "443",
+				ServiceAnnotationLoadBalancerSSLCipherSuite:           "oci-modern-ssl-cipher-suite-v1",
+				ServiceAnnotationLoadBalancerSSLProtocols:             "TLSv1.2, TLSv1.3",
+				ServiceAnnotationLoadBalancerSSLServerOrderPreference: "enabled",
+			},
+			want: &listenerSSLPolicy{
+				cipherSuiteName:       common.String("oci-modern-ssl-cipher-suite-v1"),
+				protocols:             []string{"TLSv1.2", "TLSv1.3"},
+				serverOrderPreference: "ENABLED",
+			},
+		},
+		"custom cipher suite": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSSLPorts:       "443",
+				ServiceAnnotationLoadBalancerSSLCipherSuite: "compliance",
+				ServiceAnnotationLoadBalancerSSLCiphers:     "ECDHE-RSA-AES256-GCM-SHA384,ECDHE-RSA-AES128-GCM-SHA256",
+			},
+			want: &listenerSSLPolicy{
+				cipherSuiteName: common.String("ccm-compliance"),
+				cipherSuite: &client.GenericSslCipherSuite{
+					Name:    common.String("ccm-compliance"),
+					Ciphers: []string{"ECDHE-RSA-AES256-GCM-SHA384", "ECDHE-RSA-AES128-GCM-SHA256"},
+				},
+			},
+		},
+		"without ssl ports": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSSLProtocols: "TLSv1.2",
+			},
+			err: "SSL cipher suite and protocol annotations require the service.beta.kubernetes.io/oci-load-balancer-ssl-ports annotation on a load balancer",
+		},
+		"unknown predefined cipher suite": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSSLPorts:       "443",
+				ServiceAnnotationLoadBalancerSSLCipherSuite: "compliance",
+			},
+			err: "invalid value: compliance provided for annotation: oci.oraclecloud.com/oci-load-balancer-ssl-cipher-suite, valid predefined cipher suites are " + strings.Join(predefinedSSLCipherSuites.List(), ", "),
+		},
+		"ciphers without cipher suite name": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSSLPorts:   "443",
+				ServiceAnnotationLoadBalancerSSLCiphers: "ECDHE-RSA-AES256-GCM-SHA384",
+			},
+			err: "oci.oraclecloud.com/oci-load-balancer-ssl-ciphers annotation requires the oci.oraclecloud.com/oci-load-balancer-ssl-cipher-suite annotation to name the cipher suite",
+		},
+		"reserved custom cipher suite name": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSSLPorts:       "443",
+				ServiceAnnotationLoadBalancerSSLCipherSuite: "oci-compliance",
+				ServiceAnnotationLoadBalancerSSLCiphers:     "ECDHE-RSA-AES256-GCM-SHA384",
+			},
+			err: "invalid custom cipher suite name: oci-compliance provided for annotation: oci.oraclecloud.com/oci-load-balancer-ssl-cipher-suite, names starting with \"oci-\" are reserved",
+		},
+		"invalid protocol": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSSLPorts:     "443",
+				ServiceAnnotationLoadBalancerSSLProtocols: "SSLv3",
+			},
+			err: "invalid value: SSLv3 provided for annotation: oci.oraclecloud.com/oci-load-balancer-ssl-protocols, valid protocols are TLSv1, TLSv1.1, TLSv1.2, TLSv1.3",
+		},
+		"invalid server order preference": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSSLPorts:                 "443",
+				ServiceAnnotationLoadBalancerSSLServerOrderPreference: "yes",
+			},
+			err: "invalid value: yes provided for annotation: oci.oraclecloud.com/oci-load-balancer-ssl-server-order-preference",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			svc := &v1.Service{ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations}}
+			got, err := getListenerSSLPolicy(svc)
+			if err != nil && err.Error() != tc.err {
+				t.Errorf("Expected error %q but got %q", tc.err, err.Error())
+			}
+			if err == nil && tc.err != "" {
+				t.Errorf("Expected error %q but got none", tc.err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Expected %+v but got %+v", tc.want, got)
+			}
+		})
+	}
+}
+
+func Test_getListenersWithSSLPolicy(t *testing.T) {
+	svc := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSSLPorts:       "443",
+				ServiceAnnotationLoadBalancerTLSSecret:      listenerSecret,
+				ServiceAnnotationLoadBalancerSSLCipherSuite: "oci-modern-ssl-cipher-suite-v1",
+				ServiceAnnotationLoadBalancerSSLProtocols:   "TLSv1.2",
+			},
+		},
+		Spec: v1.ServiceSpec{
+			Ports: []v1.ServicePort{
+				{Protocol: v1.ProtocolTCP, Port: int32(443)},
+			},
+		},
+	}
+	sslCfg := &SSLConfig{Ports: sets.NewInt(443), ListenerSSLCertificateName: listenerCertificateName}
+	want := map[string]client.GenericListener{
+		"TCP-443": {
+			Name:                  common.String("TCP-443"),
+			Port:                  common.Int(443),
+			Protocol:              common.String("TCP"),
+			DefaultBackendSetName: common.String("TCP-443"),
+			SslConfiguration: &client.GenericSslConfigurationDetails{
+				CertificateName:       &listenerCertificateName,
+				VerifyDepth:           common.Int(0),
+				VerifyPeerCertificate: common.Bool(false),
+				CipherSuiteName:       common.String("oci-modern-ssl-cipher-suite-v1"),
+				Protocols:             []string{"TLSv1.2"},
+			},
+		},
+	}
+	got, err := getListeners(svc, sslCfg)
+	if err != nil {
+		t.Fatalf("getListeners() got unexpected error %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("getListeners() = %+v, \n want %+v", got, want)
+	}
+}
+
+func Test_getRuleSets(t *testing.T) {
+	redirectRuleSet := client.GenericRuleSet{
+		Name: common.String("oci_ccm_redirect_http_to_https"),
+		Items: []client.GenericRule{
+			{
+				Action:       "REDIRECT",
+				PathPrefix:   common.String("/"),
+				ResponseCode: common.Int(http.StatusMovedPermanently),
+				RedirectUri: &client.GenericRedirectUri{
+					Protocol: common.String("HTTPS"),
+					Host:     common.String("{host}"),
+					Port:     common.Int(443),
+					Path:     common.String("{path}"),
+					Query:    common.String("{query}"),
+				},
+			},
+		},
+	}
+	httpPorts := []v1.ServicePort{
+		{Protocol: v1.ProtocolTCP, Port: int32(80)},
+		{Protocol: v1.ProtocolTCP, Port: int32(443)},
+	}
+
+	testCases := map[string]struct {
+		annotations map[string]string
+		ports       []v1.ServicePort
+		want        map[string]client.GenericRuleSet
+		err         string
+	}{
+		"no rule sets": {
+			annotations: map[string]string{},
+			ports:       httpPorts,
+		},
+		"redirect disabled": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerRedirectHTTPToHTTPS: "false",
+			},
+			ports: httpPorts,
+		},
+		"redirect to the lowest SSL port": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSSLPorts:            "8443,443",
+				ServiceAnnotationLoadBalancerRedirectHTTPToHTTPS: "true",
+			},
+			ports: httpPorts,
+			want: map[string]client.GenericRuleSet{
+				"oci_ccm_redirect_http_to_https": redirectRuleSet,
+			},
+		},
+		"headers": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerBEProtocol:            "HTTP",
+				ServiceAnnotationLoadBalancerAddRequestHeaders:     "X-Forwarded-Proto: https",
+				ServiceAnnotationLoadBalancerRemoveRequestHeaders:  "X-Debug",
+				ServiceAnnotationLoadBalancerAddResponseHeaders:    "Strict-Transport-Security: max-age=31536000; includeSubDomains",
+				ServiceAnnotationLoadBalancerRemoveResponseHeaders: "Server, X-Powered-By",
+			},
+			ports: httpPorts,
+			want: map[string]client.GenericRuleSet{
+				"oci_ccm_http_headers": {
+					Name: common.String("oci_ccm_http_headers"),
+					Items: []client.GenericRule{
+						{Action: "ADD_HTTP_REQUEST_HEADER", Header: common.String("X-Forwarded-Proto"), Value: common.String("https")},
+						{Action: "REMOVE_HTTP_REQUEST_HEADER", Header: common.String("X-Debug")},
+						{Action: "ADD_HTTP_RESPONSE_HEADER", Header: common.String("Strict-Transport-Security"), Value: common.String("max-age=31536000; includeSubDomains")},
+						{Action: "REMOVE_HTTP_RESPONSE_HEADER", Header: common.String("Server")},
+						{Action: "REMOVE_HTTP_RESPONSE_HEADER", Header: common.String("X-Powered-By")},
+					},
+				},
+			},
+		},
+		"invalid redirect": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerRedirectHTTPToHTTPS: "yes",
+			},
+			ports: httpPorts,
+			err:   "invalid value: yes provided for annotation: oci.oraclecloud.com/oci-load-balancer-redirect-http-to-https",
+		},
+		"redirect without ssl ports": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerRedirectHTTPToHTTPS: "true",
+			},
+			ports: httpPorts,
+			err:   "oci.oraclecloud.com/oci-load-balancer-redirect-http-to-https annotation requires the service.beta.kubernetes.io/oci-load-balancer-ssl-ports annotation on a load balancer",
+		},
+		"redirect of an SSL port": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSSLPorts:            "80,443",
+				ServiceAnnotationLoadBalancerRedirectHTTPToHTTPS: "true",
+			},
+			ports: httpPorts,
+			err:   "oci.oraclecloud.com/oci-load-balancer-redirect-http-to-https annotation requires port 80 not to be an SSL port",
+		},
+		"redirect without port 80": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSSLPorts:            "443",
+				ServiceAnnotationLoadBalancerRedirectHTTPToHTTPS: "true",
+			},
+			ports: []v1.ServicePort{{Protocol: v1.ProtocolTCP, Port: int32(443)}},
+			err:   "oci.oraclecloud.com/oci-load-balancer-redirect-http-to-https annotation requires the service to expose port 80",
+		},
+		"headers on TCP listeners": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerRemoveRequestHeaders: "X-Debug",
+			},
+			ports: httpPorts,
+			err:   "HTTP header annotations require the service.beta.kubernetes.io/oci-load-balancer-backend-protocol annotation to be HTTP on a load balancer",
+		},
+		"header without value": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerBEProtocol:         "HTTP",
+				ServiceAnnotationLoadBalancerAddResponseHeaders: "X-Frame-Options",
+			},
+			ports: httpPorts,
+			err:   "invalid header: X-Frame-Options provided for annotation: oci.oraclecloud.com/oci-load-balancer-add-response-headers, headers must be of the form Name:Value",
+		},
+		"invalid header name": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerBEProtocol:        "HTTP",
+				ServiceAnnotationLoadBalancerAddRequestHeaders: "X Forwarded:https",
+			},
+			ports: httpPorts,
+			err:   "invalid header name: \"X Forwarded\" provided for annotation: oci.oraclecloud.com/oci-load-balancer-add-request-headers",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			svc := &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations},
+				Spec:       v1.ServiceSpec{Ports: tc.ports},
+			}
+			got, err := getRuleSets(svc)
+			if err != nil && err.Error() != tc.err {
+				t.Errorf("Expected error %q but got %q", tc.err, err.Error())
+			}
+			if err == nil && tc.err != "" {
+				t.Errorf("Expected error %q but got none", tc.err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Expected %+v but got %+v", tc.want, got)
+			}
+		})
+	}
+}
+
+func Test_getListenersWithRuleSets(t *testing.T) {
+	svc := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSSLPorts:             "443",
+				ServiceAnnotationLoadBalancerBEProtocol:           "HTTP",
+				ServiceAnnotationLoadBalancerRedirectHTTPToHTTPS:  "true",
+				ServiceAnnotationLoadBalancerRemoveRequestHeaders: "X-Debug",
+			},
+		},
+		Spec: v1.ServiceSpec{
+			Ports: []v1.ServicePort{
+				{Protocol: v1.ProtocolTCP, Port: int32(80)},
+				{Protocol: v1.ProtocolTCP, Port: int32(443)},
+			},
+		},
+	}
+	want := map[string]client.GenericListener{
+		"HTTP-80": {
+			Name:                  common.String("HTTP-80"),
+			Port:                  common.Int(80),
+			Protocol:              common.String("HTTP"),
+			DefaultBackendSetName: common.String("TCP-80"),
+			RuleSetNames:          []string{"oci_ccm_redirect_http_to_https"},
+		},
+		"HTTP-443": {
+			Name:                  common.String("HTTP-443"),
+			Port:                  common.Int(443),
+			Protocol:              common.String("HTTP"),
+			DefaultBackendSetName: common.String("TCP-443"),
+			RuleSetNames:          []string{"oci_ccm_http_headers"},
+		},
+	}
+	got, err := getListeners(svc, nil)
+	if err != nil {
+		t.Fatalf("getListeners() got unexpected error %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("getListeners() = %+v, \n want %+v", got, want)
+	}
+}
+
+func Test_getRoutingPolicies(t *testing.T) {
+	httpPorts := []v1.ServicePort{
+		{Protocol: v1.ProtocolTCP, Port: int32(80)},
+		{Protocol: v1.ProtocolTCP, Port: int32(8080)},
+		{Protocol: v1.ProtocolTCP, Port: int32(8081)},
+	}
+
+	testCases := map[string]struct {
+		annotations map[string]string
+		want        map[string]client.GenericRoutingPolicy
+		err         string
+	}{
+		"no routes": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerBEProtocol: "HTTP",
+			},
+		},
+		"routes ordered by specificity": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerBEProtocol: "HTTP",
+				ServiceAnnotationLoadBalancerRoutes:     "/static=8081, app.example.com=8080, api.example.com/v1=8081, /static/images=8080",
+			},
+			want: map[string]client.GenericRoutingPolicy{
+				"oci_ccm_routes": {
+					Name: common.String("oci_ccm_routes"),
+					Rules: []client.GenericRoutingRule{
+						{
+							Name:           common.String("route_0"),
+							Condition:      common.String("all(http.request.headers[(i 'host')] eq (i 'api.example.com'), http.request.url.path sw '/v1')"),
+							BackendSetName: common.String("TCP-8081"),
+						},
+						{
+							Name:           common.String("route_1"),
+							Condition:      common.String("http.request.headers[(i 'host')] eq (i 'app.example.com')"),
+							BackendSetName: common.String("TCP-8080"),
+						},
+						{
+							Name:           common.String("route_2"),
+							Condition:      common.String("http.request.url.path sw '/static/images'"),
+							BackendSetName: common.String("TCP-8080"),
+						},
+						{
+							Name:           common.String("route_3"),
+							Condition:      common.String("http.request.url.path sw '/static'"),
+							BackendSetName: common.String("TCP-8081"),
+						},
+					},
+				},
+			},
+		},
+		"TCP listeners": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerRoutes: "/api=8080",
+			},
+			err: "oci.oraclecloud.com/oci-load-balancer-routes annotation requires the service.beta.kubernetes.io/oci-load-balancer-backend-protocol annotation to be HTTP on a load balancer",
+		},
+		"network load balancer": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerType:       NLB,
+				ServiceAnnotationLoadBalancerBEProtocol: "HTTP",
+				ServiceAnnotationLoadBalancerRoutes:     "/api=8080",
+			},
+			err: "oci.oraclecloud.com/oci-load-balancer-routes annotation requires the service.beta.kubernetes.io/oci-load-balancer-backend-protocol annotation to be HTTP on a load balancer",
+		},
+		"missing port": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerBEProtocol: "HTTP",
+				ServiceAnnotationLoadBalancerRoutes:     "/api",
+			},
+			err: "invalid route: /api provided for annotation: oci.oraclecloud.com/oci-load-balancer-routes, routes must be of the form [host][/path]=port",
+		},
+		"missing host and path": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerBEProtocol: "HTTP",
+				ServiceAnnotationLoadBalancerRoutes:     "=8080",
+			},
+			err: "invalid route: =8080 provided for annotation: oci.oraclecloud.com/oci-load-balancer-routes, routes must be of the form [host][/path]=port",
+		},
+		"invalid host": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerBEProtocol: "HTTP",
+				ServiceAnnotationLoadBalancerRoutes:     "App_Example/api=8080",
+			},
+			err: "invalid host: App_Example provided for annotation: oci.oraclecloud.com/oci-load-balancer-routes",
+		},
+		"invalid path": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerBEProtocol: "HTTP",
+				ServiceAnnotationLoadBalancerRoutes:     "/it's=8080",
+			},
+			err: "invalid path: /it's provided for annotation: oci.oraclecloud.com/oci-load-balancer-routes",
+		},
+		"port not exposed": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerBEProtocol: "HTTP",
+				ServiceAnnotationLoadBalancerRoutes:     "/api=9090",
+			},
+			err: "route: /api=9090 provided for annotation: oci.oraclecloud.com/oci-load-balancer-routes refers to port 9090 which is not exposed by the service",
+		},
+		"duplicate route": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerBEProtocol: "HTTP",
+				ServiceAnnotationLoadBalancerRoutes:     "/api=8080,/api=8081",
+			},
+			err: "duplicate route: /api=8081 provided for annotation: oci.oraclecloud.com/oci-load-balancer-routes",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			svc := &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations},
+				Spec:       v1.ServiceSpec{Ports: httpPorts},
+			}
+			got, err := getRoutingPolicies(svc)
+			if err != nil && err.Error() != tc.err {
+				t.Errorf("Expected error %q but got %q", tc.err, err.Error())
+			}
+			if err == nil && tc.err != "" {
+				t.Errorf("Expected error %q but got none", tc.err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Expected %+v but got %+v", tc.want, got)
+			}
+		})
+	}
+}
+
+func Test_getListenersWithRoutingPolicy(t *testing.T) {
+	svc := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				ServiceAnnotationLoadBalancerBEProtocol: "HTTP",
+				ServiceAnnotationLoadBalancerRoutes:     "api.example.com=8080",
+			},
+		},
+		Spec: v1.ServiceSpec{
+			Ports: []v1.ServicePort{
+				{Protocol: v1.ProtocolTCP, Port: int32(80)},
+				{Protocol: v1.ProtocolTCP, Port: int32(8080)},
+			},
+		},
+	}
+	want := map[string]client.GenericListener{
+		"HTTP-80": {
+			Name:                  common.String("HTTP-80"),
+			Port:                  common.Int(80),
+			Protocol:              common.String("HTTP"),
+			DefaultBackendSetName: common.String("TCP-80"),
+			RoutingPolicyName:     common.String("oci_ccm_routes"),
+		},
+		"HTTP-8080": {
+			Name:                  common.String("HTTP-8080"),
+			Port:                  common.Int(8080),
+			Protocol:              common.String("HTTP"),
+			DefaultBackendSetName: common.String("TCP-8080"),
+			RoutingPolicyName:     common.String("oci_ccm_routes"),
+		},
+	}
+	got, err := getListeners(svc, nil)
+	if err != nil {
+		t.Fatalf("getListeners() got unexpected error %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("getListeners() = %+v, \n want %+v", got, want)
+	}
+}
+
+func Test_getLoadBalancerIP(t *testing.T) {
+	testCases := map[string]struct {
+		annotations    map[string]string
+		loadBalancerIP string
+		want           string
+		err            string
+	}{
+		"no IP": {},
+		"public reserved IP": {
+			loadBalancerIP: "129.0.0.1",
+			want:           "129.0.0.1",
+		},
+		"private IP of an internal network load balancer": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerType:            NLB,
+				ServiceAnnotationNetworkLoadBalancerInternal: "true",
+			},
+			loadBalancerIP: "10.0.10.10",
+			want:           "10.0.10.10",
+		},
+		"private IPv6 of an internal network load balancer": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerType:            NLB,
+				ServiceAnnotationNetworkLoadBalancerInternal: "true",
+			},
+			loadBalancerIP: "fd00::10",
+			err:            "invalid service: the private IP address of an internal network load balancer has to be an IPv4 address",
+		},
+		"private IP of an internal load balancer": {
+			annotations:    map[string]string{ServiceAnnotationLoadBalancerInternal: "true"},
+			loadBalancerIP: "10.0.10.10",
+			err:            "invalid service: a private IP address can only be assigned to an internal network load balancer",
+		},
+		"private IP OCID": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerType:            NLB,
+				ServiceAnnotationNetworkLoadBalancerInternal: "true",
+			},
+			loadBalancerIP: "ocid1.privateip.oc1.reserved",
+			err:            `invalid value "ocid1.privateip.oc1.reserved" provided for LoadBalancerIP`,
+		},
+		"invalid IP": {
+			annotations:    map[string]string{ServiceAnnotationLoadBalancerInternal: "true"},
+			loadBalancerIP: "10.0.10",
+			err:            `invalid value "10.0.10" provided for LoadBalancerIP`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			svc := &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations},
+				Spec:       v1.ServiceSpec{LoadBalancerIP: tc.loadBalancerIP},
+			}
+			got, err := getLoadBalancerIP(svc)
+			if err != nil && err.Error() != tc.err {
+				t.Errorf("Expected error %q but got %q", tc.err, err.Error())
+			}
+			if err == nil && tc.err != "" {
+				t.Errorf("Expected error %q but got none", tc.err)
+			}
+			if got != tc.want {
+				t.Errorf("Expected %q but got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func Test_getSecurityListManagementMode(t *testing.T) {
+	testCases := map[string]struct {
+		service  *v1.Service
+		expected string
+	}{
+		"defaults - lb": {
+			service: &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{},
+				},
+			},
+			expected: "All",
+		},
+		"defaults - nlb": {
+			service: &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerType: "nlb",
+					},
+				},
+			},
+			expected: "None",
+		},
+		"lb mode None": {
+			service: &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerSecurityListManagementMode: "None",
+					},
+				},
+			},
+			expected: "None",
+		},
+		"lb mode all": {
+			service: &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerSecurityListManagementMode: "All",
+					},
+				},
+			},
+			expected: "All",
+		},
+		"lb mode frontend": {
+			service: &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerSecurityListManagementMode: "Frontend",
+					},
+				},
+			},
+			expected: "Frontend",
+		},
+		"defaults-nlb": {
+			service: &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerType: "nlb",
+					},
+				},
+			},
+			expected: "None",
+		},
+		"nlb mode None": {
+			service: &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
 					Annotations: map[string]string{
 						ServiceAnnotationLoadBalancerType:                              "nlb",
 						ServiceAnnotationNetworkLoadBalancerSecurityListManagementMode: "None",
@@ -5401,7 +6529,7 @@ func Test_validateService(t *testing.T) {
 			},
 			err: fmt.Errorf("Security list management mode can only be 'None' for UDP protocol"),
 		},
-		"session affinity not none": {
+		"nlb session affinity client ip": {
 			service: &v1.Service{
 				Spec: v1.ServiceSpec{
 					SessionAffinity: v1.ServiceAffinityClientIP,
@@ -5419,7 +6547,20 @@ func Test_validateService(t *testing.T) {
 					},
 				},
 			},
-			err: fmt.Errorf("OCI only supports SessionAffinity \"None\" currently"),
+			err: nil,
+		},
+		"lb cookie session persistence with tcp listeners": {
+			service: &v1.Service{
+				Spec: v1.ServiceSpec{
+					SessionAffinity: v1.ServiceAffinityClientIP,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerSessionPersistence: "lb-cookie",
+					},
+				},
+			},
+			err: fmt.Errorf("oci.oraclecloud.com/loadbalancer-session-persistence annotation requires the service.beta.kubernetes.io/oci-load-balancer-backend-protocol annotation to be set to HTTP"),
 		},
 	}
 	for name, tc := range testCases {
@@ -5438,6 +6579,183 @@ func Test_validateService(t *testing.T) {
 	}
 }
 
+func Test_getLoadBalancerPolicy(t *testing.T) {
+	testCases := map[string]struct {
+		service *v1.Service
+		want    string
+		err     error
+	}{
+		"lb defaults": {
+			service: &v1.Service{},
+			want:    DefaultLoadBalancerPolicy,
+		},
+		"lb session affinity client ip": {
+			service: &v1.Service{
+				Spec: v1.ServiceSpec{SessionAffinity: v1.ServiceAffinityClientIP},
+			},
+			want: IPHashLoadBalancerPolicy,
+		},
+		"lb session affinity client ip with cookie persistence": {
+			service: &v1.Service{
+				Spec: v1.ServiceSpec{SessionAffinity: v1.ServiceAffinityClientIP},
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerPolicy:             LeastConnectionsLoadBalancerPolicy,
+						ServiceAnnotationLoadBalancerSessionPersistence: SessionPersistenceLBCookie,
+					},
+				},
+			},
+			want: LeastConnectionsLoadBalancerPolicy,
+		},
+		"lb session affinity client ip with conflicting policy": {
+			service: &v1.Service{
+				Spec: v1.ServiceSpec{SessionAffinity: v1.ServiceAffinityClientIP},
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerPolicy: RoundRobinLoadBalancerPolicy,
+					},
+				},
+			},
+			err: fmt.Errorf("loadbalancer policy \"ROUND_ROBIN\" conflicts with ClientIP session affinity which requires \"IP_HASH\""),
+		},
+		"nlb session affinity client ip": {
+			service: &v1.Service{
+				Spec: v1.ServiceSpec{SessionAffinity: v1.ServiceAffinityClientIP},
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerType: NLB,
+					},
+				},
+			},
+			want: NetworkLoadBalancingPolicyTwoTuple,
+		},
+		"nlb session affinity client ip with conflicting policy": {
+			service: &v1.Service{
+				Spec: v1.ServiceSpec{SessionAffinity: v1.ServiceAffinityClientIP},
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerType:                 NLB,
+						ServiceAnnotationNetworkLoadBalancerBackendPolicy: NetworkLoadBalancingPolicyFiveTuple,
+					},
+				},
+			},
+			err: fmt.Errorf("network loadbalancer policy \"FIVE_TUPLE\" conflicts with ClientIP session affinity which requires \"TWO_TUPLE\""),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := getLoadBalancerPolicy(tc.service)
+			if tc.err != nil && (err == nil || err.Error() != tc.err.Error()) {
+				t.Errorf("Expected \n%+v\nbut got\n%+v", tc.err, err)
+			}
+			if tc.err == nil && err != nil {
+				t.Errorf("Unexpected error %+v", err)
+			}
+			if got != tc.want {
+				t.Errorf("Expected policy %q but got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func Test_getSessionPersistence(t *testing.T) {
+	testCases := map[string]struct {
+		service       *v1.Service
+		wantAppCookie *client.GenericSessionPersistenceConfiguration
+		wantLBCookie  *client.GenericLbCookieSessionPersistenceConfiguration
+		err           error
+	}{
+		"no session persistence": {
+			service: &v1.Service{},
+		},
+		"lb cookie with client ip timeout": {
+			service: &v1.Service{
+				Spec: v1.ServiceSpec{
+					SessionAffinity: v1.ServiceAffinityClientIP,
+					SessionAffinityConfig: &v1.SessionAffinityConfig{
+						ClientIP: &v1.ClientIPConfig{TimeoutSeconds: pointer.Int32(3600)},
+					},
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerBEProtocol:                        "HTTP",
+						ServiceAnnotationLoadBalancerSessionPersistence:                "lb-cookie",
+						ServiceAnnotationLoadBalancerSessionPersistenceDisableFallback: "true",
+					},
+				},
+			},
+			wantLBCookie: &client.GenericLbCookieSessionPersistenceConfiguration{
+				DisableFallback: common.Bool(true),
+				MaxAgeInSeconds: common.Int(3600),
+			},
+		},
+		"app cookie": {
+			service: &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerBEProtocol:                   "HTTP",
+						ServiceAnnotationLoadBalancerSessionPersistence:           "app-cookie",
+						ServiceAnnotationLoadBalancerSessionPersistenceCookieName: "JSESSIONID",
+					},
+				},
+			},
+			wantAppCookie: &client.GenericSessionPersistenceConfiguration{
+				CookieName: common.String("JSESSIONID"),
+			},
+		},
+		"app cookie without cookie name": {
+			service: &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerBEProtocol:         "HTTP",
+						ServiceAnnotationLoadBalancerSessionPersistence: "app-cookie",
+					},
+				},
+			},
+			err: fmt.Errorf("oci.oraclecloud.com/loadbalancer-session-persistence-cookie-name annotation is required for app-cookie session persistence"),
+		},
+		"invalid session persistence": {
+			service: &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerBEProtocol:         "HTTP",
+						ServiceAnnotationLoadBalancerSessionPersistence: "source-ip",
+					},
+				},
+			},
+			err: fmt.Errorf("invalid value: source-ip provided for annotation: oci.oraclecloud.com/loadbalancer-session-persistence. Supported values are \"lb-cookie\" and \"app-cookie\""),
+		},
+		"nlb session persistence": {
+			service: &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerType:               NLB,
+						ServiceAnnotationLoadBalancerSessionPersistence: "lb-cookie",
+					},
+				},
+			},
+			err: fmt.Errorf("oci.oraclecloud.com/loadbalancer-session-persistence annotation is not supported for network load balancers"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			appCookie, lbCookie, err := getSessionPersistence(tc.service)
+			if tc.err != nil && (err == nil || err.Error() != tc.err.Error()) {
+				t.Errorf("Expected \n%+v\nbut got\n%+v", tc.err, err)
+			}
+			if tc.err == nil && err != nil {
+				t.Errorf("Unexpected error %+v", err)
+			}
+			if !reflect.DeepEqual(appCookie, tc.wantAppCookie) {
+				t.Errorf("Expected app cookie persistence\n%+v\nbut got\n%+v", tc.wantAppCookie, appCookie)
+			}
+			if !reflect.DeepEqual(lbCookie, tc.wantLBCookie) {
+				t.Errorf("Expected lb cookie persistence\n%+v\nbut got\n%+v", tc.wantLBCookie, lbCookie)
+			}
+		})
+	}
+}
+
 func Test_getListenersNetworkLoadBalancer(t *testing.T) {
 	testOneListenerName := "TCP_AND_UDP-67"
 	testOneBackendSetName := "TCP_AND_UDP-67"
@@ -6458,3 +7776,60 @@ func Test_getResourceTrackingSysTagsFromConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_getBackendDrainPeriod(t *testing.T) {
+	testCases := map[string]struct {
+		annotations map[string]string
+		expected    time.Duration
+		err         error
+	}{
+		"no annotation": {
+			annotations: map[string]string{},
+			expected:    0,
+		},
+		"drain period": {
+			annotations: map[string]string{
+				ServiceAnnotationBackendDrainPeriod: "300",
+			},
+			expected: 5 * time.Minute,
+		},
+		"negative drain period": {
+			annotations: map[string]string{
+				ServiceAnnotationBackendDrainPeriod: "-1",
+			},
+			err: fmt.Errorf("invalid value: -1 provided for annotation: oci.oraclecloud.com/backend-drain-period"),
+		},
+		"drain period too long": {
+			annotations: map[string]string{
+				ServiceAnnotationBackendDrainPeriod: "3601",
+			},
+			err: fmt.Errorf("invalid value: 3601 provided for annotation: oci.oraclecloud.com/backend-drain-period"),
+		},
+		"drain period with unit": {
+			annotations: map[string]string{
+				ServiceAnnotationBackendDrainPeriod: "5m",
+			},
+			err: fmt.Errorf("invalid value: 5m provided for annotation: oci.oraclecloud.com/backend-drain-period"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			svc := &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: tc.annotations,
+				},
+			}
+			result, err := getBackendDrainPeriod(svc)
+			if err != nil && err.Error() != tc.err.Error() {
+				t.Errorf("Expected error\n%+v\nbut got\n%+v", tc.err, err)
+			}
+			if err == nil && tc.err != nil {
+				t.Errorf("Expected error\n%+v\nbut got none", tc.err)
+			}
+			if result != tc.expected {
+				t.Errorf("Expected drain period\n%+v\nbut got\n%+v", tc.expected, result)
+			}
+		})
+	}
+}
@@ -1183,3 +1183,265 @@ func assertError(actual, expected error) bool {
 	}
 	return actual.Error() == expected.Error()
 }
+
+func Test_deferredRoutingPolicies(t *testing.T) {
+	spec := &LBSpec{
+		service: &v1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					ServiceAnnotationLoadBalancerBEProtocol: "HTTP",
+					ServiceAnnotationLoadBalancerRoutes:     "/api=8080",
+				},
+			},
+			Spec: v1.ServiceSpec{
+				Ports: []v1.ServicePort{
+					{Protocol: v1.ProtocolTCP, Port: int32(80)},
+					{Protocol: v1.ProtocolTCP, Port: int32(8080)},
+				},
+			},
+		},
+		Listeners: map[string]client.GenericListener{
+			"HTTP-80":   {Name: common.String("HTTP-80"), RoutingPolicyName: common.String("oci_ccm_routes")},
+			"HTTP-8080": {Name: common.String("HTTP-8080"), RoutingPolicyName: common.String("oci_ccm_routes")},
+		},
+	}
+	lb := &client.GenericLoadBalancer{
+		BackendSets: map[string]client.GenericBackendSetDetails{"TCP-80": {}},
+		Listeners: map[string]client.GenericListener{
+			"HTTP-80": {Name: common.String("HTTP-80"), RoutingPolicyName: common.String("old")},
+		},
+	}
+
+	if hasRoutedBackendSets(lb, spec) {
+		t.Errorf("hasRoutedBackendSets() = true without backend set TCP-8080")
+	}
+	want := map[string]client.GenericListener{
+		"HTTP-80":   {Name: common.String("HTTP-80"), RoutingPolicyName: common.String("old")},
+		"HTTP-8080": {Name: common.String("HTTP-8080")},
+	}
+	if got := withActualRoutingPolicies(lb, spec).Listeners; !reflect.DeepEqual(got, want) {
+		t.Errorf("withActualRoutingPolicies() listeners = %+v, want %+v", got, want)
+	}
+	if *spec.Listeners["HTTP-8080"].RoutingPolicyName != "oci_ccm_routes" {
+		t.Errorf("withActualRoutingPolicies() modified the spec")
+	}
+
+	lb.BackendSets["TCP-8080"] = client.GenericBackendSetDetails{}
+	if !hasRoutedBackendSets(lb, spec) {
+		t.Errorf("hasRoutedBackendSets() = false with all backend sets")
+	}
+}
+
+func Test_validatePrivateIpInSubnets(t *testing.T) {
+	testCases := map[string]struct {
+		ipAddress string
+		subnetIDs []string
+		err       string
+	}{
+		"address in the subnet": {
+			ipAddress: "10.0.10.10",
+			subnetIDs: []string{"reserved-ip-subnet"},
+		},
+		"address outside of the subnet": {
+			ipAddress: "10.0.11.10",
+			subnetIDs: []string{"reserved-ip-subnet"},
+			err:       "private IP 10.0.11.10 is not in the subnets of the load balancer",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validatePrivateIpInSubnets(context.Background(), tc.ipAddress, tc.subnetIDs, &MockVirtualNetworkClient{})
+			if err != nil && err.Error() != tc.err {
+				t.Errorf("Expected error %q but got %q", tc.err, err.Error())
+			}
+			if err == nil && tc.err != "" {
+				t.Errorf("Expected error %q but got none", tc.err)
+			}
+		})
+	}
+}
+func Test_getUnusedSSLCertificates(t *testing.T) {
+	lb := &client.GenericLoadBalancer{
+		Id: common.String("ocid1.loadbalancer.oc1..aaaaa"),
+		Certificates: map[string]client.GenericCertificate{
+			"oci-ccm-listener-6e6227668180d555": {},
+			"oci-ccm-listener-fd6633921fe279eb": {},
+			"oci-ccm-backend-a5aa9c2b9e6681c3":  {},
+			"oci-ccm-removed-0d404de8a8aa4a8c":  {},
+			"listener":                          {},
+			"backend":                           {},
+			"user-uploaded":                     {},
+		},
+	}
+	spec := &LBSpec{
+		SSLConfig: &SSLConfig{
+			ListenerSSLSecretName:        "listener",
+			ListenerSSLCertificateName:   "oci-ccm-listener-fd6633921fe279eb",
+			BackendSetSSLSecretName:      "backend",
+			BackendSetSSLCertificateName: "oci-ccm-backend-a5aa9c2b9e6681c3",
+		},
+		Listeners: map[string]client.GenericListener{
+			"TCP-443": {
+				SslConfiguration: &client.GenericSslConfigurationDetails{
+					CertificateName: common.String("oci-ccm-listener-fd6633921fe279eb"),
+				},
+			},
+			"TCP-80": {},
+		},
+		BackendSets: map[string]client.GenericBackendSetDetails{
+			"TCP-443": {
+				SslConfiguration: &client.GenericSslConfigurationDetails{
+					CertificateName: common.String("oci-ccm-backend-a5aa9c2b9e6681c3"),
+				},
+			},
+		},
+	}
+
+	want := []string{"backend", "listener", "oci-ccm-listener-6e6227668180d555", "oci-ccm-removed-0d404de8a8aa4a8c"}
+	if got := getUnusedSSLCertificates(lb, spec); !reflect.DeepEqual(got, want) {
+		t.Errorf("getUnusedSSLCertificates() = %v, want %v", got, want)
+	}
+}
+
+func Test_getUnusedSSLCipherSuites(t *testing.T) {
+	lb := &client.GenericLoadBalancer{
+		Id: common.String("ocid1.loadbalancer.oc1..aaaaa"),
+		SslCipherSuites: map[string]client.GenericSslCipherSuite{
+			"ccm-compliance":                  {},
+			"ccm-removed":                     {},
+			"user-suite":                      {},
+			"oci-default-ssl-cipher-suite-v1": {},
+		},
+	}
+	spec := &LBSpec{
+		Listeners: map[string]client.GenericListener{
+			"TCP-443": {
+				SslConfiguration: &client.GenericSslConfigurationDetails{
+					CipherSuiteName: common.String("ccm-compliance"),
+				},
+			},
+			"TCP-80": {},
+		},
+	}
+
+	want := []string{"ccm-removed"}
+	if got := getUnusedSSLCipherSuites(lb, spec); !reflect.DeepEqual(got, want) {
+		t.Errorf("getUnusedSSLCipherSuites() = %v, want %v", got, want)
+	}
+}
+
+func Test_getUnusedRuleSets(t *testing.T) {
+	lb := &client.GenericLoadBalancer{
+		Id: common.String("ocid1.loadbalancer.oc1..aaaaa"),
+		RuleSets: map[string]client.GenericRuleSet{
+			httpRedirectRuleSetName: {},
+			httpHeaderRuleSetName:   {},
+			"user_rule_set":         {},
+		},
+	}
+	ruleSets := map[string]client.GenericRuleSet{
+		httpHeaderRuleSetName: {Name: common.String(httpHeaderRuleSetName)},
+	}
+
+	want := []string{httpRedirectRuleSetName}
+	if got := getUnusedRuleSets(lb, ruleSets); !reflect.DeepEqual(got, want) {
+		t.Errorf("getUnusedRuleSets() = %v, want %v", got, want)
+	}
+}
+
+func Test_getUnusedRoutingPolicies(t *testing.T) {
+	lb := &client.GenericLoadBalancer{
+		Id: common.String("ocid1.loadbalancer.oc1..aaaaa"),
+		RoutingPolicies: map[string]client.GenericRoutingPolicy{
+			routingPolicyName: {},
+			"user_routes":     {},
+		},
+	}
+
+	want := []string{routingPolicyName}
+	if got := getUnusedRoutingPolicies(lb, map[string]client.GenericRoutingPolicy{}); !reflect.DeepEqual(got, want) {
+		t.Errorf("getUnusedRoutingPolicies() = %v, want %v", got, want)
+	}
+}
+
+func Test_drainRemovedBackends(t *testing.T) {
+	drainingSince := time.Now().Add(-10 * time.Second).Unix()
+	newLoadBalancer := func(id string) *client.GenericLoadBalancer {
+		return &client.GenericLoadBalancer{
+			Id: common.String(id),
+			BackendSets: map[string]client.GenericBackendSetDetails{
+				"TCP-80": {
+					Backends: []client.GenericBackend{
+						{IpAddress: common.String("10.0.0.1"), Port: common.Int(30080)},
+						{IpAddress: common.String("10.0.0.2"), Port: common.Int(30080), Drain: common.Bool(true)},
+					},
+				},
+				"TCP-443": {
+					Backends: []client.GenericBackend{
+						{IpAddress: common.String("10.0.0.1"), Port: common.Int(30443)},
+						{IpAddress: common.String("10.0.0.2"), Port: common.Int(30443)},
+					},
+				},
+			},
+			FreeformTags: map[string]string{backendDrainTagKey: fmt.Sprintf("10.0.0.2=%d", drainingSince)},
+		}
+	}
+	spec := &LBSpec{
+		service:            &v1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test"}},
+		BackendDrainPeriod: time.Minute,
+	}
+	newActions := func() []Action {
+		return []Action{
+			&BackendSetAction{name: "TCP-80", actionType: Update, BackendSet: client.GenericBackendSetDetails{
+				Backends: []client.GenericBackend{{IpAddress: common.String("10.0.0.1"), Port: common.Int(30080)}},
+			}},
+			&BackendSetAction{name: "TCP-443", actionType: Update, BackendSet: client.GenericBackendSetDetails{
+				Backends: []client.GenericBackend{{IpAddress: common.String("10.0.0.1"), Port: common.Int(30443)}},
+			}},
+		}
+	}
+
+	var resyncDelay time.Duration
+	clb := &CloudLoadBalancerProvider{
+		lbClient: &MockLoadBalancerClient{},
+		logger:   zap.S(),
+		resyncBackendsAfter: func(service *v1.Service, delay time.Duration) {
+			resyncDelay = delay
+		},
+	}
+
+	t.Run("removed backends drain", func(t *testing.T) {
+		lb := newLoadBalancer("ocid1.loadbalancer.oc1..aaaaa")
+		result := clb.drainRemovedBackends(context.Background(), lb, spec, newActions())
+
+		// The backend draining already needs no update, the other backend of
+		// the node starts draining along with it.
+		if len(result) != 1 || result[0].Name() != "TCP-443" {
+			t.Fatalf("drainRemovedBackends() actions = %v, want the update of TCP-443", result)
+		}
+		backends := result[0].(*BackendSetAction).BackendSet.Backends
+		if len(backends) != 2 || !toBool(backends[1].Drain) {
+			t.Errorf("drainRemovedBackends() backends = %+v, want the removed backend draining", backends)
+		}
+		if tag := lb.FreeformTags[backendDrainTagKey]; tag != fmt.Sprintf("10.0.0.2=%d", drainingSince) {
+			t.Errorf("drainRemovedBackends() drain tag = %q", tag)
+		}
+		if resyncDelay <= 0 || resyncDelay > 50*time.Second {
+			t.Errorf("drainRemovedBackends() scheduled resync in %s, want at most 50s", resyncDelay)
+		}
+	})
+
+	t.Run("removed backends are removed when the drain tag cannot be updated", func(t *testing.T) {
+		resyncDelay = 0
+		lb := newLoadBalancer("work request fail")
+		lb.FreeformTags = nil
+		actions := newActions()
+		result := clb.drainRemovedBackends(context.Background(), lb, spec, actions)
+		if !reflect.DeepEqual(result, actions) {
+			t.Errorf("drainRemovedBackends() actions = %v, want %v", result, actions)
+		}
+		if resyncDelay != 0 {
+			t.Errorf("drainRemovedBackends() scheduled resync in %s, want none", resyncDelay)
+		}
+	})
+}
@@ -22,6 +22,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/oracle/oci-cloud-controller-manager/pkg/metrics"
 
@@ -30,6 +31,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
+	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
 )
 
@@ -222,6 +224,42 @@ func getHealthCheckerChanges(actual *client.GenericHealthChecker, desired *clien
 	return healthCheckerChanges
 }
 
+func getSessionPersistenceChanges(actual client.GenericBackendSetDetails, desired client.GenericBackendSetDetails) []string {
+	var sessionPersistenceChanges []string
+
+	actualAppCookie, desiredAppCookie := actual.SessionPersistenceConfiguration, desired.SessionPersistenceConfiguration
+	if (actualAppCookie == nil) != (desiredAppCookie == nil) {
+		return append(sessionPersistenceChanges, fmt.Sprintf(changeFmtStr, "BackEndSet:SessionPersistenceConfiguration", actualAppCookie != nil, desiredAppCookie != nil))
+	}
+	if actualAppCookie != nil {
+		if toString(actualAppCookie.CookieName) != toString(desiredAppCookie.CookieName) {
+			sessionPersistenceChanges = append(sessionPersistenceChanges, fmt.Sprintf(changeFmtStr, "BackEndSet:SessionPersistenceConfiguration:CookieName", toString(actualAppCookie.CookieName), toString(desiredAppCookie.CookieName)))
+		}
+		if toBool(actualAppCookie.DisableFallback) != toBool(desiredAppCookie.DisableFallback) {
+			sessionPersistenceChanges = append(sessionPersistenceChanges, fmt.Sprintf(changeFmtStr, "BackEndSet:SessionPersistenceConfiguration:DisableFallback", toBool(actualAppCookie.DisableFallback), toBool(desiredAppCookie.DisableFallback)))
+		}
+	}
+
+	actualLBCookie, desiredLBCookie := actual.LbCookieSessionPersistenceConfiguration, desired.LbCookieSessionPersistenceConfiguration
+	if (actualLBCookie == nil) != (desiredLBCookie == nil) {
+		return append(sessionPersistenceChanges, fmt.Sprintf(changeFmtStr, "BackEndSet:LbCookieSessionPersistenceConfiguration", actualLBCookie != nil, desiredLBCookie != nil))
+	}
+	if actualLBCookie != nil {
+		// OCI assigns a default cookie name when none is requested.
+		if desiredLBCookie.CookieName != nil && toString(actualLBCookie.CookieName) != toString(desiredLBCookie.CookieName) {
+			sessionPersistenceChanges = append(sessionPersistenceChanges, fmt.Sprintf(changeFmtStr, "BackEndSet:LbCookieSessionPersistenceConfiguration:CookieName", toString(actualLBCookie.CookieName), toString(desiredLBCookie.CookieName)))
+		}
+		if toBool(actualLBCookie.DisableFallback) != toBool(desiredLBCookie.DisableFallback) {
+			sessionPersistenceChanges = append(sessionPersistenceChanges, fmt.Sprintf(changeFmtStr, "BackEndSet:LbCookieSessionPersistenceConfiguration:DisableFallback", toBool(actualLBCookie.DisableFallback), toBool(desiredLBCookie.DisableFallback)))
+		}
+		if toInt(actualLBCookie.MaxAgeInSeconds) != toInt(desiredLBCookie.MaxAgeInSeconds) {
+			sessionPersistenceChanges = append(sessionPersistenceChanges, fmt.Sprintf(changeFmtStr, "BackEndSet:LbCookieSessionPersistenceConfiguration:MaxAgeInSeconds", toInt(actualLBCookie.MaxAgeInSeconds), toInt(desiredLBCookie.MaxAgeInSeconds)))
+		}
+	}
+
+	return sessionPersistenceChanges
+}
+
 // TODO(horwitz): this doesn't check weight which we may want in the future to
 // evenly distribute Local traffic policy load.
 func hasBackendSetChanged(logger *zap.SugaredLogger, actual client.GenericBackendSetDetails, desired client.GenericBackendSetDetails) bool {
@@ -244,6 +282,9 @@ func hasBackendSetChanged(logger *zap.SugaredLogger, actual client.GenericBacken
 		backendSetChanges = append(backendSetChanges, fmt.Sprintf(changeFmtStr, "BackEndSet:IsPreserveSource", toBool(actual.IsPreserveSource), toBool(desired.IsPreserveSource)))
 	}
 
+	backendSetChanges = append(backendSetChanges, getSessionPersistenceChanges(actual, desired)...)
+	backendSetChanges = append(backendSetChanges, getSSLConfigurationChanges(actual.SslConfiguration, desired.SslConfiguration)...)
+
 	nameFormat := "%s:%d"
 
 	desiredSet := sets.NewString()
@@ -252,20 +293,28 @@ func hasBackendSetChanged(logger *zap.SugaredLogger, actual client.GenericBacken
 		desiredSet.Insert(name)
 	}
 
-	actualSet := sets.NewString()
+	actualBackends := make(map[string]client.GenericBackend)
 	var backendChanges []string
 	for _, backend := range actual.Backends {
 		name := fmt.Sprintf(nameFormat, *backend.IpAddress, *backend.Port)
 		if !desiredSet.Has(name) {
 			backendChanges = append(backendChanges, fmt.Sprintf(backendChangeFmtStr, "BackEndSet:Backend Remove", name))
 		}
-		actualSet.Insert(name)
+		actualBackends[name] = backend
 	}
 
 	for _, backend := range desired.Backends {
 		name := fmt.Sprintf(nameFormat, *backend.IpAddress, *backend.Port)
-		if !actualSet.Has(name) {
+		actualBackend, ok := actualBackends[name]
+		if !ok {
 			backendChanges = append(backendChanges, fmt.Sprintf(backendChangeFmtStr, "BackEndSet:Backend Add", name))
+			continue
+		}
+		if toBool(actualBackend.Drain) != toBool(backend.Drain) {
+			backendChanges = append(backendChanges, fmt.Sprintf(changeFmtStr, "BackEndSet:Backend Drain "+name, toBool(actualBackend.Drain), toBool(backend.Drain)))
+		}
+		if toBool(actualBackend.Offline) != toBool(backend.Offline) {
+			backendChanges = append(backendChanges, fmt.Sprintf(changeFmtStr, "BackEndSet:Backend Offline "+name, toBool(actualBackend.Offline), toBool(backend.Offline)))
 		}
 	}
 
@@ -302,9 +351,14 @@ func sslConfigurationToDetails(sc *client.GenericSslConfigurationDetails) *clien
 		return nil
 	}
 	return &client.GenericSslConfigurationDetails{
-		CertificateName:       sc.CertificateName,
-		VerifyDepth:           sc.VerifyDepth,
-		VerifyPeerCertificate: sc.VerifyPeerCertificate,
+		CertificateName:                sc.CertificateName,
+		CertificateIds:                 sc.CertificateIds,
+		TrustedCertificateAuthorityIds: sc.TrustedCertificateAuthorityIds,
+		VerifyDepth:                    sc.VerifyDepth,
+		VerifyPeerCertificate:          sc.VerifyPeerCertificate,
+		CipherSuiteName:                sc.CipherSuiteName,
+		Protocols:                      sc.Protocols,
+		ServerOrderPreference:          sc.ServerOrderPreference,
 	}
 }
 
@@ -315,14 +369,121 @@ func backendsToBackendDetails(bs []client.GenericBackend) []client.GenericBacken
 			IpAddress: backend.IpAddress,
 			Port:      backend.Port,
 			//Backup:    backend.Backup,
-			//Drain:     backend.Drain,
-			//Offline:   backend.Offline,
-			Weight: backend.Weight,
+			Drain:   backend.Drain,
+			Offline: backend.Offline,
+			Weight:  backend.Weight,
 		}
 	}
 	return backends
 }
 
+// getDrainingBackendSet returns the desired backend set with the backends it
+// removes kept in it and marked as draining, until the drain period has passed
+// since their node started draining. The drain start of a node is read from
+// the recorded drain starts, or is now for a node that starts draining, and is
+// added to drainStarts for every node kept. Nodes that no longer fit in the
+// backend drain tag are removed right away. It also returns how long until the
+// next kept backend can be removed, which is zero when none is kept.
+func getDrainingBackendSet(actual client.GenericBackendSetDetails, desired client.GenericBackendSetDetails, recorded map[string]int64, drainStarts map[string]int64, drainPeriod time.Duration, now time.Time) (client.GenericBackendSetDetails, time.Duration) {
+	nameFormat := "%s:%d"
+
+	desiredSet := sets.NewString()
+	for _, backend := range desired.Backends {
+		desiredSet.Insert(fmt.Sprintf(nameFormat, *backend.IpAddress, *backend.Port))
+	}
+
+	backends := append([]client.GenericBackend{}, desired.Backends...)
+	var next time.Duration
+	for _, backend := range actual.Backends {
+		if desiredSet.Has(fmt.Sprintf(nameFormat, *backend.IpAddress, *backend.Port)) || toBool(backend.Offline) {
+			continue
+		}
+		ip := *backend.IpAddress
+		start, draining := drainStarts[ip]
+		if !draining {
+			start = now.Unix()
+			if seconds, ok := recorded[ip]; ok && toBool(backend.Drain) {
+				start = seconds
+			}
+		}
+		remaining := drainPeriod - now.Sub(time.Unix(start, 0))
+		if remaining <= 0 {
+			continue
+		}
+		if !draining && !fitsBackendDrainTag(drainStarts, ip, start) {
+			continue
+		}
+		if next == 0 || remaining < next {
+			next = remaining
+		}
+		drainStarts[ip] = start
+		backend.Drain = common.Bool(true)
+		backends = append(backends, backend)
+	}
+	if next == 0 {
+		return desired, 0
+	}
+
+	drainingBackendSet := desired
+	drainingBackendSet.Backends = backends
+	return drainingBackendSet, next
+}
+
+// parseBackendDrainTag returns the drain starts, in Unix seconds, of the nodes
+// recorded in the backend drain tag of a load balancer. Malformed entries are
+// ignored.
+func parseBackendDrainTag(value string) map[string]int64 {
+	drainStarts := make(map[string]int64)
+	for _, entry := range strings.Split(value, ",") {
+		i := strings.LastIndex(entry, "=")
+		if i < 0 {
+			continue
+		}
+		if seconds, err := strconv.ParseInt(entry[i+1:], 10, 64); err == nil {
+			drainStarts[entry[:i]] = seconds
+		}
+	}
+	return drainStarts
+}
+
+// formatBackendDrainTag returns the value of the backend drain tag of a load
+// balancer: the "<node address>=<drain start>" entries of the nodes, sorted
+// and separated by commas.
+func formatBackendDrainTag(drainStarts map[string]int64) string {
+	entries := make([]string, 0, len(drainStarts))
+	for ip, start := range drainStarts {
+		entries = append(entries, fmt.Sprintf("%s=%d", ip, start))
+	}
+	sort.Strings(entries)
+	return strings.Join(entries, ",")
+}
+
+// fitsBackendDrainTag returns whether the drain start of another node still
+// fits in the value of the backend drain tag.
+func fitsBackendDrainTag(drainStarts map[string]int64, ip string, start int64) bool {
+	length := len(formatBackendDrainTag(drainStarts)) + len(fmt.Sprintf("%s=%d", ip, start))
+	if len(drainStarts) > 0 {
+		length++
+	}
+	return length <= maxBackendDrainTagLength
+}
+
+// getBackendDrainTags returns the freeform tags of the load balancer with the
+// backend drain tag set to the drain starts of the nodes that are draining, or
+// removed when none is.
+func getBackendDrainTags(tags map[string]string, drainStarts map[string]int64) map[string]string {
+	result := make(map[string]string, len(tags)+1)
+	for key, value := range tags {
+		if key != backendDrainTagKey {
+			result[key] = value
+		}
+	}
+	if len(drainStarts) > 0 {
+		result[backendDrainTagKey] = formatBackendDrainTag(drainStarts)
+	}
+	return result
+}
+
 func portsFromBackendSetDetails(logger *zap.SugaredLogger, name string, bs *client.GenericBackendSetDetails) portSpec {
 	spec := portSpec{}
 	if len(bs.Backends) > 0 {
@@ -331,7 +492,11 @@ func portsFromBackendSetDetails(logger *zap.SugaredLogger, name string, bs *clie
 		logger.Warnf("BackendSet %q has no Backends", name)
 	}
 	if bs.HealthChecker != nil {
-		spec.HealthCheckerPort = *bs.HealthChecker.Port
+		// Health checks without a port check the port of the backend.
+		spec.HealthCheckerPort = spec.BackendPort
+		if toInt(bs.HealthChecker.Port) != 0 {
+			spec.HealthCheckerPort = *bs.HealthChecker.Port
+		}
 	} else {
 		logger.Warnf("BackendSet %q has no health checker", name)
 	}
@@ -346,7 +511,11 @@ func portsFromBackendSet(logger *zap.SugaredLogger, name string, bs *client.Gene
 		logger.Warnf("BackendSet %q has no Backends", name)
 	}
 	if bs.HealthChecker != nil {
-		spec.HealthCheckerPort = *bs.HealthChecker.Port
+		// Health checks without a port check the port of the backend.
+		spec.HealthCheckerPort = spec.BackendPort
+		if toInt(bs.HealthChecker.Port) != 0 {
+			spec.HealthCheckerPort = *bs.HealthChecker.Port
+		}
 	} else {
 		logger.Warnf("BackendSet %q has no health checker", name)
 	}
@@ -363,11 +532,12 @@ func getBackendSetChanges(logger *zap.SugaredLogger, actual map[string]client.Ge
 			backendSetActions = append(backendSetActions, &BackendSetAction{
 				name: *actualBackendSet.Name,
 				BackendSet: client.GenericBackendSetDetails{
-					HealthChecker:                   healthCheckerToDetails(actualBackendSet.HealthChecker),
-					Policy:                          actualBackendSet.Policy,
-					Backends:                        backendsToBackendDetails(actualBackendSet.Backends),
-					SessionPersistenceConfiguration: actualBackendSet.SessionPersistenceConfiguration,
-					SslConfiguration:                sslConfigurationToDetails(actualBackendSet.SslConfiguration),
+					HealthChecker:                           healthCheckerToDetails(actualBackendSet.HealthChecker),
+					Policy:                                  actualBackendSet.Policy,
+					Backends:                                backendsToBackendDetails(actualBackendSet.Backends),
+					SessionPersistenceConfiguration:         actualBackendSet.SessionPersistenceConfiguration,
+					LbCookieSessionPersistenceConfiguration: actualBackendSet.LbCookieSessionPersistenceConfiguration,
+					SslConfiguration:                        sslConfigurationToDetails(actualBackendSet.SslConfiguration),
 				},
 				Ports:      portsFromBackendSet(logger, *actualBackendSet.Name, &actualBackendSet),
 				actionType: Delete,
@@ -426,6 +596,23 @@ func getSSLConfigurationChanges(actual *client.GenericSslConfigurationDetails, d
 	if toBool(actual.VerifyPeerCertificate) != toBool(desired.VerifyPeerCertificate) {
 		sslConfigurationChanges = append(sslConfigurationChanges, fmt.Sprintf(changeFmtStr, "Listener:SSLConfiguration:VerifyPeerCertificate", toBool(actual.VerifyPeerCertificate), toBool(desired.VerifyPeerCertificate)))
 	}
+	if !sets.NewString(actual.CertificateIds...).Equal(sets.NewString(desired.CertificateIds...)) {
+		sslConfigurationChanges = append(sslConfigurationChanges, fmt.Sprintf(changeFmtStr, "Listener:SSLConfiguration:CertificateIds", actual.CertificateIds, desired.CertificateIds))
+	}
+	if !sets.NewString(actual.TrustedCertificateAuthorityIds...).Equal(sets.NewString(desired.TrustedCertificateAuthorityIds...)) {
+		sslConfigurationChanges = append(sslConfigurationChanges, fmt.Sprintf(changeFmtStr, "Listener:SSLConfiguration:TrustedCertificateAuthorityIds", actual.TrustedCertificateAuthorityIds, desired.TrustedCertificateAuthorityIds))
+	}
+	// OCI picks the cipher suite, protocols and server order preference when
+	// they are not set, so they are only reconciled when desired.
+	if desired.CipherSuiteName != nil && toString(actual.CipherSuiteName) != toString(desired.CipherSuiteName) {
+		sslConfigurationChanges = append(sslConfigurationChanges, fmt.Sprintf(changeFmtStr, "Listener:SSLConfiguration:CipherSuiteName", toString(actual.CipherSuiteName), toString(desired.CipherSuiteName)))
+	}
+	if len(desired.Protocols) != 0 && !sets.NewString(actual.Protocols...).Equal(sets.NewString(desired.Protocols...)) {
+		sslConfigurationChanges = append(sslConfigurationChanges, fmt.Sprintf(changeFmtStr, "Listener:SSLConfiguration:Protocols", actual.Protocols, desired.Protocols))
+	}
+	if desired.ServerOrderPreference != "" && actual.ServerOrderPreference != desired.ServerOrderPreference {
+		sslConfigurationChanges = append(sslConfigurationChanges, fmt.Sprintf(changeFmtStr, "Listener:SSLConfiguration:ServerOrderPreference", actual.ServerOrderPreference, desired.ServerOrderPreference))
+	}
 	return sslConfigurationChanges
 }
 
@@ -441,6 +628,12 @@ func hasListenerChanged(logger *zap.SugaredLogger, actual client.GenericListener
 	if toString(actual.Protocol) != toString(desired.Protocol) {
 		listenerChanges = append(listenerChanges, fmt.Sprintf(changeFmtStr, "Listener:Protocol", toString(actual.Protocol), toString(desired.Protocol)))
 	}
+	if !sets.NewString(actual.RuleSetNames...).Equal(sets.NewString(desired.RuleSetNames...)) {
+		listenerChanges = append(listenerChanges, fmt.Sprintf(changeFmtStr, "Listener:RuleSetNames", actual.RuleSetNames, desired.RuleSetNames))
+	}
+	if toString(actual.RoutingPolicyName) != toString(desired.RoutingPolicyName) {
+		listenerChanges = append(listenerChanges, fmt.Sprintf(changeFmtStr, "Listener:RoutingPolicyName", toString(actual.RoutingPolicyName), toString(desired.RoutingPolicyName)))
+	}
 	listenerChanges = append(listenerChanges, getSSLConfigurationChanges(actual.SslConfiguration, desired.SslConfiguration)...)
 	listenerChanges = append(listenerChanges, getConnectionConfigurationChanges(actual.ConnectionConfiguration, desired.ConnectionConfiguration)...)
 
@@ -475,6 +668,30 @@ func getConnectionConfigurationChanges(actual *client.GenericConnectionConfigura
 	return connectionConfigurationChanges
 }
 
+// withRestoredSSLPolicy fills in the OCI default TLS negotiation settings the
+// desired listener leaves unset when the actual listener uses a cipher suite
+// created by the CCM, so that removing the annotations reverts the listeners
+// the CCM customized. Settings of other listeners, e.g. tuned in the console,
+// are left alone.
+func withRestoredSSLPolicy(actual, desired client.GenericListener) client.GenericListener {
+	if actual.SslConfiguration == nil || desired.SslConfiguration == nil ||
+		!strings.HasPrefix(toString(actual.SslConfiguration.CipherSuiteName), sslCipherSuiteNamePrefix) {
+		return desired
+	}
+	sslConfiguration := *desired.SslConfiguration
+	if sslConfiguration.CipherSuiteName == nil {
+		sslConfiguration.CipherSuiteName = common.String(defaultSSLCipherSuiteName)
+	}
+	if len(sslConfiguration.Protocols) == 0 {
+		sslConfiguration.Protocols = append([]string(nil), defaultSSLProtocols...)
+	}
+	if sslConfiguration.ServerOrderPreference == "" {
+		sslConfiguration.ServerOrderPreference = defaultSSLServerOrderPreference
+	}
+	desired.SslConfiguration = &sslConfiguration
+	return desired
+}
+
 func getListenerChanges(logger *zap.SugaredLogger, actual map[string]client.GenericListener, desired map[string]client.GenericListener) []Action {
 	var listenerActions []Action
 
@@ -506,6 +723,7 @@ func getListenerChanges(logger *zap.SugaredLogger, actual map[string]client.Gene
 			continue
 		}
 		exists.Insert(getSanitizedName(name))
+		desiredListener = withRestoredSSLPolicy(actualListener, desiredListener)
 		if hasListenerChanged(logger, actualListener, desiredListener) {
 			listenerActions = append(listenerActions, &ListenerAction{
 				Listener:   desiredListener,
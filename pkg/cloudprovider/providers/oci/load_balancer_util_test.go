@@ -20,6 +20,7 @@ import (
 	"os"
 	"reflect"
 	"testing"
+	"time"
 
 	"go.uber.org/zap"
 	api "k8s.io/api/core/v1"
@@ -1220,6 +1221,46 @@ func TestHasListenerChanged(t *testing.T) {
 				Port:     common.Int(443)},
 			expected: true,
 		},
+		{
+			name: "RuleSetNames changes",
+			desired: client.GenericListener{
+				Protocol:     common.String("HTTP"),
+				Port:         common.Int(80),
+				RuleSetNames: []string{"oci_ccm_redirect_http_to_https"},
+			},
+			actual: client.GenericListener{
+				Protocol:     common.String("HTTP"),
+				Port:         common.Int(80),
+				RuleSetNames: []string{},
+			},
+			expected: true,
+		},
+		{
+			name: "RoutingPolicyName changes",
+			desired: client.GenericListener{
+				Protocol:          common.String("HTTP"),
+				Port:              common.Int(80),
+				RoutingPolicyName: common.String("oci_ccm_routes"),
+			},
+			actual: client.GenericListener{
+				Protocol: common.String("HTTP"),
+				Port:     common.Int(80),
+			},
+			expected: true,
+		},
+		{
+			name: "RuleSetNames unchanged",
+			desired: client.GenericListener{
+				Protocol: common.String("TCP"),
+				Port:     common.Int(443),
+			},
+			actual: client.GenericListener{
+				Protocol:     common.String("TCP"),
+				Port:         common.Int(443),
+				RuleSetNames: []string{},
+			},
+			expected: false,
+		},
 		{
 			name: "SSLConfigurationChanges present in actual but not in desired",
 			desired: client.GenericListener{
@@ -1832,6 +1873,107 @@ func TestHasBackendSetChanged(t *testing.T) {
 			},
 			expected: false,
 		},
+		{
+			name: "LB cookie session persistence added",
+			desired: client.GenericBackendSetDetails{
+				Policy:                                  common.String("policy"),
+				LbCookieSessionPersistenceConfiguration: &client.GenericLbCookieSessionPersistenceConfiguration{},
+			},
+			actual: client.GenericBackendSetDetails{
+				Policy: common.String("policy"),
+			},
+			expected: true,
+		},
+		{
+			name: "LB cookie session persistence max age changes",
+			desired: client.GenericBackendSetDetails{
+				Policy: common.String("policy"),
+				LbCookieSessionPersistenceConfiguration: &client.GenericLbCookieSessionPersistenceConfiguration{
+					MaxAgeInSeconds: common.Int(3600),
+				},
+			},
+			actual: client.GenericBackendSetDetails{
+				Policy: common.String("policy"),
+				LbCookieSessionPersistenceConfiguration: &client.GenericLbCookieSessionPersistenceConfiguration{
+					CookieName: common.String("X-Oracle-BMC-LBS-Route"),
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "LB cookie session persistence with default cookie name unchanged",
+			desired: client.GenericBackendSetDetails{
+				Policy:                                  common.String("policy"),
+				LbCookieSessionPersistenceConfiguration: &client.GenericLbCookieSessionPersistenceConfiguration{},
+			},
+			actual: client.GenericBackendSetDetails{
+				Policy: common.String("policy"),
+				LbCookieSessionPersistenceConfiguration: &client.GenericLbCookieSessionPersistenceConfiguration{
+					CookieName: common.String("X-Oracle-BMC-LBS-Route"),
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "SSL certificate rotated",
+			desired: client.GenericBackendSetDetails{
+				Policy: common.String("policy"),
+				SslConfiguration: &client.GenericSslConfigurationDetails{
+					CertificateName: common.String("oci-ccm-backendsecret-a5aa9c2b9e6681c3"),
+				},
+			},
+			actual: client.GenericBackendSetDetails{
+				Policy: common.String("policy"),
+				SslConfiguration: &client.GenericSslConfigurationDetails{
+					CertificateName: common.String("oci-ccm-backendsecret-0d404de8a8aa4a8c"),
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "Application cookie session persistence cookie name changes",
+			desired: client.GenericBackendSetDetails{
+				Policy: common.String("policy"),
+				SessionPersistenceConfiguration: &client.GenericSessionPersistenceConfiguration{
+					CookieName: common.String("JSESSIONID"),
+				},
+			},
+			actual: client.GenericBackendSetDetails{
+				Policy: common.String("policy"),
+				SessionPersistenceConfiguration: &client.GenericSessionPersistenceConfiguration{
+					CookieName: common.String("SESSION"),
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "Backend starts draining",
+			desired: client.GenericBackendSetDetails{
+				Backends: []client.GenericBackend{
+					{IpAddress: common.String("10.0.0.1"), Port: common.Int(30000), Drain: common.Bool(true)},
+				},
+			},
+			actual: client.GenericBackendSetDetails{
+				Backends: []client.GenericBackend{
+					{IpAddress: common.String("10.0.0.1"), Port: common.Int(30000), Drain: common.Bool(false)},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "Backend not draining",
+			desired: client.GenericBackendSetDetails{
+				Backends: []client.GenericBackend{
+					{IpAddress: common.String("10.0.0.1"), Port: common.Int(30000)},
+				},
+			},
+			actual: client.GenericBackendSetDetails{
+				Backends: []client.GenericBackend{
+					{IpAddress: common.String("10.0.0.1"), Port: common.Int(30000), Drain: common.Bool(false), Offline: common.Bool(false)},
+				},
+			},
+			expected: false,
+		},
 	}
 
 	for _, tt := range testCases {
@@ -1920,6 +2062,65 @@ func TestGetSSLConfigurationChanges(t *testing.T) {
 				fmt.Sprintf(changeFmtStr, "Listener:SSLConfiguration:CertificateName", false, true),
 			},
 		},
+		{
+			name: "Certificate Ids Changed",
+			desired: client.GenericSslConfigurationDetails{
+				CertificateIds:                 []string{"ocid1.certificate.oc1..new"},
+				TrustedCertificateAuthorityIds: []string{"ocid1.cabundle.oc1..a", "ocid1.cabundle.oc1..b"},
+			},
+			actual: client.GenericSslConfigurationDetails{
+				CertificateIds:                 []string{"ocid1.certificate.oc1..old"},
+				TrustedCertificateAuthorityIds: []string{"ocid1.cabundle.oc1..b", "ocid1.cabundle.oc1..a"},
+			},
+			expected: []string{
+				fmt.Sprintf(changeFmtStr, "Listener:SSLConfiguration:CertificateIds", []string{"ocid1.certificate.oc1..old"}, []string{"ocid1.certificate.oc1..new"}),
+			},
+		},
+		{
+			name: "Cipher Suite and Protocols Changed",
+			desired: client.GenericSslConfigurationDetails{
+				CipherSuiteName:       common.String("oci-modern-ssl-cipher-suite-v1"),
+				Protocols:             []string{"TLSv1.2", "TLSv1.3"},
+				ServerOrderPreference: "ENABLED",
+			},
+			actual: client.GenericSslConfigurationDetails{
+				CipherSuiteName:       common.String("oci-default-ssl-cipher-suite-v1"),
+				Protocols:             []string{"TLSv1.1", "TLSv1.2"},
+				ServerOrderPreference: "DISABLED",
+			},
+			expected: []string{
+				fmt.Sprintf(changeFmtStr, "Listener:SSLConfiguration:CipherSuiteName", "oci-default-ssl-cipher-suite-v1", "oci-modern-ssl-cipher-suite-v1"),
+				fmt.Sprintf(changeFmtStr, "Listener:SSLConfiguration:Protocols", []string{"TLSv1.1", "TLSv1.2"}, []string{"TLSv1.2", "TLSv1.3"}),
+				fmt.Sprintf(changeFmtStr, "Listener:SSLConfiguration:ServerOrderPreference", "DISABLED", "ENABLED"),
+			},
+		},
+		{
+			name: "Restored OCI Defaults Reconciled",
+			desired: client.GenericSslConfigurationDetails{
+				CipherSuiteName:       common.String(defaultSSLCipherSuiteName),
+				Protocols:             defaultSSLProtocols,
+				ServerOrderPreference: defaultSSLServerOrderPreference,
+			},
+			actual: client.GenericSslConfigurationDetails{
+				CipherSuiteName:       common.String("ccm-compliance"),
+				Protocols:             []string{"TLSv1.3"},
+				ServerOrderPreference: "DISABLED",
+			},
+			expected: []string{
+				fmt.Sprintf(changeFmtStr, "Listener:SSLConfiguration:CipherSuiteName", "ccm-compliance", "oci-default-ssl-cipher-suite-v1"),
+				fmt.Sprintf(changeFmtStr, "Listener:SSLConfiguration:Protocols", []string{"TLSv1.3"}, []string{"TLSv1.2"}),
+				fmt.Sprintf(changeFmtStr, "Listener:SSLConfiguration:ServerOrderPreference", "DISABLED", "ENABLED"),
+			},
+		},
+		{
+			name:    "OCI Defaults Not Reconciled",
+			desired: client.GenericSslConfigurationDetails{},
+			actual: client.GenericSslConfigurationDetails{
+				CipherSuiteName:       common.String("oci-default-ssl-cipher-suite-v1"),
+				Protocols:             []string{"TLSv1.2"},
+				ServerOrderPreference: "ENABLED",
+			},
+		},
 	}
 
 	for _, tt := range testCases {
@@ -1935,6 +2136,72 @@ func TestGetSSLConfigurationChanges(t *testing.T) {
 	}
 }
 
+func Test_withRestoredSSLPolicy(t *testing.T) {
+	testCases := []struct {
+		name     string
+		actual   *client.GenericSslConfigurationDetails
+		desired  *client.GenericSslConfigurationDetails
+		expected *client.GenericSslConfigurationDetails
+	}{
+		{
+			name: "Removed annotations restore OCI defaults on a listener customized by the CCM",
+			actual: &client.GenericSslConfigurationDetails{
+				CipherSuiteName:       common.String("ccm-compliance"),
+				Protocols:             []string{"TLSv1.3"},
+				ServerOrderPreference: "DISABLED",
+			},
+			desired: &client.GenericSslConfigurationDetails{CertificateName: common.String("cert")},
+			expected: &client.GenericSslConfigurationDetails{
+				CertificateName:       common.String("cert"),
+				CipherSuiteName:       common.String(defaultSSLCipherSuiteName),
+				Protocols:             []string{"TLSv1.2"},
+				ServerOrderPreference: defaultSSLServerOrderPreference,
+			},
+		},
+		{
+			name: "Annotations are kept on a listener customized by the CCM",
+			actual: &client.GenericSslConfigurationDetails{
+				CipherSuiteName: common.String("ccm-compliance"),
+				Protocols:       []string{"TLSv1.3"},
+			},
+			desired: &client.GenericSslConfigurationDetails{
+				CipherSuiteName: common.String("ccm-compliance"),
+				Protocols:       []string{"TLSv1.3"},
+			},
+			expected: &client.GenericSslConfigurationDetails{
+				CipherSuiteName:       common.String("ccm-compliance"),
+				Protocols:             []string{"TLSv1.3"},
+				ServerOrderPreference: defaultSSLServerOrderPreference,
+			},
+		},
+		{
+			name: "Settings of a listener not customized by the CCM are left alone",
+			actual: &client.GenericSslConfigurationDetails{
+				CipherSuiteName: common.String("oci-compatible-ssl-cipher-suite-v1"),
+				Protocols:       []string{"TLSv1.1", "TLSv1.2"},
+			},
+			desired:  &client.GenericSslConfigurationDetails{CertificateName: common.String("cert")},
+			expected: &client.GenericSslConfigurationDetails{CertificateName: common.String("cert")},
+		},
+		{
+			name:   "Listener without SSL",
+			actual: &client.GenericSslConfigurationDetails{CipherSuiteName: common.String("ccm-compliance")},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got := withRestoredSSLPolicy(
+				client.GenericListener{SslConfiguration: tt.actual},
+				client.GenericListener{SslConfiguration: tt.desired},
+			)
+			if !reflect.DeepEqual(got.SslConfiguration, tt.expected) {
+				t.Errorf("withRestoredSSLPolicy() = %+v, want %+v", got.SslConfiguration, tt.expected)
+			}
+		})
+	}
+}
+
 func TestGetConnectionConfigurationChanges(t *testing.T) {
 	var testCases = []struct {
 		name     string
@@ -2183,3 +2450,214 @@ func Test_parseFlexibleShapeBandwidth(t *testing.T) {
 		})
 	}
 }
+
+func Test_getDrainingBackendSet(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	var testCases = []struct {
+		name                string
+		actual              client.GenericBackendSetDetails
+		desired             client.GenericBackendSetDetails
+		recorded            map[string]int64
+		expected            client.GenericBackendSetDetails
+		expectedDrainStarts map[string]int64
+		expectedRemaining   time.Duration
+	}{
+		{
+			name: "no backends removed",
+			actual: client.GenericBackendSetDetails{
+				Backends: []client.GenericBackend{
+					{IpAddress: common.String("10.0.0.1"), Port: common.Int(30000)},
+				},
+			},
+			desired: client.GenericBackendSetDetails{
+				Backends: []client.GenericBackend{
+					{IpAddress: common.String("10.0.0.1"), Port: common.Int(30000)},
+					{IpAddress: common.String("10.0.0.2"), Port: common.Int(30000)},
+				},
+			},
+			expected: client.GenericBackendSetDetails{
+				Backends: []client.GenericBackend{
+					{IpAddress: common.String("10.0.0.1"), Port: common.Int(30000)},
+					{IpAddress: common.String("10.0.0.2"), Port: common.Int(30000)},
+				},
+			},
+			expectedDrainStarts: map[string]int64{},
+		},
+		{
+			name: "removed backend starts draining",
+			actual: client.GenericBackendSetDetails{
+				Backends: []client.GenericBackend{
+					{IpAddress: common.String("10.0.0.1"), Port: common.Int(30000)},
+					{IpAddress: common.String("10.0.0.2"), Port: common.Int(30000), Weight: common.Int(1), Drain: common.Bool(false)},
+				},
+			},
+			desired: client.GenericBackendSetDetails{
+				Policy: common.String("ROUND_ROBIN"),
+				Backends: []client.GenericBackend{
+					{IpAddress: common.String("10.0.0.1"), Port: common.Int(30000)},
+				},
+			},
+			expected: client.GenericBackendSetDetails{
+				Policy: common.String("ROUND_ROBIN"),
+				Backends: []client.GenericBackend{
+					{IpAddress: common.String("10.0.0.1"), Port: common.Int(30000)},
+					{IpAddress: common.String("10.0.0.2"), Port: common.Int(30000), Weight: common.Int(1), Drain: common.Bool(true)},
+				},
+			},
+			expectedDrainStarts: map[string]int64{"10.0.0.2": 1700000000},
+			expectedRemaining:   time.Minute,
+		},
+		{
+			name: "removed backend is draining already",
+			actual: client.GenericBackendSetDetails{
+				Backends: []client.GenericBackend{
+					{IpAddress: common.String("10.0.0.1"), Port: common.Int(30000)},
+					{IpAddress: common.String("10.0.0.2"), Port: common.Int(30000), Drain: common.Bool(true)},
+				},
+			},
+			desired: client.GenericBackendSetDetails{
+				Backends: []client.GenericBackend{
+					{IpAddress: common.String("10.0.0.1"), Port: common.Int(30000)},
+				},
+			},
+			recorded: map[string]int64{"10.0.0.2": 1699999980},
+			expected: client.GenericBackendSetDetails{
+				Backends: []client.GenericBackend{
+					{IpAddress: common.String("10.0.0.1"), Port: common.Int(30000)},
+					{IpAddress: common.String("10.0.0.2"), Port: common.Int(30000), Drain: common.Bool(true)},
+				},
+			},
+			expectedDrainStarts: map[string]int64{"10.0.0.2": 1699999980},
+			expectedRemaining:   40 * time.Second,
+		},
+		{
+			name: "drain period of removed backend has passed",
+			actual: client.GenericBackendSetDetails{
+				Backends: []client.GenericBackend{
+					{IpAddress: common.String("10.0.0.1"), Port: common.Int(30000)},
+					{IpAddress: common.String("10.0.0.2"), Port: common.Int(30000), Drain: common.Bool(true)},
+				},
+			},
+			desired: client.GenericBackendSetDetails{
+				Backends: []client.GenericBackend{
+					{IpAddress: common.String("10.0.0.1"), Port: common.Int(30000)},
+				},
+			},
+			recorded: map[string]int64{"10.0.0.2": 1699999940},
+			expected: client.GenericBackendSetDetails{
+				Backends: []client.GenericBackend{
+					{IpAddress: common.String("10.0.0.1"), Port: common.Int(30000)},
+				},
+			},
+			expectedDrainStarts: map[string]int64{},
+		},
+		{
+			name: "removed backends of a node drain together",
+			actual: client.GenericBackendSetDetails{
+				Backends: []client.GenericBackend{
+					{IpAddress: common.String("10.0.0.2"), Port: common.Int(30000), Drain: common.Bool(true)},
+					{IpAddress: common.String("10.0.0.2"), Port: common.Int(30001)},
+				},
+			},
+			desired:  client.GenericBackendSetDetails{},
+			recorded: map[string]int64{"10.0.0.2": 1699999980},
+			expected: client.GenericBackendSetDetails{
+				Backends: []client.GenericBackend{
+					{IpAddress: common.String("10.0.0.2"), Port: common.Int(30000), Drain: common.Bool(true)},
+					{IpAddress: common.String("10.0.0.2"), Port: common.Int(30001), Drain: common.Bool(true)},
+				},
+			},
+			expectedDrainStarts: map[string]int64{"10.0.0.2": 1699999980},
+			expectedRemaining:   40 * time.Second,
+		},
+		{
+			name: "removed backend is offline",
+			actual: client.GenericBackendSetDetails{
+				Backends: []client.GenericBackend{
+					{IpAddress: common.String("10.0.0.2"), Port: common.Int(30000), Offline: common.Bool(true)},
+				},
+			},
+			desired:             client.GenericBackendSetDetails{},
+			expected:            client.GenericBackendSetDetails{},
+			expectedDrainStarts: map[string]int64{},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			drainStarts := map[string]int64{}
+			result, remaining := getDrainingBackendSet(tt.actual, tt.desired, tt.recorded, drainStarts, time.Minute, now)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected draining backend set\n%+v\nbut got\n%+v", tt.expected, result)
+			}
+			if !reflect.DeepEqual(drainStarts, tt.expectedDrainStarts) {
+				t.Errorf("expected drain starts %v but got %v", tt.expectedDrainStarts, drainStarts)
+			}
+			if remaining != tt.expectedRemaining {
+				t.Errorf("expected remaining drain period %s but got %s", tt.expectedRemaining, remaining)
+			}
+		})
+	}
+}
+
+func Test_getDrainingBackendSetFullDrainTag(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	drainStarts := map[string]int64{}
+	for i := 0; len(formatBackendDrainTag(drainStarts)) < maxBackendDrainTagLength-30; i++ {
+		drainStarts[fmt.Sprintf("10.0.1.%d", i)] = now.Unix()
+	}
+	actual := client.GenericBackendSetDetails{
+		Backends: []client.GenericBackend{
+			{IpAddress: common.String("10.0.0.1"), Port: common.Int(30000)},
+			{IpAddress: common.String("10.0.0.2"), Port: common.Int(30000)},
+		},
+	}
+	desired := client.GenericBackendSetDetails{
+		Backends: []client.GenericBackend{
+			{IpAddress: common.String("10.0.0.1"), Port: common.Int(30000)},
+		},
+	}
+
+	// The node that no longer fits in the drain tag is removed right away.
+	result, remaining := getDrainingBackendSet(actual, desired, nil, drainStarts, time.Minute, now)
+	if !reflect.DeepEqual(result, desired) || remaining != 0 {
+		t.Errorf("getDrainingBackendSet() = %+v, %s, want the backend removed", result, remaining)
+	}
+	if _, ok := drainStarts["10.0.0.2"]; ok {
+		t.Errorf("getDrainingBackendSet() recorded the drain start of a node beyond the drain tag length")
+	}
+	if length := len(formatBackendDrainTag(drainStarts)); length > maxBackendDrainTagLength {
+		t.Errorf("backend drain tag is %d characters long, want at most %d", length, maxBackendDrainTagLength)
+	}
+}
+
+func Test_parseBackendDrainTag(t *testing.T) {
+	drainStarts := map[string]int64{"10.0.0.3": 1700000000, "10.0.0.2": 1699999940, "fd00::2": 1699999980}
+	value := formatBackendDrainTag(drainStarts)
+	if expected := "10.0.0.2=1699999940,10.0.0.3=1700000000,fd00::2=1699999980"; value != expected {
+		t.Errorf("formatBackendDrainTag() = %q, want %q", value, expected)
+	}
+	if result := parseBackendDrainTag(value + ",malformed,10.0.0.4=now"); !reflect.DeepEqual(result, drainStarts) {
+		t.Errorf("parseBackendDrainTag() = %v, want %v", result, drainStarts)
+	}
+	if result := parseBackendDrainTag(""); len(result) != 0 {
+		t.Errorf("parseBackendDrainTag() = %v, want no drain starts", result)
+	}
+}
+
+func Test_getBackendDrainTags(t *testing.T) {
+	tags := map[string]string{
+		"team":             "network",
+		backendDrainTagKey: "10.0.0.2=1699999940",
+	}
+	expected := map[string]string{
+		"team":             "network",
+		backendDrainTagKey: "10.0.0.3=1700000000",
+	}
+	if result := getBackendDrainTags(tags, map[string]int64{"10.0.0.3": 1700000000}); !reflect.DeepEqual(result, expected) {
+		t.Errorf("getBackendDrainTags() = %v, want %v", result, expected)
+	}
+	if result := getBackendDrainTags(tags, nil); !reflect.DeepEqual(result, map[string]string{"team": "network"}) {
+		t.Errorf("getBackendDrainTags() = %v, want the drain tag removed", result)
+	}
+}
@@ -0,0 +1,239 @@
+// Copyright 2024 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/informers"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// loadBalancerSyncer updates the load balancer of a service.
+type loadBalancerSyncer interface {
+	resyncLoadBalancer(ctx context.Context, service *v1.Service) error
+}
+
+// TLSSecretController watches the TLS secrets referenced by load balancer
+// services and updates the load balancers of the services when the secrets
+// are renewed.
+type TLSSecretController struct {
+	secretInformer  informers.GenericInformer
+	serviceInformer coreinformers.ServiceInformer
+	kubeClient      clientset.Interface
+	syncer          loadBalancerSyncer
+	queue           workqueue.RateLimitingInterface
+	logger          *zap.SugaredLogger
+}
+
+// NewTLSSecretController creates a TLSSecretController object. The secret
+// informer only needs to hold the metadata of the secrets, their contents are
+// read when a load balancer is updated.
+func NewTLSSecretController(
+	secretInformer informers.GenericInformer,
+	serviceInformer coreinformers.ServiceInformer,
+	kubeClient clientset.Interface,
+	syncer loadBalancerSyncer,
+	logger *zap.SugaredLogger) *TLSSecretController {
+
+	tsc := &TLSSecretController{
+		secretInformer:  secretInformer,
+		serviceInformer: serviceInformer,
+		kubeClient:      kubeClient,
+		syncer:          syncer,
+		queue:           workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		logger:          logger,
+	}
+
+	// Only updates of secrets used by load balancer services are of interest,
+	// services referencing a secret that does not exist yet fail to sync and
+	// are retried by the service controller.
+	tsc.secretInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: func(obj interface{}) bool {
+			key, err := cache.MetaNamespaceKeyFunc(obj)
+			if err != nil {
+				return false
+			}
+			return len(tsc.getServiceKeysUsingSecret(key)) > 0
+		},
+		Handler: cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				oldSecret, ok := oldObj.(metav1.Object)
+				if !ok {
+					return
+				}
+				newSecret, ok := newObj.(metav1.Object)
+				if !ok || oldSecret.GetResourceVersion() == newSecret.GetResourceVersion() {
+					return
+				}
+				key, err := cache.MetaNamespaceKeyFunc(newSecret)
+				if err != nil {
+					utilruntime.HandleError(err)
+					return
+				}
+				for _, serviceKey := range tsc.getServiceKeysUsingSecret(key) {
+					tsc.queue.Add(serviceKey)
+				}
+			},
+		},
+	})
+
+	return tsc
+}
+
+// Run will start the TLSSecretController and manage shutdown
+func (tsc *TLSSecretController) Run(stopCh <-chan struct{}) {
+	defer utilruntime.HandleCrash()
+
+	defer tsc.queue.ShutDown()
+
+	tsc.logger.Info("Starting TLS secret controller")
+
+	if !cache.WaitForCacheSync(stopCh, tsc.secretInformer.Informer().HasSynced, tsc.serviceInformer.Informer().HasSynced) {
+		utilruntime.HandleError(fmt.Errorf("Timed out waiting for caches to sync"))
+		return
+	}
+
+	wait.Until(tsc.runWorker, time.Second, stopCh)
+}
+
+func (tsc *TLSSecretController) runWorker() {
+	for tsc.processNextItem() {
+
+	}
+}
+
+func (tsc *TLSSecretController) processNextItem() bool {
+	key, quit := tsc.queue.Get()
+	if quit {
+		return false
+	}
+
+	defer tsc.queue.Done(key)
+
+	err := tsc.processItem(key.(string))
+
+	if err != nil {
+		tsc.logger.Errorf("Error updating load balancer of service %s (will retry): %v", key, err)
+		tsc.queue.AddRateLimited(key)
+	} else {
+		tsc.queue.Forget(key)
+	}
+	return true
+}
+
+// processItem updates the load balancer of the service so that it uses the
+// current contents of its TLS secrets. A service with a secret that cannot
+// be read is skipped, the service controller reports the error when it syncs
+// the service.
+func (tsc *TLSSecretController) processItem(key string) error {
+	logger := tsc.logger.With("service", key)
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	svc, err := tsc.serviceInformer.Lister().Services(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	secretKeys := getTLSSecretKeys(svc)
+	if len(secretKeys) == 0 {
+		return nil
+	}
+	for secretKey := range secretKeys {
+		if err := tsc.checkTLSSecret(secretKey); err != nil {
+			logger.With(zap.Error(err), "secret", secretKey).Warn("Skipping service with unreadable TLS secret")
+			return nil
+		}
+	}
+
+	if err := tsc.syncer.resyncLoadBalancer(context.Background(), svc); err != nil {
+		return err
+	}
+	logger.Info("Updated load balancer for renewed TLS secret")
+	return nil
+}
+
+// checkTLSSecret returns an error if the secret does not hold a certificate
+// and private key, or a CA bundle for client CA secrets.
+func (tsc *TLSSecretController) checkTLSSecret(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	secret, err := tsc.kubeClient.CoreV1().Secrets(namespace).Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if _, err := certificateDataFromSecret(secret); err != nil {
+		if _, ok := secret.Data[SSLCAFileName]; !ok {
+			return err
+		}
+	}
+	return nil
+}
+
+// getServiceKeysUsingSecret returns the sorted keys of the load balancer
+// services that use the secret.
+func (tsc *TLSSecretController) getServiceKeysUsingSecret(secretKey string) []string {
+	services, err := tsc.serviceInformer.Lister().List(labels.Everything())
+	if err != nil {
+		utilruntime.HandleError(err)
+		return nil
+	}
+	var keys []string
+	for _, svc := range services {
+		if getTLSSecretKeys(svc)[secretKey] {
+			keys = append(keys, svc.Namespace+"/"+svc.Name)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// getTLSSecretKeys returns the namespace/name keys of the TLS and client CA
+// secrets used by the listeners and backend sets of a load balancer service.
+func getTLSSecretKeys(svc *v1.Service) map[string]bool {
+	keys := make(map[string]bool)
+	if svc.Spec.Type != v1.ServiceTypeLoadBalancer || !requiresCertificate(svc) {
+		return keys
+	}
+	for _, annotation := range []string{ServiceAnnotationLoadBalancerTLSSecret, ServiceAnnotationLoadBalancerTLSBackendSetSecret, ServiceAnnotationLoadBalancerListenerClientCASecret} {
+		name, namespace := getSecretParts(svc.Annotations[annotation], svc)
+		if name == "" {
+			continue
+		}
+		keys[namespace+"/"+name] = true
+	}
+	return keys
+}
@@ -0,0 +1,175 @@
+// Copyright 2024 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"go.uber.org/zap"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/informers"
+	testclient "k8s.io/client-go/kubernetes/fake"
+	metadatafake "k8s.io/client-go/metadata/fake"
+	"k8s.io/client-go/metadata/metadatainformer"
+	"k8s.io/client-go/tools/cache"
+)
+
+func newTLSService(name string, annotations map[string]string) *v1.Service {
+	return &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "ns",
+			Name:        name,
+			Annotations: annotations,
+		},
+		Spec: v1.ServiceSpec{Type: v1.ServiceTypeLoadBalancer},
+	}
+}
+
+// fakeLoadBalancerSyncer records the services whose load balancers are synced.
+type fakeLoadBalancerSyncer struct {
+	synced []string
+}
+
+func (f *fakeLoadBalancerSyncer) resyncLoadBalancer(ctx context.Context, service *v1.Service) error {
+	f.synced = append(f.synced, service.Namespace+"/"+service.Name)
+	return nil
+}
+
+func TestTLSSecretController_processItem(t *testing.T) {
+	kc := testclient.NewSimpleClientset(
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "tls"},
+			Data: map[string][]byte{
+				SSLCertificateFileName: []byte("cert"),
+				SSLPrivateKeyFileName:  []byte("key"),
+			},
+		},
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "client-ca"},
+			Data: map[string][]byte{
+				SSLCAFileName: []byte("ca"),
+			},
+		},
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "no-key"},
+			Data: map[string][]byte{
+				SSLCertificateFileName: []byte("cert"),
+			},
+		},
+		newTLSService("listener", map[string]string{
+			ServiceAnnotationLoadBalancerSSLPorts:  "443",
+			ServiceAnnotationLoadBalancerTLSSecret: "ns/tls",
+		}),
+		newTLSService("backend", map[string]string{
+			ServiceAnnotationLoadBalancerSSLPorts:            "443",
+			ServiceAnnotationLoadBalancerTLSBackendSetSecret: "tls",
+		}),
+		newTLSService("mtls", map[string]string{
+			ServiceAnnotationLoadBalancerSSLPorts:                      "443",
+			ServiceAnnotationLoadBalancerTLSSecret:                     "tls",
+			ServiceAnnotationLoadBalancerListenerVerifyPeerCertificate: "true",
+			ServiceAnnotationLoadBalancerListenerClientCASecret:        "client-ca",
+		}),
+		newTLSService("missing-secret", map[string]string{
+			ServiceAnnotationLoadBalancerSSLPorts:            "443",
+			ServiceAnnotationLoadBalancerTLSSecret:           "tls",
+			ServiceAnnotationLoadBalancerTLSBackendSetSecret: "missing",
+		}),
+		newTLSService("invalid-secret", map[string]string{
+			ServiceAnnotationLoadBalancerSSLPorts:  "443",
+			ServiceAnnotationLoadBalancerTLSSecret: "no-key",
+		}),
+		newTLSService("nlb", map[string]string{
+			ServiceAnnotationLoadBalancerType:      NLB,
+			ServiceAnnotationLoadBalancerSSLPorts:  "443",
+			ServiceAnnotationLoadBalancerTLSSecret: "tls",
+		}),
+	)
+
+	scheme := runtime.NewScheme()
+	if err := metav1.AddMetaToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	metadataFactory := metadatainformer.NewSharedInformerFactory(metadatafake.NewSimpleMetadataClient(scheme), 0)
+	secretInformer := metadataFactory.ForResource(v1.SchemeGroupVersion.WithResource("secrets"))
+	factory := informers.NewSharedInformerFactory(kc, 0)
+	serviceInformer := factory.Core().V1().Services()
+	serviceInformer.Informer()
+	syncer := &fakeLoadBalancerSyncer{}
+	tsc := NewTLSSecretController(secretInformer, serviceInformer, kc, syncer, zap.S())
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	factory.Start(stopCh)
+	metadataFactory.Start(stopCh)
+	if !cache.WaitForCacheSync(stopCh, tsc.secretInformer.Informer().HasSynced, tsc.serviceInformer.Informer().HasSynced) {
+		t.Fatal("Timed out waiting for caches to sync")
+	}
+
+	serviceKeys := tsc.getServiceKeysUsingSecret("ns/tls")
+	if want := []string{"ns/backend", "ns/listener", "ns/missing-secret", "ns/mtls"}; !reflect.DeepEqual(serviceKeys, want) {
+		t.Errorf("getServiceKeysUsingSecret() = %v, want %v", serviceKeys, want)
+	}
+
+	for _, key := range append(serviceKeys, "ns/invalid-secret", "ns/deleted") {
+		if err := tsc.processItem(key); err != nil {
+			t.Errorf("processItem(%s) got unexpected error %v", key, err)
+		}
+	}
+	if want := []string{"ns/backend", "ns/listener", "ns/mtls"}; !reflect.DeepEqual(syncer.synced, want) {
+		t.Errorf("processItem() synced load balancers of %v, want %v", syncer.synced, want)
+	}
+}
+
+func Test_getTLSSecretKeys(t *testing.T) {
+	tests := map[string]struct {
+		service *v1.Service
+		want    map[string]bool
+	}{
+		"no ssl ports": {
+			service: newTLSService("svc", map[string]string{
+				ServiceAnnotationLoadBalancerTLSSecret: "tls",
+			}),
+			want: map[string]bool{},
+		},
+		"listener and backend set secrets": {
+			service: newTLSService("svc", map[string]string{
+				ServiceAnnotationLoadBalancerSSLPorts:            "443",
+				ServiceAnnotationLoadBalancerTLSSecret:           "tls",
+				ServiceAnnotationLoadBalancerTLSBackendSetSecret: "other-ns/backend-tls",
+			}),
+			want: map[string]bool{"ns/tls": true, "other-ns/backend-tls": true},
+		},
+		"client CA secret": {
+			service: newTLSService("svc", map[string]string{
+				ServiceAnnotationLoadBalancerSSLPorts:                      "443",
+				ServiceAnnotationLoadBalancerTLSSecret:                     "tls",
+				ServiceAnnotationLoadBalancerListenerVerifyPeerCertificate: "true",
+				ServiceAnnotationLoadBalancerListenerClientCASecret:        "client-ca",
+			}),
+			want: map[string]bool{"ns/tls": true, "ns/client-ca": true},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := getTLSSecretKeys(tt.service); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getTLSSecretKeys() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
@@ -38,6 +39,7 @@ import (
 	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
 	"github.com/oracle/oci-cloud-controller-manager/pkg/util"
 	"github.com/oracle/oci-cloud-controller-manager/pkg/util/disk"
+	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/core"
 )
 
@@ -58,12 +60,22 @@ const (
 	backupTypeFull                = "full"
 	backupTypeIncremental         = "incremental"
 	backupDefinedTags             = "oci.oraclecloud.com/defined-tags"
+	autoTuneMaxVpusPerGB          = "autoTuneMaxVpusPerGB"
 	backupFreeformTags            = "oci.oraclecloud.com/freeform-tags"
+	backupCopyDestinationRegion   = "backupCopyDestinationRegion"
 	newBackupAvailableTimeout     = 45 * time.Second
 	needResize                    = "needResize"
 	newSize                       = "newSize"
 	multipathEnabled              = "multipathEnabled"
 	multipathDevices              = "multipathDevices"
+	// luksEncryption enables LUKS encryption of the volume on the node
+	luksEncryption = "luksEncryption"
+	// luksVaultSecretID is the OCI Vault secret that holds the LUKS passphrase
+	luksVaultSecretID     = "luksVaultSecretId"
+	vaultSecretOCIDPrefix = "ocid1.vaultsecret."
+	// backupPolicy is the display name or OCID of the volume backup policy assigned to provisioned volumes
+	backupPolicy           = "backupPolicy"
+	backupPolicyOCIDPrefix = "ocid1.volumebackuppolicy."
 	//device is the consistent device path that would be used for paravirtualized attachment
 	device                          = "device"
 	resourceTrackingFeatureFlagName = "CPO_ENABLE_RESOURCE_ATTRIBUTION"
@@ -72,11 +84,14 @@ const (
 )
 
 var (
-	// OCI currently only support a single node to be attached to a single node
-	// in read/write mode. This corresponds to `accessModes.ReadWriteOnce` in a
-	// PVC resource on Kubernetes
-	supportedAccessMode = &csi.VolumeCapability_AccessMode{
-		Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER,
+	// OCI attaches a volume to a single node in read/write mode, or to many
+	// nodes with read-only or shareable attachments. These correspond to
+	// `accessModes.ReadWriteOnce`, `accessModes.ReadOnlyMany` and
+	// `accessModes.ReadWriteMany` in a PVC resource on Kubernetes
+	supportedAccessModes = []*csi.VolumeCapability_AccessMode{
+		{Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER},
+		{Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_READER_ONLY},
+		{Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER},
 	}
 )
 
@@ -93,6 +108,23 @@ type VolumeParameters struct {
 	definedTags map[string]map[string]interface{}
 	//volume performance units per gb describes the block volume performance level
 	vpusPerGB int64
+	//autotune policies to apply to the block volume, taken from the VolumeAttributesClass
+	autotunePolicies []core.AutotunePolicy
+	//whether the volume is LUKS encrypted by the node driver
+	luksEncryption bool
+	//OCI Vault secret holding the LUKS passphrase, the node-stage secret is used when empty
+	luksVaultSecretID string
+	//display name or OCID of the volume backup policy to assign to the volume
+	backupPolicy string
+}
+
+// ModifyVolumeParameters holds the mutable parameters of a block volume that
+// can be changed through a VolumeAttributesClass. Unset fields are left as is.
+type ModifyVolumeParameters struct {
+	vpusPerGB *int64
+	// autoTuneMaxVpusPerGB enables performance based auto-tuning up to the given
+	// level, zero disables it
+	autoTuneMaxVpusPerGB *int64
 }
 
 // VolumeAttachmentOption holds config for attachments
@@ -110,6 +142,8 @@ type SnapshotParameters struct {
 	freeformTags map[string]string
 	// defined tags to add for backups
 	definedTags map[string]map[string]interface{}
+	// region the backups are copied to, backups are not copied when empty
+	copyDestinationRegion string
 }
 
 func extractVolumeParameters(log *zap.SugaredLogger, parameters map[string]string) (VolumeParameters, error) {
@@ -164,12 +198,87 @@ func extractVolumeParameters(log *zap.SugaredLogger, parameters map[string]strin
 				return p, status.Error(codes.InvalidArgument, err.Error())
 			}
 			p.vpusPerGB = vpusPerGB
+		case luksEncryption:
+			enabled, err := strconv.ParseBool(v)
+			if err != nil {
+				return p, status.Errorf(codes.InvalidArgument, "invalid %s: %s provided for storageclass. supported values are true and false", luksEncryption, v)
+			}
+			p.luksEncryption = enabled
+		case luksVaultSecretID:
+			if !strings.HasPrefix(v, vaultSecretOCIDPrefix) {
+				return p, status.Errorf(codes.InvalidArgument, "invalid %s: %s provided for storageclass. it must be the OCID of an OCI Vault secret", luksVaultSecretID, v)
+			}
+			p.luksVaultSecretID = v
+		case backupPolicy:
+			if v != "" {
+				p.backupPolicy = v
+			}
 		}
 
 	}
+	if p.luksVaultSecretID != "" && !p.luksEncryption {
+		return p, status.Errorf(codes.InvalidArgument, "%s can only be provided for storageclass when %s is true", luksVaultSecretID, luksEncryption)
+	}
 	return p, nil
 }
 
+// extractModifyVolumeParameters parses the mutable parameters of a VolumeAttributesClass.
+func extractModifyVolumeParameters(parameters map[string]string) (ModifyVolumeParameters, error) {
+	p := ModifyVolumeParameters{}
+	for k, v := range parameters {
+		switch k {
+		case csi_util.VpusPerGB:
+			vpusPerGB, err := csi_util.ExtractBlockVolumePerformanceLevel(v)
+			if err != nil {
+				return p, status.Error(codes.InvalidArgument, err.Error())
+			}
+			p.vpusPerGB = &vpusPerGB
+		case autoTuneMaxVpusPerGB:
+			maxVpusPerGB, err := csi_util.ExtractBlockVolumePerformanceLevel(v)
+			if err != nil {
+				return p, status.Error(codes.InvalidArgument, err.Error())
+			}
+			p.autoTuneMaxVpusPerGB = &maxVpusPerGB
+		default:
+			return p, status.Errorf(codes.InvalidArgument, "unsupported mutable parameter %q, supported parameters are %s and %s",
+				k, csi_util.VpusPerGB, autoTuneMaxVpusPerGB)
+		}
+	}
+	if p.vpusPerGB != nil && p.autoTuneMaxVpusPerGB != nil && *p.autoTuneMaxVpusPerGB != 0 && *p.autoTuneMaxVpusPerGB < *p.vpusPerGB {
+		return p, status.Errorf(codes.InvalidArgument, "%s (%d) must not be lower than %s (%d)",
+			autoTuneMaxVpusPerGB, *p.autoTuneMaxVpusPerGB, csi_util.VpusPerGB, *p.vpusPerGB)
+	}
+	return p, nil
+}
+
+// getAutotunePolicies returns the autotune policies of a volume after applying
+// the performance based auto-tune setting. Other policies (e.g. detached volume
+// auto-tune) are kept unchanged.
+func getAutotunePolicies(existing []core.AutotunePolicy, maxVpusPerGB int64) []core.AutotunePolicy {
+	policies := []core.AutotunePolicy{}
+	for _, policy := range existing {
+		if _, ok := policy.(core.PerformanceBasedAutotunePolicy); ok {
+			continue
+		}
+		policies = append(policies, policy)
+	}
+	if maxVpusPerGB > 0 {
+		policies = append(policies, core.PerformanceBasedAutotunePolicy{MaxVpusPerGB: &maxVpusPerGB})
+	}
+	return policies
+}
+
+// getPerformanceBasedAutotuneMaxVpusPerGB returns the max vpusPerGB of the
+// performance based autotune policy of a volume, zero if there is none.
+func getPerformanceBasedAutotuneMaxVpusPerGB(policies []core.AutotunePolicy) int64 {
+	for _, policy := range policies {
+		if p, ok := policy.(core.PerformanceBasedAutotunePolicy); ok && p.MaxVpusPerGB != nil {
+			return *p.MaxVpusPerGB
+		}
+	}
+	return 0
+}
+
 func extractSnapshotParameters(parameters map[string]string) (SnapshotParameters, error) {
 	p := SnapshotParameters{
 		backupType: core.CreateVolumeBackupDetailsTypeIncremental, //Default backupType is incremental
@@ -208,6 +317,12 @@ func extractSnapshotParameters(parameters map[string]string) (SnapshotParameters
 					"in volumesnapshotclass. please check the parameters block on the volume snapshot class")
 			}
 			p.definedTags = definedTags
+		case backupCopyDestinationRegion:
+			if v == "" {
+				continue
+			}
+			// region keys such as iad are accepted as well as region names
+			p.copyDestinationRegion = string(common.StringToRegion(v))
 		}
 	}
 	return p, nil
@@ -256,9 +371,37 @@ func (d *BlockVolumeControllerDriver) CreateVolume(ctx context.Context, req *csi
 		return nil, status.Errorf(codes.InvalidArgument, "failed to parse storageclass parameters %v", err)
 	}
 
+	// parameters of the VolumeAttributesClass take precedence over the storage class
+	modifyVolumeParams, err := extractModifyVolumeParameters(req.GetMutableParameters())
+	if err != nil {
+		log.With(zap.Error(err)).Error("Failed to parse volumeattributesclass parameters.")
+		metricDimension = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		dimensionsMap[metrics.ComponentDimension] = metricDimension
+		metrics.SendMetricData(d.metricPusher, metrics.PVProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse volumeattributesclass parameters %v", err)
+	}
+	if modifyVolumeParams.vpusPerGB != nil {
+		volumeParams.vpusPerGB = *modifyVolumeParams.vpusPerGB
+	}
+	if modifyVolumeParams.autoTuneMaxVpusPerGB != nil {
+		volumeParams.autotunePolicies = getAutotunePolicies(nil, *modifyVolumeParams.autoTuneMaxVpusPerGB)
+	}
+
 	dimensionsMap[metrics.ResourceOCIDDimension] = volumeName
 	dimensionsMap[metrics.VolumeVpusPerGBDimension] = strconv.Itoa(int(volumeParams.vpusPerGB))
 
+	backupPolicyID := ""
+	if volumeParams.backupPolicy != "" {
+		backupPolicyID, err = d.getVolumeBackupPolicyID(ctx, volumeParams.backupPolicy)
+		if err != nil {
+			log.With("backupPolicy", volumeParams.backupPolicy).With(zap.Error(err)).Error("Failed to find volume backup policy.")
+			metricDimension = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+			dimensionsMap[metrics.ComponentDimension] = metricDimension
+			metrics.SendMetricData(d.metricPusher, metrics.PVProvision, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, err
+		}
+	}
+
 	srcSnapshotId := ""
 	srcVolumeId := ""
 	volumeContentSource := req.GetVolumeContentSource()
@@ -279,7 +422,7 @@ func (d *BlockVolumeControllerDriver) CreateVolume(ctx context.Context, req *csi
 			}
 
 			id := srcSnapshot.GetSnapshotId()
-			volumeBackup, err := d.client.BlockStorage().GetVolumeBackup(ctx, id)
+			volumeBackup, err := d.getVolumeBackup(ctx, id)
 			if err != nil {
 				if k8sapierrors.IsNotFound(err) {
 					log.With("service", "blockstorage", "verb", "get", "resource", "volumeBackup", "statusCode", util.GetHttpStatusCode(err)).Errorf("Failed to get snapshot with ID %v", id)
@@ -289,13 +432,22 @@ func (d *BlockVolumeControllerDriver) CreateVolume(ctx context.Context, req *csi
 				return nil, status.Errorf(codes.Internal, "Failed to fetch snapshot with ID %v with error %v", id, err)
 			}
 
+			// a backup copied from another region can be referenced before the copy completes
+			if volumeBackup.LifecycleState == core.VolumeBackupLifecycleStateCreating ||
+				volumeBackup.LifecycleState == core.VolumeBackupLifecycleStateRequestReceived {
+				log.With("volumeBackupId", *volumeBackup.Id, "lifecycleState", volumeBackup.LifecycleState).
+					Info("Snapshot is not available yet, controller will retry")
+				return nil, status.Errorf(codes.Unavailable, "snapshot %s is not available yet, backup %s is still being created or copied (lifecycleState=%q)",
+					id, *volumeBackup.Id, volumeBackup.LifecycleState)
+			}
+
 			volumeBackupSize := *volumeBackup.SizeInMBs * client.MiB
 			if volumeBackupSize < size {
 				volumeContext[needResize] = "true"
 				volumeContext[newSize] = strconv.FormatInt(size, 10)
 			}
 
-			srcSnapshotId = id
+			srcSnapshotId = *volumeBackup.Id
 		} else {
 			srcVolume := volumeContentSource.GetVolume()
 			if srcVolume == nil {
@@ -420,7 +572,7 @@ func (d *BlockVolumeControllerDriver) CreateVolume(ctx context.Context, req *csi
 		bvTags := getBVTags(log, d.config.Tags, volumeParams)
 
 		provisionedVolume, err = provision(ctx, log, d.client, volumeName, size, *ad.Name, d.config.CompartmentID, srcSnapshotId, srcVolumeId,
-			volumeParams.diskEncryptionKey, volumeParams.vpusPerGB, bvTags)
+			volumeParams.diskEncryptionKey, volumeParams.vpusPerGB, volumeParams.autotunePolicies, bvTags)
 
 		if err != nil && client.IsSystemTagNotFoundOrNotAuthorisedError(log, errors.Unwrap(err)) {
 			log.With("Ad name", *ad.Name, "Compartment Id", d.config.CompartmentID).With(zap.Error(err)).Warn("New volume creation failed due to oke system tags error. sending metric & retrying without oke system tags")
@@ -432,7 +584,7 @@ func (d *BlockVolumeControllerDriver) CreateVolume(ctx context.Context, req *csi
 			// retry provision without oke system tags
 			delete(bvTags.DefinedTags, OkeSystemTagNamesapce)
 			provisionedVolume, err = provision(ctx, log, d.client, volumeName, size, *ad.Name, d.config.CompartmentID, srcSnapshotId, srcVolumeId,
-				volumeParams.diskEncryptionKey, volumeParams.vpusPerGB, bvTags)
+				volumeParams.diskEncryptionKey, volumeParams.vpusPerGB, volumeParams.autotunePolicies, bvTags)
 		}
 		if err != nil {
 			log.With("Ad name", *ad.Name, "Compartment Id", d.config.CompartmentID).With(zap.Error(err)).Error("New volume creation failed.")
@@ -464,6 +616,18 @@ func (d *BlockVolumeControllerDriver) CreateVolume(ctx context.Context, req *csi
 	if provisionedVolume.Id != nil {
 		volumeOCID = *provisionedVolume.Id
 	}
+
+	if backupPolicyID != "" {
+		if err = d.assignVolumeBackupPolicy(ctx, log, volumeOCID, backupPolicyID); err != nil {
+			log.With("service", "blockstorage", "verb", "create", "resource", "volumeBackupPolicyAssignment", "statusCode", util.GetHttpStatusCode(err)).
+				With("volumeID", volumeOCID, "backupPolicyID", backupPolicyID).With(zap.Error(err)).Error("Failed to assign volume backup policy.")
+			errorType = util.GetError(err)
+			metricDimension = util.GetMetricDimensionForComponent(errorType, metricType)
+			dimensionsMap[metrics.ComponentDimension] = metricDimension
+			metrics.SendMetricData(d.metricPusher, metric, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, status.Errorf(codes.Internal, "failed to assign volume backup policy %s to volume %s: %v", backupPolicyID, volumeOCID, err)
+		}
+	}
 	log.With("volumeID", volumeOCID).Info("Volume is created.")
 	metricDimension = util.GetMetricDimensionForComponent(util.Success, metricType)
 	dimensionsMap[metrics.ComponentDimension] = metricDimension
@@ -472,6 +636,12 @@ func (d *BlockVolumeControllerDriver) CreateVolume(ctx context.Context, req *csi
 
 	volumeContext[attachmentType] = volumeParams.attachmentParameter[attachmentType]
 	volumeContext[csi_util.VpusPerGB] = strconv.FormatInt(volumeParams.vpusPerGB, 10)
+	if volumeParams.luksEncryption {
+		volumeContext[luksEncryption] = "true"
+		if volumeParams.luksVaultSecretID != "" {
+			volumeContext[luksVaultSecretID] = volumeParams.luksVaultSecretID
+		}
+	}
 
 	return &csi.CreateVolumeResponse{
 		Volume: &csi.Volume{
@@ -513,6 +683,16 @@ func (d *BlockVolumeControllerDriver) DeleteVolume(ctx context.Context, req *csi
 		return nil, status.Error(codes.InvalidArgument, "DeleteVolume Volume ID must be provided")
 	}
 
+	if err := d.removeVolumeBackupPolicyAssignments(ctx, log, req.VolumeId); err != nil {
+		log.With("service", "blockstorage", "verb", "delete", "resource", "volumeBackupPolicyAssignment", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to remove volume backup policy assignment.")
+		errorType = util.GetError(err)
+		csiMetricDimension = util.GetMetricDimensionForComponent(errorType, util.CSIStorageType)
+		dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+		metrics.SendMetricData(d.metricPusher, metrics.PVDelete, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, fmt.Errorf("failed to remove backup policy assignment of volume, volumeId: %s, error: %v", req.VolumeId, err)
+	}
+
 	log.Info("Deleting Volume")
 	err := d.client.BlockStorage().DeleteVolume(ctx, req.VolumeId)
 	if err != nil {
@@ -535,6 +715,76 @@ func (d *BlockVolumeControllerDriver) DeleteVolume(ctx context.Context, req *csi
 	return &csi.DeleteVolumeResponse{}, nil
 }
 
+// getVolumeBackupPolicyID resolves the volume backup policy given by display
+// name or OCID in the storage class to the OCID of an existing policy.
+func (d *BlockVolumeControllerDriver) getVolumeBackupPolicyID(ctx context.Context, policy string) (string, error) {
+	if strings.HasPrefix(policy, backupPolicyOCIDPrefix) {
+		volumeBackupPolicy, err := d.client.BlockStorage().GetVolumeBackupPolicy(ctx, policy)
+		if err != nil {
+			if client.IsNotFound(err) {
+				return "", status.Errorf(codes.InvalidArgument, "volume backup policy %s provided for storageclass not found", policy)
+			}
+			return "", status.Errorf(codes.Internal, "failed to get volume backup policy %s: %v", policy, err)
+		}
+		return *volumeBackupPolicy.Id, nil
+	}
+
+	policies, err := d.client.BlockStorage().GetVolumeBackupPoliciesByName(ctx, policy, d.config.CompartmentID)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to get volume backup policy %s: %v", policy, err)
+	}
+	switch len(policies) {
+	case 0:
+		return "", status.Errorf(codes.InvalidArgument, "volume backup policy %s provided for storageclass not found", policy)
+	case 1:
+		return *policies[0].Id, nil
+	default:
+		return "", status.Errorf(codes.InvalidArgument, "found %d volume backup policies named %s, please provide the OCID of the policy for storageclass", len(policies), policy)
+	}
+}
+
+// assignVolumeBackupPolicy assigns the backup policy to the volume unless the
+// volume already has a policy assigned, which keeps retries of CreateVolume
+// idempotent and leaves policies changed outside of the cluster alone.
+func (d *BlockVolumeControllerDriver) assignVolumeBackupPolicy(ctx context.Context, log *zap.SugaredLogger, volumeID, policyID string) error {
+	assignments, err := d.client.BlockStorage().GetVolumeBackupPolicyAssignments(ctx, volumeID)
+	if err != nil {
+		return err
+	}
+	for _, assignment := range assignments {
+		if assignment.PolicyId != nil && *assignment.PolicyId != policyID {
+			log.With("volumeID", volumeID, "backupPolicyID", *assignment.PolicyId).
+				Warn("Volume already has a different backup policy assigned, leaving it as is.")
+		}
+		return nil
+	}
+
+	if _, err = d.client.BlockStorage().CreateVolumeBackupPolicyAssignment(ctx, volumeID, policyID); err != nil {
+		return err
+	}
+	log.With("volumeID", volumeID, "backupPolicyID", policyID).Info("Volume backup policy is assigned.")
+	return nil
+}
+
+// removeVolumeBackupPolicyAssignments removes the backup policy assignments of
+// a volume before it is deleted.
+func (d *BlockVolumeControllerDriver) removeVolumeBackupPolicyAssignments(ctx context.Context, log *zap.SugaredLogger, volumeID string) error {
+	assignments, err := d.client.BlockStorage().GetVolumeBackupPolicyAssignments(ctx, volumeID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	for _, assignment := range assignments {
+		if err = d.client.BlockStorage().DeleteVolumeBackupPolicyAssignment(ctx, *assignment.Id); err != nil && !client.IsNotFound(err) {
+			return err
+		}
+		log.With("policyAssignmentID", *assignment.Id).Info("Volume backup policy assignment is removed.")
+	}
+	return nil
+}
+
 // ControllerPublishVolume attaches the given volume to the node
 func (d *BlockVolumeControllerDriver) ControllerPublishVolume(ctx context.Context, req *csi.ControllerPublishVolumeRequest) (*csi.ControllerPublishVolumeResponse, error) {
 	startTime := time.Now()
@@ -603,7 +853,14 @@ func (d *BlockVolumeControllerDriver) ControllerPublishVolume(ctx context.Contex
 		return nil, status.Errorf(codes.Unknown, "failed to get compartmentID from node annotation:. error : %s", err)
 	}
 
-	volumeAttached, err := d.client.Compute().FindActiveVolumeAttachment(ctx, compartmentID, req.VolumeId)
+	isReadOnly, isShareable := getAttachmentAccess(req.VolumeCapability.GetAccessMode().GetMode())
+	// A shareable volume may be attached to other nodes as well, so only its
+	// attachment to this node matters.
+	attachedInstanceID := ""
+	if isShareable {
+		attachedInstanceID = id
+	}
+	volumeAttached, err := d.client.Compute().FindActiveVolumeAttachment(ctx, compartmentID, req.VolumeId, attachedInstanceID)
 
 	if err != nil && !client.IsNotFound(err) {
 		log.With("service", "compute", "verb", "get", "resource", "volumeAttachment", "statusCode", util.GetHttpStatusCode(err)).
@@ -675,10 +932,10 @@ func (d *BlockVolumeControllerDriver) ControllerPublishVolume(ctx context.Contex
 		}
 	}
 
-	log.Info("Attaching volume to instance")
+	log.With("isReadOnly", isReadOnly, "isShareable", isShareable).Info("Attaching volume to instance")
 
 	if volumeAttachmentOptions.useParavirtualizedAttachment {
-		volumeAttached, err = d.client.Compute().AttachParavirtualizedVolume(ctx, id, req.VolumeId, volumeAttachmentOptions.enableInTransitEncryption)
+		volumeAttached, err = d.client.Compute().AttachParavirtualizedVolume(ctx, id, req.VolumeId, volumeAttachmentOptions.enableInTransitEncryption, isReadOnly, isShareable)
 		if err != nil {
 			log.With("service", "compute", "verb", "create", "resource", "volumeAttachment", "statusCode", util.GetHttpStatusCode(err)).
 				With("instanceID", id).With(zap.Error(err)).Info("failed paravirtualized attachment instance to volume.")
@@ -689,7 +946,7 @@ func (d *BlockVolumeControllerDriver) ControllerPublishVolume(ctx context.Contex
 			return nil, status.Errorf(codes.Internal, "failed paravirtualized attachment instance to volume. error : %s", err)
 		}
 	} else {
-		volumeAttached, err = d.client.Compute().AttachVolume(ctx, id, req.VolumeId)
+		volumeAttached, err = d.client.Compute().AttachVolume(ctx, id, req.VolumeId, isReadOnly, isShareable)
 		if err != nil {
 			log.With("service", "compute", "verb", "create", "resource", "volumeAttachment", "statusCode", util.GetHttpStatusCode(err)).
 				With("instanceID", id).With(zap.Error(err)).Info("failed iscsi attachment instance to volume.")
@@ -806,7 +1063,21 @@ func (d *BlockVolumeControllerDriver) ControllerUnpublishVolume(ctx context.Cont
 		return nil, status.Errorf(codes.Unknown, "failed to get compartmentID from node annotation:: error : %s", err)
 	}
 	log = log.With("compartmentID", compartmentID)
-	attachedVolume, err := d.client.Compute().FindVolumeAttachment(ctx, compartmentID, req.VolumeId)
+
+	// A volume can be attached to many nodes, so only its attachment to this
+	// node is detached.
+	id, err := d.util.LookupNodeID(d.KubeClient, req.NodeId)
+	if err != nil {
+		log.With(zap.Error(err)).Error("Failed to lookup node")
+		errorType = util.GetError(err)
+		csiMetricDimension = util.GetMetricDimensionForComponent(errorType, util.CSIStorageType)
+		dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+		metrics.SendMetricData(d.metricPusher, metrics.PVDetach, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.Unknown, "failed to get ProviderID by nodeName. error : %s", err)
+	}
+	id = client.MapProviderIDToResourceID(id)
+
+	attachedVolume, err := d.client.Compute().FindActiveVolumeAttachment(ctx, compartmentID, req.VolumeId, id)
 	if attachedVolume != nil && attachedVolume.GetId() != nil {
 		log = log.With("volumeAttachedId", *attachedVolume.GetId())
 	}
@@ -820,11 +1091,12 @@ func (d *BlockVolumeControllerDriver) ControllerUnpublishVolume(ctx context.Cont
 			metrics.SendMetricData(d.metricPusher, metrics.PVDetach, time.Since(startTime).Seconds(), dimensionsMap)
 			return nil, err
 		}
-		if attachedVolume == nil {
-			log.With("service", "compute", "verb", "get", "resource", "volumeAttachment", "statusCode", util.GetHttpStatusCode(err)).With(zap.Error(err)).
-				Error("Unable to find volume attachment for volume to detach. Volume is possibly already detached. Nothing to do in Un-publish Volume.")
-			return &csi.ControllerUnpublishVolumeResponse{}, nil
-		}
+	}
+	if attachedVolume == nil {
+		log.With("instanceID", id).Info("Unable to find volume attachment for volume to detach. Volume is possibly already detached. Nothing to do in Un-publish Volume.")
+		return &csi.ControllerUnpublishVolumeResponse{}, nil
+	}
+	if attachedVolume.GetLifecycleState() == core.VolumeAttachmentLifecycleStateDetaching {
 		log.Info("Attached Volume is still in Detaching state")
 	}
 	if attachedVolume.GetLifecycleState() != core.VolumeAttachmentLifecycleStateDetaching {
@@ -897,13 +1169,13 @@ func (d *BlockVolumeControllerDriver) ValidateVolumeCapabilities(ctx context.Con
 	}
 
 	if *volume.Id == req.VolumeId {
+		if err := d.validateCapabilities(req.VolumeCapabilities); err != nil {
+			log.With(zap.Error(err)).Info("Requested volume capabilities are not supported.")
+			return &csi.ValidateVolumeCapabilitiesResponse{Message: err.Error()}, nil
+		}
 		return &csi.ValidateVolumeCapabilitiesResponse{
 			Confirmed: &csi.ValidateVolumeCapabilitiesResponse_Confirmed{
-				VolumeCapabilities: []*csi.VolumeCapability{
-					{
-						AccessMode: supportedAccessMode,
-					},
-				},
+				VolumeCapabilities: req.VolumeCapabilities,
 			},
 		}, nil
 	}
@@ -938,7 +1210,11 @@ func (d *BlockVolumeControllerDriver) ControllerGetCapabilities(ctx context.Cont
 		csi.ControllerServiceCapability_RPC_PUBLISH_UNPUBLISH_VOLUME,
 		csi.ControllerServiceCapability_RPC_EXPAND_VOLUME,
 		csi.ControllerServiceCapability_RPC_CREATE_DELETE_SNAPSHOT,
+		csi.ControllerServiceCapability_RPC_LIST_SNAPSHOTS,
 		csi.ControllerServiceCapability_RPC_CLONE_VOLUME,
+		csi.ControllerServiceCapability_RPC_MODIFY_VOLUME,
+		csi.ControllerServiceCapability_RPC_GET_VOLUME,
+		csi.ControllerServiceCapability_RPC_VOLUME_CONDITION,
 	} {
 		caps = append(caps, newCap(cap))
 	}
@@ -953,10 +1229,8 @@ func (d *BlockVolumeControllerDriver) ControllerGetCapabilities(ctx context.Cont
 // validateCapabilities validates the requested capabilities. It returns an error
 // if it doesn't satisfy the currently supported modes of OCI Block Volume
 func (d *BlockVolumeControllerDriver) validateCapabilities(caps []*csi.VolumeCapability) error {
-	vcaps := []*csi.VolumeCapability_AccessMode{supportedAccessMode}
-
 	hasSupport := func(mode csi.VolumeCapability_AccessMode_Mode) bool {
-		for _, m := range vcaps {
+		for _, m := range supportedAccessModes {
 			if mode == m.Mode {
 				return true
 			}
@@ -965,23 +1239,37 @@ func (d *BlockVolumeControllerDriver) validateCapabilities(caps []*csi.VolumeCap
 	}
 
 	for _, cap := range caps {
-		if blk := cap.GetBlock(); blk != nil {
-			d.logger.Error("volumeMode is set to Block which is not supported.")
-			return fmt.Errorf("driver does not support Block volumeMode. Please use Filesystem mode")
-		}
-		if hasSupport(cap.AccessMode.Mode) {
-			continue
-		} else {
+		if !hasSupport(cap.GetAccessMode().GetMode()) {
 			// we need to make sure all capabilities are supported. Revert back
 			// in case we have a cap that is supported, but is invalidated now
 			d.logger.Errorf("The VolumeCapability isn't supported: %s", cap.AccessMode)
-			return fmt.Errorf("invalid volume capabilities requested. Only SINGLE_NODE_WRITER is supported ('accessModes.ReadWriteOnce' on Kubernetes)")
+			return fmt.Errorf("invalid volume capabilities requested. Only SINGLE_NODE_WRITER, MULTI_NODE_READER_ONLY and MULTI_NODE_MULTI_WRITER are supported ('accessModes.ReadWriteOnce', 'accessModes.ReadOnlyMany' and 'accessModes.ReadWriteMany' on Kubernetes)")
+		}
+		// A file system mounted read/write by more than one node at a time
+		// gets corrupted, so nodes share writes only to raw block devices,
+		// e.g. for a cluster file system.
+		if cap.GetAccessMode().GetMode() == csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER && cap.GetBlock() == nil {
+			d.logger.Errorf("The VolumeCapability isn't supported: %s without raw block access", cap.AccessMode)
+			return fmt.Errorf("invalid volume capabilities requested. MULTI_NODE_MULTI_WRITER is only supported for raw block volumes ('volumeMode: Block' on Kubernetes)")
 		}
 	}
 
 	return nil
 }
 
+// getAttachmentAccess returns whether the attachments of a volume published
+// with the access mode are read-only, and whether they are shareable so that
+// the volume can be attached to other nodes at the same time.
+func getAttachmentAccess(mode csi.VolumeCapability_AccessMode_Mode) (isReadOnly, isShareable bool) {
+	switch mode {
+	case csi.VolumeCapability_AccessMode_MULTI_NODE_READER_ONLY:
+		return true, true
+	case csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER:
+		return false, true
+	}
+	return false, false
+}
+
 // CreateSnapshot will be called by the CO to create a new snapshot from a
 // source volume on behalf of a user.
 func (d *BlockVolumeControllerDriver) CreateSnapshot(ctx context.Context, req *csi.CreateSnapshotRequest) (*csi.CreateSnapshotResponse, error) {
@@ -1010,6 +1298,15 @@ func (d *BlockVolumeControllerDriver) CreateSnapshot(ctx context.Context, req *c
 		return nil, status.Error(codes.InvalidArgument, "Volume snapshot source ID must be provided")
 	}
 
+	snapshotParams, err := extractSnapshotParameters(req.GetParameters())
+	if err != nil {
+		log.With(zap.Error(err)).Error("Failed to parse volumesnapshotclass parameters.")
+		snapshotMetricDimension = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		dimensionsMap[metrics.ComponentDimension] = snapshotMetricDimension
+		metrics.SendMetricData(d.metricPusher, metrics.BlockSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse volumesnapshotclass parameters %v", err)
+	}
+
 	snapshots, err := d.client.BlockStorage().GetVolumeBackupsByName(ctx, req.Name, d.config.CompartmentID)
 	if err != nil {
 		errorType = util.GetError(err)
@@ -1059,6 +1356,17 @@ func (d *BlockVolumeControllerDriver) CreateSnapshot(ctx context.Context, req *c
 			return nil, status.Errorf(codes.Internal, "Backup did not become available %q: %v", req.Name, err)
 		}
 
+		if blockVolumeAvailable && snapshotParams.copyDestinationRegion != "" {
+			blockVolumeAvailable, err = d.copyVolumeBackup(ctx, log, &snapshot, req.Name, snapshotParams.copyDestinationRegion)
+			if err != nil {
+				errorType = util.GetError(err)
+				snapshotMetricDimension = util.GetMetricDimensionForComponent(errorType, util.CSIStorageType)
+				dimensionsMap[metrics.ComponentDimension] = snapshotMetricDimension
+				metrics.SendMetricData(d.metricPusher, metrics.BlockSnapshotProvision, time.Since(snapshot.TimeRequestReceived.Time).Seconds(), dimensionsMap)
+				return nil, status.Errorf(codes.Internal, "Failed to copy snapshot %q to region %s: %v", req.Name, snapshotParams.copyDestinationRegion, err)
+			}
+		}
+
 		if blockVolumeAvailable {
 			log.Info("Snapshot is created and available.")
 			snapshotMetricDimension = util.GetMetricDimensionForComponent(util.Success, util.CSIStorageType)
@@ -1082,15 +1390,6 @@ func (d *BlockVolumeControllerDriver) CreateSnapshot(ctx context.Context, req *c
 		}, nil
 	}
 
-	snapshotParams, err := extractSnapshotParameters(req.GetParameters())
-	if err != nil {
-		log.With(zap.Error(err)).Error("Failed to parse volumesnapshotclass parameters.")
-		snapshotMetricDimension = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
-		dimensionsMap[metrics.ComponentDimension] = snapshotMetricDimension
-		metrics.SendMetricData(d.metricPusher, metrics.BlockSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
-		return nil, status.Errorf(codes.InvalidArgument, "failed to parse volumesnapshotclass parameters %v", err)
-	}
-
 	backupTags := &config.TagConfig{
 		FreeformTags: snapshotParams.freeformTags,
 		DefinedTags:  snapshotParams.definedTags,
@@ -1147,8 +1446,24 @@ func (d *BlockVolumeControllerDriver) CreateSnapshot(ctx context.Context, req *c
 		}
 	}
 
-	log.Info("Snapshot is created and available.")
-	snapshotMetricDimension = util.GetMetricDimensionForComponent(util.Success, util.CSIStorageType)
+	readyToUse := true
+	if snapshotParams.copyDestinationRegion != "" {
+		readyToUse, err = d.copyVolumeBackup(ctx, log, snapshot, req.Name, snapshotParams.copyDestinationRegion)
+		if err != nil {
+			errorType = util.GetError(err)
+			snapshotMetricDimension = util.GetMetricDimensionForComponent(errorType, util.CSIStorageType)
+			dimensionsMap[metrics.ComponentDimension] = snapshotMetricDimension
+			metrics.SendMetricData(d.metricPusher, metrics.BlockSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, status.Errorf(codes.Internal, "Failed to copy snapshot %q to region %s: %v", req.Name, snapshotParams.copyDestinationRegion, err)
+		}
+	}
+
+	if readyToUse {
+		log.Info("Snapshot is created and available.")
+		snapshotMetricDimension = util.GetMetricDimensionForComponent(util.Success, util.CSIStorageType)
+	} else {
+		snapshotMetricDimension = util.GetMetricDimensionForComponent(util.BackupCreating, util.CSIStorageType)
+	}
 	dimensionsMap[metrics.ComponentDimension] = snapshotMetricDimension
 	metrics.SendMetricData(d.metricPusher, metrics.BlockSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
 
@@ -1158,11 +1473,89 @@ func (d *BlockVolumeControllerDriver) CreateSnapshot(ctx context.Context, req *c
 			SourceVolumeId: *snapshot.VolumeId,
 			SizeBytes:      *snapshot.SizeInMBs * client.MiB,
 			CreationTime:   ts,
-			ReadyToUse:     true,
+			ReadyToUse:     readyToUse,
 		},
 	}, nil
 }
 
+// copyVolumeBackup makes sure an available backup has a copy in the
+// destination region and reports whether the copy is available. The snapshot
+// stays not ready to use while the copy is in flight, so that the controller
+// keeps polling it and logging the state of the copy. A faulty copy is copied
+// again.
+func (d *BlockVolumeControllerDriver) copyVolumeBackup(ctx context.Context, log *zap.SugaredLogger, backup *core.VolumeBackup, name, destinationRegion string) (bool, error) {
+	log = log.With("destinationRegion", destinationRegion)
+	backupCopy, err := d.client.BlockStorage().GetVolumeBackupCopy(ctx, backup, destinationRegion)
+	if err != nil {
+		log.With("service", "blockstorage", "verb", "list", "resource", "volumeBackup", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to look up the copy of the backup.")
+		return false, err
+	}
+	if backupCopy != nil && backupCopy.LifecycleState == core.VolumeBackupLifecycleStateFaulty {
+		log.With("volumeBackupCopyId", *backupCopy.Id).Warn("Copy of the backup is faulty, copying the backup again.")
+		backupCopy = nil
+	}
+	if backupCopy == nil {
+		backupCopy, err = d.client.BlockStorage().CopyVolumeBackup(ctx, *backup.Id, destinationRegion, name)
+		if err != nil {
+			log.With("service", "blockstorage", "verb", "copy", "resource", "volumeBackup", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Failed to copy the backup.")
+			return false, err
+		}
+		log.With("volumeBackupCopyId", *backupCopy.Id).Info("Started copying the backup to the destination region.")
+	}
+	log = log.With("volumeBackupCopyId", *backupCopy.Id)
+
+	if backupCopy.LifecycleState != core.VolumeBackupLifecycleStateAvailable {
+		log.With("lifecycleState", backupCopy.LifecycleState).Info("Backup is being copied to the destination region, controller will retry")
+		return false, nil
+	}
+	log.Info("Copy of the backup is available in the destination region.")
+	return true, nil
+}
+
+// getVolumeBackup returns the volume backup of a snapshot. The ID of a backup
+// in another region resolves to its copy in the region of the cluster, so that
+// a pre-provisioned VolumeSnapshotContent can keep the ID of the original backup.
+// The copy is looked up in the compartment of the original backup, which is
+// read from the region named in its OCID.
+func (d *BlockVolumeControllerDriver) getVolumeBackup(ctx context.Context, id string) (*core.VolumeBackup, error) {
+	volumeBackup, err := d.client.BlockStorage().GetVolumeBackup(ctx, id)
+	if err == nil || !client.IsNotFound(err) {
+		return volumeBackup, err
+	}
+	region := getRegionFromOCID(id)
+	if region == "" {
+		return nil, err
+	}
+	sourceBackup, sourceErr := d.client.BlockStorage().GetVolumeBackupInRegion(ctx, id, region)
+	if sourceErr != nil {
+		if client.IsNotFound(sourceErr) {
+			return nil, err
+		}
+		return nil, sourceErr
+	}
+	backupCopy, copyErr := d.client.BlockStorage().GetVolumeBackupCopy(ctx, sourceBackup, "")
+	if copyErr != nil {
+		return nil, copyErr
+	}
+	if backupCopy == nil {
+		return nil, err
+	}
+	return backupCopy, nil
+}
+
+// getRegionFromOCID returns the region segment of an OCID, which is either a
+// region key or a region identifier, or an empty string if it has none.
+func getRegionFromOCID(id string) string {
+	// ocid1.<resource type>.<realm>.<region>.<unique ID>
+	parts := strings.Split(id, ".")
+	if len(parts) < 5 {
+		return ""
+	}
+	return parts[3]
+}
+
 // DeleteSnapshot will be called by the CO to delete a snapshot.
 func (d *BlockVolumeControllerDriver) DeleteSnapshot(ctx context.Context, req *csi.DeleteSnapshotRequest) (*csi.DeleteSnapshotResponse, error) {
 	startTime := time.Now()
@@ -1201,7 +1594,85 @@ func (d *BlockVolumeControllerDriver) DeleteSnapshot(ctx context.Context, req *c
 
 // ListSnapshots returns all the matched snapshots
 func (d *BlockVolumeControllerDriver) ListSnapshots(ctx context.Context, req *csi.ListSnapshotsRequest) (*csi.ListSnapshotsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "ListSnapshots is not supported yet")
+	log := d.logger.With("snapshotId", req.SnapshotId, "sourceVolumeId", req.SourceVolumeId, "csiOperation", "listSnapshots")
+
+	if req.MaxEntries < 0 {
+		log.Errorf("Invalid max entries %d", req.MaxEntries)
+		return nil, status.Errorf(codes.InvalidArgument, "max_entries must not be negative, got %d", req.MaxEntries)
+	}
+
+	if req.SnapshotId != "" {
+		snapshot, err := d.getVolumeBackup(ctx, req.SnapshotId)
+		if err != nil {
+			if client.IsNotFound(err) {
+				log.Info("Snapshot not found, returning empty list")
+				return &csi.ListSnapshotsResponse{}, nil
+			}
+			log.With("service", "blockstorage", "verb", "get", "resource", "volumeBackup", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Failed to get snapshot.")
+			return nil, status.Errorf(codes.Internal, "failed to get snapshot %s: %v", req.SnapshotId, err)
+		}
+		if req.SourceVolumeId != "" && (snapshot.VolumeId == nil || *snapshot.VolumeId != req.SourceVolumeId) {
+			log.Info("Snapshot does not belong to the requested source volume, returning empty list")
+			return &csi.ListSnapshotsResponse{}, nil
+		}
+		var entries []*csi.ListSnapshotsResponse_Entry
+		if entry := getListSnapshotsEntry(*snapshot); entry != nil {
+			entries = append(entries, entry)
+		}
+		return &csi.ListSnapshotsResponse{Entries: entries}, nil
+	}
+
+	snapshots, nextToken, err := d.client.BlockStorage().ListVolumeBackups(ctx, d.config.CompartmentID, req.SourceVolumeId, int(req.MaxEntries), req.StartingToken)
+	if err != nil {
+		log.With("service", "blockstorage", "verb", "list", "resource", "volumeBackup", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to list snapshots.")
+		if req.StartingToken != "" && util.GetHttpStatusCode(err) == http.StatusBadRequest {
+			return nil, status.Errorf(codes.Aborted, "invalid starting_token %q: %v", req.StartingToken, err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list snapshots: %v", err)
+	}
+
+	entries := make([]*csi.ListSnapshotsResponse_Entry, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		if entry := getListSnapshotsEntry(snapshot); entry != nil {
+			entries = append(entries, entry)
+		}
+	}
+
+	log.With("snapshotCount", len(entries), "nextToken", nextToken).Info("Listed snapshots.")
+	return &csi.ListSnapshotsResponse{
+		Entries:   entries,
+		NextToken: nextToken,
+	}, nil
+}
+
+// getListSnapshotsEntry converts a volume backup into a ListSnapshots entry.
+// Backups that are being or have been deleted are not reported and yield nil.
+func getListSnapshotsEntry(snapshot core.VolumeBackup) *csi.ListSnapshotsResponse_Entry {
+	if snapshot.Id == nil ||
+		snapshot.LifecycleState == core.VolumeBackupLifecycleStateTerminating ||
+		snapshot.LifecycleState == core.VolumeBackupLifecycleStateTerminated {
+		return nil
+	}
+
+	// a faulty backup is reported as not ready rather than failing the whole listing
+	readyToUse, _ := isBlockVolumeAvailable(snapshot)
+
+	csiSnapshot := &csi.Snapshot{
+		SnapshotId: *snapshot.Id,
+		ReadyToUse: readyToUse,
+	}
+	if snapshot.VolumeId != nil {
+		csiSnapshot.SourceVolumeId = *snapshot.VolumeId
+	}
+	if snapshot.SizeInMBs != nil {
+		csiSnapshot.SizeBytes = *snapshot.SizeInMBs * client.MiB
+	}
+	if snapshot.TimeCreated != nil {
+		csiSnapshot.CreationTime = timestamppb.New(snapshot.TimeCreated.Time)
+	}
+	return &csi.ListSnapshotsResponse_Entry{Snapshot: csiSnapshot}
 }
 
 // ControllerExpandVolume returns ControllerExpandVolume request
@@ -1291,17 +1762,169 @@ func (d *BlockVolumeControllerDriver) ControllerExpandVolume(ctx context.Context
 	}, nil
 }
 
-// ControllerGetVolume returns ControllerGetVolumeResponse response
+// ControllerGetVolume returns the current state of the block volume along with
+// its VolumeCondition so that out of band deletion, faulty volumes and broken
+// attachments can be surfaced by the external health monitor.
 func (d *BlockVolumeControllerDriver) ControllerGetVolume(ctx context.Context, req *csi.ControllerGetVolumeRequest) (*csi.ControllerGetVolumeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "ControllerGetVolume is not supported yet")
+	volumeId := req.GetVolumeId()
+	if volumeId == "" {
+		return nil, status.Error(codes.InvalidArgument, "Volume ID must be provided")
+	}
+	log := d.logger.With("volumeID", volumeId, "csiOperation", "getVolume")
+
+	volume, err := d.client.BlockStorage().GetVolume(ctx, volumeId)
+	if err != nil && !client.IsNotFound(err) {
+		log.With("service", "blockstorage", "verb", "get", "resource", "volume", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to get volume.")
+		return nil, status.Errorf(codes.Internal, "failed to get volume %s: %v", volumeId, err)
+	}
+	if volume == nil || volume.Id == nil {
+		log.Warn("Volume not found, it may have been deleted outside of Kubernetes.")
+		return getControllerGetVolumeResponse(volumeId, 0, true, fmt.Sprintf("volume %s not found, it may have been deleted", volumeId)), nil
+	}
+
+	var capacityBytes int64
+	if volume.SizeInMBs != nil {
+		capacityBytes = *volume.SizeInMBs * client.MiB
+	}
+
+	switch state := volume.LifecycleState; state {
+	case core.VolumeLifecycleStateFaulty,
+		core.VolumeLifecycleStateTerminating,
+		core.VolumeLifecycleStateTerminated:
+		log.With("lifecycleState", state).Warn("Volume is in an abnormal lifecycle state.")
+		return getControllerGetVolumeResponse(volumeId, capacityBytes, true, fmt.Sprintf("volume is in %s state", state)), nil
+	}
+
+	compartmentID := d.config.CompartmentID
+	if volume.CompartmentId != nil {
+		compartmentID = *volume.CompartmentId
+	}
+	attachments, err := d.client.Compute().ListVolumeAttachments(ctx, compartmentID, volumeId)
+	if err != nil && !client.IsNotFound(err) {
+		log.With("service", "compute", "verb", "list", "resource", "volumeAttachment", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to list volume attachments.")
+		return nil, status.Errorf(codes.Internal, "failed to list attachments of volume %s: %v", volumeId, err)
+	}
+	// a shareable volume can be attached to several instances, it is only abnormal
+	// when an attachment is detaching and no other attachment is attached
+	var detachingAttachment core.VolumeAttachment
+	for _, attachment := range attachments {
+		switch attachment.GetLifecycleState() {
+		case core.VolumeAttachmentLifecycleStateAttached:
+			return getControllerGetVolumeResponse(volumeId, capacityBytes, false, fmt.Sprintf("volume is in %s state", volume.LifecycleState)), nil
+		case core.VolumeAttachmentLifecycleStateDetaching:
+			if detachingAttachment == nil {
+				detachingAttachment = attachment
+			}
+		}
+	}
+	if detachingAttachment != nil {
+		var instanceID string
+		if detachingAttachment.GetInstanceId() != nil {
+			instanceID = *detachingAttachment.GetInstanceId()
+		}
+		state := detachingAttachment.GetLifecycleState()
+		log.With("lifecycleState", state, "instanceID", instanceID).Warn("Volume attachment is no longer attached.")
+		return getControllerGetVolumeResponse(volumeId, capacityBytes, true,
+			fmt.Sprintf("volume attachment to instance %s is in %s state", instanceID, state)), nil
+	}
+
+	return getControllerGetVolumeResponse(volumeId, capacityBytes, false, fmt.Sprintf("volume is in %s state", volume.LifecycleState)), nil
 }
 
-func (d *BlockVolumeControllerDriver) ControllerModifyVolume(ctx context.Context, request *csi.ControllerModifyVolumeRequest) (*csi.ControllerModifyVolumeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "ControllerModifyVolume is not supported yet")
+// ControllerModifyVolume changes the performance level and auto-tune settings of
+// a block volume when the VolumeAttributesClass of its PVC is changed.
+func (d *BlockVolumeControllerDriver) ControllerModifyVolume(ctx context.Context, req *csi.ControllerModifyVolumeRequest) (*csi.ControllerModifyVolumeResponse, error) {
+	startTime := time.Now()
+	volumeId := req.GetVolumeId()
+	if volumeId == "" {
+		return nil, status.Error(codes.InvalidArgument, "ModifyVolume volumeId must be provided")
+	}
+	log := d.logger.With("volumeID", volumeId, "csiOperation", "modifyVolume")
+	var errorType string
+	var csiMetricDimension string
+
+	dimensionsMap := make(map[string]string)
+	dimensionsMap[metrics.ResourceOCIDDimension] = volumeId
+
+	modifyVolumeParams, err := extractModifyVolumeParameters(req.GetMutableParameters())
+	if err != nil {
+		log.With(zap.Error(err)).Error("Failed to parse volumeattributesclass parameters.")
+		csiMetricDimension = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+		metrics.SendMetricData(d.metricPusher, metrics.PVModify, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse volumeattributesclass parameters %v", err)
+	}
+
+	volume, err := d.client.BlockStorage().GetVolume(ctx, volumeId)
+	if err != nil {
+		log.With("service", "blockstorage", "verb", "get", "resource", "volume", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to find existence of volume")
+		errorType = util.GetError(err)
+		csiMetricDimension = util.GetMetricDimensionForComponent(errorType, util.CSIStorageType)
+		dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+		metrics.SendMetricData(d.metricPusher, metrics.PVModify, time.Since(startTime).Seconds(), dimensionsMap)
+		if client.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "volume %s not found", volumeId)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to check existence of volume %v", err)
+	}
+
+	log = log.With("volumeName", volume.DisplayName)
+	updateVolumeDetails := core.UpdateVolumeDetails{
+		DisplayName: volume.DisplayName,
+	}
+	needsUpdate := false
+
+	if modifyVolumeParams.vpusPerGB != nil && (volume.VpusPerGB == nil || *volume.VpusPerGB != *modifyVolumeParams.vpusPerGB) {
+		updateVolumeDetails.VpusPerGB = modifyVolumeParams.vpusPerGB
+		dimensionsMap[metrics.VolumeVpusPerGBDimension] = strconv.FormatInt(*modifyVolumeParams.vpusPerGB, 10)
+		needsUpdate = true
+	}
+	if modifyVolumeParams.autoTuneMaxVpusPerGB != nil && getPerformanceBasedAutotuneMaxVpusPerGB(volume.AutotunePolicies) != *modifyVolumeParams.autoTuneMaxVpusPerGB {
+		updateVolumeDetails.AutotunePolicies = getAutotunePolicies(volume.AutotunePolicies, *modifyVolumeParams.autoTuneMaxVpusPerGB)
+		needsUpdate = true
+	}
+
+	if !needsUpdate {
+		log.Info("Volume already has the requested attributes. No action needed.")
+		return &csi.ControllerModifyVolumeResponse{}, nil
+	}
+
+	_, err = d.client.BlockStorage().UpdateVolume(ctx, volumeId, updateVolumeDetails)
+	if err != nil {
+		message := fmt.Sprintf("Update volume failed %v", err)
+		log.With("service", "blockstorage", "verb", "update", "resource", "volume", "statusCode", util.GetHttpStatusCode(err)).
+			Error(message)
+		errorType = util.GetError(err)
+		csiMetricDimension = util.GetMetricDimensionForComponent(errorType, util.CSIStorageType)
+		dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+		metrics.SendMetricData(d.metricPusher, metrics.PVModify, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Error(codes.Internal, message)
+	}
+
+	_, err = d.client.BlockStorage().AwaitVolumeAvailableORTimeout(ctx, volumeId)
+	if err != nil {
+		log.With("service", "blockstorage", "verb", "get", "resource", "volume", "statusCode", util.GetHttpStatusCode(err)).
+			Error("Volume modification failed with time out")
+		errorType = util.GetError(err)
+		csiMetricDimension = util.GetMetricDimensionForComponent(errorType, util.CSIStorageType)
+		dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+		metrics.SendMetricData(d.metricPusher, metrics.PVModify, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.DeadlineExceeded, "ControllerModifyVolume failed with time out %v", err.Error())
+	}
+
+	log.Info("Volume is modified.")
+	csiMetricDimension = util.GetMetricDimensionForComponent(util.Success, util.CSIStorageType)
+	dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+	metrics.SendMetricData(d.metricPusher, metrics.PVModify, time.Since(startTime).Seconds(), dimensionsMap)
+
+	return &csi.ControllerModifyVolumeResponse{}, nil
 }
 
 func provision(ctx context.Context, log *zap.SugaredLogger, c client.Interface, volName string, volSize int64, availDomainName, compartmentID,
-	backupID, srcVolumeID, kmsKeyID string, vpusPerGB int64, bvTags *config.TagConfig) (core.Volume, error) {
+	backupID, srcVolumeID, kmsKeyID string, vpusPerGB int64, autotunePolicies []core.AutotunePolicy, bvTags *config.TagConfig) (core.Volume, error) {
 
 	volSizeGB, minSizeGB := csi_util.RoundUpSize(volSize, 1*client.GiB), csi_util.RoundUpMinSize()
 
@@ -1326,6 +1949,9 @@ func provision(ctx context.Context, log *zap.SugaredLogger, c client.Interface,
 	if kmsKeyID != "" {
 		volumeDetails.KmsKeyId = &kmsKeyID
 	}
+	if len(autotunePolicies) > 0 {
+		volumeDetails.AutotunePolicies = autotunePolicies
+	}
 	if bvTags != nil && bvTags.FreeformTags != nil {
 		volumeDetails.FreeformTags = bvTags.FreeformTags
 	}
@@ -1367,6 +1993,21 @@ func getAttachmentOptions(ctx context.Context, client client.ComputeInterface, a
 	return volumeAttachmentOption, nil
 }
 
+func getControllerGetVolumeResponse(volumeId string, capacityBytes int64, abnormal bool, message string) *csi.ControllerGetVolumeResponse {
+	return &csi.ControllerGetVolumeResponse{
+		Volume: &csi.Volume{
+			VolumeId:      volumeId,
+			CapacityBytes: capacityBytes,
+		},
+		Status: &csi.ControllerGetVolumeResponse_VolumeStatus{
+			VolumeCondition: &csi.VolumeCondition{
+				Abnormal: abnormal,
+				Message:  message,
+			},
+		},
+	}
+}
+
 func isBlockVolumeAvailable(backup core.VolumeBackup) (bool, error) {
 	switch state := backup.LifecycleState; state {
 	case core.VolumeBackupLifecycleStateAvailable:
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -25,6 +26,7 @@ import (
 	"github.com/container-storage-interface/spec/lib/go/csi"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	authv1 "k8s.io/api/authentication/v1"
 	kubeAPI "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -91,6 +93,27 @@ var (
 			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
 			Id:                 common.String("clone-volume-in-provisioning-state"),
 		},
+		"volume-in-faulty-state": {
+			DisplayName:        common.String("volume-in-faulty-state"),
+			LifecycleState:     core.VolumeLifecycleStateFaulty,
+			SizeInMBs:          common.Int64(50000),
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("volume-in-faulty-state"),
+		},
+		"volume-with-detaching-attachment": {
+			DisplayName:        common.String("volume-with-detaching-attachment"),
+			LifecycleState:     core.VolumeLifecycleStateAvailable,
+			SizeInMBs:          common.Int64(50000),
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("volume-with-detaching-attachment"),
+		},
+		"shareable-volume-with-detaching-attachment": {
+			DisplayName:        common.String("shareable-volume-with-detaching-attachment"),
+			LifecycleState:     core.VolumeLifecycleStateAvailable,
+			SizeInMBs:          common.Int64(50000),
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("shareable-volume-with-detaching-attachment"),
+		},
 	}
 
 	create_volume_requests = map[string]*csi.CreateVolumeRequest{
@@ -140,6 +163,144 @@ var (
 		},
 	}
 
+	backupCreationTime = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	volume_backups = []core.VolumeBackup{
+		{
+			Id:             common.String("volume-backup-available"),
+			VolumeId:       common.String("volume-in-available-state"),
+			LifecycleState: core.VolumeBackupLifecycleStateAvailable,
+			SizeInMBs:      common.Int64(51200),
+		},
+		{
+			Id:             common.String("volume-backup-creating"),
+			VolumeId:       common.String("volume-in-available-state"),
+			LifecycleState: core.VolumeBackupLifecycleStateCreating,
+			SizeInMBs:      common.Int64(51200),
+		},
+		{
+			Id:             common.String("volume-backup-terminated"),
+			VolumeId:       common.String("volume-in-available-state"),
+			LifecycleState: core.VolumeBackupLifecycleStateTerminated,
+			SizeInMBs:      common.Int64(51200),
+		},
+		{
+			Id:             common.String("volume-backup-of-other-volume"),
+			VolumeId:       common.String("volume-in-provisioning-state"),
+			LifecycleState: core.VolumeBackupLifecycleStateFaulty,
+			SizeInMBs:      common.Int64(51200),
+		},
+	}
+	// copies in the region of the cluster, keyed by the backup in another region they were copied from
+	volume_backup_copies = map[string]core.VolumeBackup{
+		"ocid1.volumebackup.oc1.phx.source-region-volume-backup": {
+			Id:                   common.String("volume-backup-copy-available"),
+			CompartmentId:        common.String("source-region-backup-compartment"),
+			VolumeId:             common.String("source-region-volume"),
+			SourceVolumeBackupId: common.String("ocid1.volumebackup.oc1.phx.source-region-volume-backup"),
+			LifecycleState:       core.VolumeBackupLifecycleStateAvailable,
+			SizeInMBs:            common.Int64(51200),
+		},
+		"ocid1.volumebackup.oc1.phx.source-region-volume-backup-copying": {
+			Id:                   common.String("volume-backup-copy-creating"),
+			CompartmentId:        common.String("source-region-backup-compartment"),
+			VolumeId:             common.String("source-region-volume"),
+			SourceVolumeBackupId: common.String("ocid1.volumebackup.oc1.phx.source-region-volume-backup-copying"),
+			LifecycleState:       core.VolumeBackupLifecycleStateCreating,
+		},
+		"ocid1.volumebackup.oc1.phx.source-region-volume-backup-faulty": {
+			Id:                   common.String("volume-backup-copy-faulty"),
+			CompartmentId:        common.String("source-region-backup-compartment"),
+			VolumeId:             common.String("source-region-volume"),
+			SourceVolumeBackupId: common.String("ocid1.volumebackup.oc1.phx.source-region-volume-backup-faulty"),
+			LifecycleState:       core.VolumeBackupLifecycleStateFaulty,
+		},
+	}
+	volume_backup_policies = map[string]core.VolumeBackupPolicy{
+		"ocid1.volumebackuppolicy.oc1..gold": {
+			Id:          common.String("ocid1.volumebackuppolicy.oc1..gold"),
+			DisplayName: common.String("gold"),
+		},
+		"ocid1.volumebackuppolicy.oc1..weekly-1": {
+			Id:          common.String("ocid1.volumebackuppolicy.oc1..weekly-1"),
+			DisplayName: common.String("weekly"),
+		},
+		"ocid1.volumebackuppolicy.oc1..weekly-2": {
+			Id:          common.String("ocid1.volumebackuppolicy.oc1..weekly-2"),
+			DisplayName: common.String("weekly"),
+		},
+	}
+	// backup policy assignments keyed by the assigned volume
+	volume_backup_policy_assignments = map[string][]core.VolumeBackupPolicyAssignment{
+		"volume-with-backup-policy": {
+			{
+				Id:       common.String("backup-policy-assignment"),
+				AssetId:  common.String("volume-with-backup-policy"),
+				PolicyId: common.String("ocid1.volumebackuppolicy.oc1..gold"),
+			},
+		},
+		"volume-with-locked-backup-policy": {
+			{
+				Id:       common.String("backup-policy-assignment-locked"),
+				AssetId:  common.String("volume-with-locked-backup-policy"),
+				PolicyId: common.String("ocid1.volumebackuppolicy.oc1..gold"),
+			},
+		},
+	}
+	group_member_backups = map[string]*core.VolumeBackup{
+		"group-member-backup-1": {
+			Id:             common.String("group-member-backup-1"),
+			VolumeId:       common.String("volume-in-available-state"),
+			LifecycleState: core.VolumeBackupLifecycleStateAvailable,
+			SizeInMBs:      common.Int64(51200),
+		},
+		"group-member-backup-2": {
+			Id:             common.String("group-member-backup-2"),
+			VolumeId:       common.String("volume-with-detaching-attachment"),
+			LifecycleState: core.VolumeBackupLifecycleStateAvailable,
+			SizeInMBs:      common.Int64(51200),
+		},
+		"group-member-backup-creating": {
+			Id:             common.String("group-member-backup-creating"),
+			VolumeId:       common.String("volume-in-available-state"),
+			LifecycleState: core.VolumeBackupLifecycleStateCreating,
+			SizeInMBs:      common.Int64(51200),
+		},
+	}
+	volume_group_backups = map[string]*core.VolumeGroupBackup{
+		"group-backup-new": {
+			Id:              common.String("group-backup-new"),
+			DisplayName:     common.String("group-snapshot-new"),
+			LifecycleState:  core.VolumeGroupBackupLifecycleStateAvailable,
+			VolumeBackupIds: []string{"group-member-backup-1", "group-member-backup-2"},
+			VolumeGroupId:   common.String("volume-group-group-snapshot-new"),
+		},
+		"group-backup-available": {
+			Id:              common.String("group-backup-available"),
+			DisplayName:     common.String("group-snapshot-available"),
+			LifecycleState:  core.VolumeGroupBackupLifecycleStateAvailable,
+			VolumeBackupIds: []string{"group-member-backup-1", "group-member-backup-2"},
+			VolumeGroupId:   common.String("volume-group-already-deleted"),
+		},
+		"group-backup-creating": {
+			Id:              common.String("group-backup-creating"),
+			DisplayName:     common.String("group-snapshot-creating"),
+			LifecycleState:  core.VolumeGroupBackupLifecycleStateCreating,
+			VolumeBackupIds: []string{"group-member-backup-creating"},
+		},
+		"group-backup-partially-created": {
+			Id:              common.String("group-backup-partially-created"),
+			DisplayName:     common.String("group-snapshot-partially-created"),
+			LifecycleState:  core.VolumeGroupBackupLifecycleStateCreating,
+			VolumeBackupIds: []string{"group-member-backup-creating"},
+		},
+		"group-backup-delete-fails": {
+			Id:              common.String("group-backup-delete-fails"),
+			DisplayName:     common.String("group-snapshot-delete-fails"),
+			LifecycleState:  core.VolumeGroupBackupLifecycleStateAvailable,
+			VolumeBackupIds: []string{"group-member-backup-1"},
+		},
+	}
 	volume_attachments = map[string]*core.IScsiVolumeAttachment{
 		"volume-attachment-stuck-in-detaching-state": {
 			DisplayName:        common.String("volume-attachment-stuck-in-detaching-state"),
@@ -155,6 +316,29 @@ var (
 			Id:                 common.String("volume-attachment-stuck-in-attaching-state"),
 			InstanceId:         common.String("sample-provider-id"),
 		},
+		"volume-with-detaching-attachment": {
+			DisplayName:        common.String("volume-with-detaching-attachment"),
+			LifecycleState:     core.VolumeAttachmentLifecycleStateDetaching,
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("volume-with-detaching-attachment"),
+			InstanceId:         common.String("sample-instance-id"),
+		},
+	}
+	shareable_volume_attachments = map[string][]core.VolumeAttachment{
+		"shareable-volume-with-detaching-attachment": {
+			core.IScsiVolumeAttachment{
+				Id:             common.String("shareable-volume-attachment-detaching"),
+				LifecycleState: core.VolumeAttachmentLifecycleStateDetaching,
+				InstanceId:     common.String("sample-instance-id"),
+				IsShareable:    common.Bool(true),
+			},
+			core.IScsiVolumeAttachment{
+				Id:             common.String("shareable-volume-attachment-attached"),
+				LifecycleState: core.VolumeAttachmentLifecycleStateAttached,
+				InstanceId:     common.String("sample-provider-id"),
+				IsShareable:    common.Bool(true),
+			},
+		},
 	}
 )
 
@@ -226,7 +410,12 @@ func (c *MockBlockStorageClient) AwaitVolumeBackupAvailableOrTimeout(ctx context
 func (c *MockBlockStorageClient) CreateVolumeBackup(ctx context.Context, details core.CreateVolumeBackupDetails) (*core.VolumeBackup, error) {
 	id := "oc1.volumebackup1.xxxx"
 	return &core.VolumeBackup{
-		Id: &id,
+		Id:             &id,
+		CompartmentId:  common.String("backup-compartment"),
+		VolumeId:       details.VolumeId,
+		SizeInMBs:      common.Int64(51200),
+		TimeCreated:    &common.SDKTime{Time: backupCreationTime},
+		LifecycleState: core.VolumeBackupLifecycleStateAvailable,
 	}, nil
 }
 
@@ -235,15 +424,211 @@ func (c *MockBlockStorageClient) DeleteVolumeBackup(ctx context.Context, id stri
 }
 
 func (c *MockBlockStorageClient) GetVolumeBackup(ctx context.Context, id string) (*core.VolumeBackup, error) {
+	if _, ok := volume_backup_copies[id]; ok {
+		return nil, errors.WithStack(mockNotFoundError{})
+	}
+	for _, backup := range volume_backups {
+		if *backup.Id == id {
+			return &backup, nil
+		}
+	}
+	if backup, ok := group_member_backups[id]; ok {
+		return backup, nil
+	}
 	return &core.VolumeBackup{
 		Id: &id,
 	}, nil
 }
 
+func (c *MockBlockStorageClient) GetVolumeBackupInRegion(ctx context.Context, id, region string) (*core.VolumeBackup, error) {
+	if region == "" {
+		return c.GetVolumeBackup(ctx, id)
+	}
+	if backupCopy, ok := volume_backup_copies[id]; ok {
+		return &core.VolumeBackup{
+			Id:             &id,
+			CompartmentId:  backupCopy.CompartmentId,
+			VolumeId:       backupCopy.VolumeId,
+			LifecycleState: core.VolumeBackupLifecycleStateAvailable,
+		}, nil
+	}
+	return nil, errors.WithStack(mockNotFoundError{})
+}
+
 func (c *MockBlockStorageClient) GetVolumeBackupsByName(ctx context.Context, snapshotName, compartmentID string) ([]core.VolumeBackup, error) {
 	return []core.VolumeBackup{}, nil
 }
 
+func (c *MockBlockStorageClient) ListVolumeBackups(ctx context.Context, compartmentID, volumeID string, limit int, page string) ([]core.VolumeBackup, string, error) {
+	var backups []core.VolumeBackup
+	for _, backup := range volume_backups {
+		if volumeID == "" || *backup.VolumeId == volumeID {
+			backups = append(backups, backup)
+		}
+	}
+	start := 0
+	if page != "" {
+		var err error
+		if start, err = strconv.Atoi(page); err != nil {
+			return nil, "", errors.New("invalid page")
+		}
+	}
+	if start > len(backups) {
+		start = len(backups)
+	}
+	end := len(backups)
+	if limit > 0 && start+limit < end {
+		end = start + limit
+	}
+	nextPage := ""
+	if end < len(backups) {
+		nextPage = strconv.Itoa(end)
+	}
+	return backups[start:end], nextPage, nil
+}
+
+func (c *MockBlockStorageClient) CopyVolumeBackup(ctx context.Context, id, destinationRegion, displayName string) (*core.VolumeBackup, error) {
+	return &core.VolumeBackup{
+		Id:                   common.String("volume-backup-copy"),
+		SourceVolumeBackupId: &id,
+		LifecycleState:       core.VolumeBackupLifecycleStateCreating,
+	}, nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeBackupCopy(ctx context.Context, sourceVolumeBackup *core.VolumeBackup, region string) (*core.VolumeBackup, error) {
+	// copies are only found in the compartment of the backup they were copied from
+	if backupCopy, ok := volume_backup_copies[*sourceVolumeBackup.Id]; ok && *backupCopy.CompartmentId == *sourceVolumeBackup.CompartmentId {
+		return &backupCopy, nil
+	}
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeBackupPolicy(ctx context.Context, id string) (*core.VolumeBackupPolicy, error) {
+	if policy, ok := volume_backup_policies[id]; ok {
+		return &policy, nil
+	}
+	return nil, errors.WithStack(mockNotFoundError{})
+}
+
+func (c *MockBlockStorageClient) GetVolumeBackupPoliciesByName(ctx context.Context, policyName, compartmentID string) ([]core.VolumeBackupPolicy, error) {
+	policies := make([]core.VolumeBackupPolicy, 0)
+	for _, policy := range volume_backup_policies {
+		if *policy.DisplayName == policyName {
+			policies = append(policies, policy)
+		}
+	}
+	return policies, nil
+}
+
+func (c *MockBlockStorageClient) CreateVolumeBackupPolicyAssignment(ctx context.Context, volumeID, policyID string) (*core.VolumeBackupPolicyAssignment, error) {
+	return &core.VolumeBackupPolicyAssignment{
+		Id:       common.String("backup-policy-assignment"),
+		AssetId:  &volumeID,
+		PolicyId: &policyID,
+	}, nil
+}
+
+func (c *MockBlockStorageClient) DeleteVolumeBackupPolicyAssignment(ctx context.Context, id string) error {
+	if id == "backup-policy-assignment-locked" {
+		return fmt.Errorf("backup policy assignment %s is locked", id)
+	}
+	return nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeBackupPolicyAssignments(ctx context.Context, volumeID string) ([]core.VolumeBackupPolicyAssignment, error) {
+	return volume_backup_policy_assignments[volumeID], nil
+}
+
+func (c *MockBlockStorageClient) AwaitVolumeGroupAvailableOrTimeout(ctx context.Context, id string) (*core.VolumeGroup, error) {
+	return &core.VolumeGroup{
+		Id:             &id,
+		LifecycleState: core.VolumeGroupLifecycleStateAvailable,
+	}, nil
+}
+
+func (c *MockBlockStorageClient) CreateVolumeGroup(ctx context.Context, details core.CreateVolumeGroupDetails) (*core.VolumeGroup, error) {
+	if *details.DisplayName == "group-snapshot-in-other-volume-group" {
+		return nil, errors.WithStack(mockVolumeInVolumeGroupError{})
+	}
+	id := "volume-group-" + *details.DisplayName
+	return &core.VolumeGroup{
+		Id:                 &id,
+		DisplayName:        details.DisplayName,
+		CompartmentId:      details.CompartmentId,
+		AvailabilityDomain: details.AvailabilityDomain,
+		LifecycleState:     core.VolumeGroupLifecycleStateProvisioning,
+	}, nil
+}
+
+func (c *MockBlockStorageClient) DeleteVolumeGroup(ctx context.Context, id string) error {
+	return nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeGroup(ctx context.Context, id string) (*core.VolumeGroup, error) {
+	if id == "volume-group-already-deleted" {
+		return nil, errors.WithStack(mockNotFoundError{})
+	}
+	name := strings.TrimPrefix(id, "volume-group-")
+	return &core.VolumeGroup{
+		Id:             &id,
+		DisplayName:    &name,
+		LifecycleState: core.VolumeGroupLifecycleStateAvailable,
+	}, nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeGroupsByName(ctx context.Context, volumeGroupName, compartmentID string) ([]core.VolumeGroup, error) {
+	if volumeGroupName == "group-snapshot-with-other-volume-group" {
+		return []core.VolumeGroup{{
+			Id:             common.String("volume-group-group-snapshot-with-other-volume-group"),
+			DisplayName:    &volumeGroupName,
+			LifecycleState: core.VolumeGroupLifecycleStateAvailable,
+			VolumeIds:      []string{"volume-in-provisioning-state"},
+		}}, nil
+	}
+	return []core.VolumeGroup{}, nil
+}
+
+func (c *MockBlockStorageClient) AwaitVolumeGroupBackupAvailableOrTimeout(ctx context.Context, id string) (*core.VolumeGroupBackup, error) {
+	return c.GetVolumeGroupBackup(ctx, id)
+}
+
+func (c *MockBlockStorageClient) CreateVolumeGroupBackup(ctx context.Context, details core.CreateVolumeGroupBackupDetails) (*core.VolumeGroupBackup, error) {
+	if *details.DisplayName == "group-snapshot-create-fails" {
+		return nil, errors.New("internal error")
+	}
+	return &core.VolumeGroupBackup{
+		Id:             common.String("group-backup-new"),
+		DisplayName:    details.DisplayName,
+		VolumeGroupId:  details.VolumeGroupId,
+		LifecycleState: core.VolumeGroupBackupLifecycleStateRequestReceived,
+	}, nil
+}
+
+func (c *MockBlockStorageClient) DeleteVolumeGroupBackup(ctx context.Context, id string) error {
+	if id == "group-backup-delete-fails" {
+		return errors.New("internal error")
+	}
+	return nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeGroupBackup(ctx context.Context, id string) (*core.VolumeGroupBackup, error) {
+	if groupBackup, ok := volume_group_backups[id]; ok {
+		return groupBackup, nil
+	}
+	return nil, errors.WithStack(mockNotFoundError{})
+}
+
+func (c *MockBlockStorageClient) GetVolumeGroupBackupsByName(ctx context.Context, groupSnapshotName, compartmentID string) ([]core.VolumeGroupBackup, error) {
+	var groupBackups []core.VolumeGroupBackup
+	for _, groupBackup := range volume_group_backups {
+		// a newly created group backup is only found by name on retries
+		if *groupBackup.Id != "group-backup-new" && *groupBackup.DisplayName == groupSnapshotName {
+			groupBackups = append(groupBackups, *groupBackup)
+		}
+	}
+	return groupBackups, nil
+}
+
 type MockProvisionerClient struct {
 	Storage *MockBlockStorageClient
 }
@@ -450,6 +835,10 @@ func (c *MockVirtualNetworkClient) IsRegionalSubnet(ctx context.Context, id stri
 	return true, nil
 }
 
+func (c *MockVirtualNetworkClient) ListIpv6s(ctx context.Context, vnicId string) ([]core.Ipv6, error) {
+	return nil, nil
+}
+
 func (c *MockVirtualNetworkClient) GetPublicIpByIpAddress(ctx context.Context, id string) (*core.PublicIp, error) {
 	return nil, nil
 }
@@ -489,6 +878,46 @@ func (c *MockLoadBalancerClient) CreateCertificate(ctx context.Context, lbID str
 	return "", nil
 }
 
+func (c *MockLoadBalancerClient) DeleteCertificate(ctx context.Context, lbID string, name string) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) CreateSSLCipherSuite(ctx context.Context, lbID string, suite *client.GenericSslCipherSuite) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) UpdateSSLCipherSuite(ctx context.Context, lbID string, suite *client.GenericSslCipherSuite) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) DeleteSSLCipherSuite(ctx context.Context, lbID string, name string) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) CreateRuleSet(ctx context.Context, lbID string, ruleSet *client.GenericRuleSet) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) UpdateRuleSet(ctx context.Context, lbID string, ruleSet *client.GenericRuleSet) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) DeleteRuleSet(ctx context.Context, lbID string, name string) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) CreateRoutingPolicy(ctx context.Context, lbID string, policy *client.GenericRoutingPolicy) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) UpdateRoutingPolicy(ctx context.Context, lbID string, policy *client.GenericRoutingPolicy) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) DeleteRoutingPolicy(ctx context.Context, lbID string, name string) (string, error) {
+	return "", nil
+}
+
 func (c *MockLoadBalancerClient) CreateBackendSet(ctx context.Context, lbID string, name string, details *client.GenericBackendSetDetails) (string, error) {
 	return "", nil
 }
@@ -604,8 +1033,8 @@ func (c *MockComputeClient) FindVolumeAttachment(ctx context.Context, compartmen
 	return nil, nil
 }
 
-func (c *MockComputeClient) FindActiveVolumeAttachment(ctx context.Context, compartmentID, volumeID string) (core.VolumeAttachment, error) {
-	if volumeID == "find-active-volume-attachment-timeout-volume" {
+func (c *MockComputeClient) FindActiveVolumeAttachment(ctx context.Context, compartmentID, volumeID, instanceID string) (core.VolumeAttachment, error) {
+	if volumeID == "find-active-volume-attachment-timeout-volume" || volumeID == "find-volume-attachment-timeout" {
 		var page *string
 		var requestMetadata common.RequestMetadata
 		for {
@@ -640,11 +1069,21 @@ func (c *MockComputeClient) FindActiveVolumeAttachment(ctx context.Context, comp
 	return nil, nil
 }
 
-func (c *MockComputeClient) AttachParavirtualizedVolume(ctx context.Context, instanceID, volumeID string, isPvEncryptionInTransitEnabled bool) (core.VolumeAttachment, error) {
+func (c *MockComputeClient) ListVolumeAttachments(ctx context.Context, compartmentID, volumeID string) ([]core.VolumeAttachment, error) {
+	if attachments, ok := shareable_volume_attachments[volumeID]; ok {
+		return attachments, nil
+	}
+	if volume_attachments[volumeID] != nil {
+		return []core.VolumeAttachment{volume_attachments[volumeID]}, nil
+	}
+	return nil, nil
+}
+
+func (c *MockComputeClient) AttachParavirtualizedVolume(ctx context.Context, instanceID, volumeID string, isPvEncryptionInTransitEnabled, isReadOnly, isShareable bool) (core.VolumeAttachment, error) {
 	return nil, nil
 }
 
-func (c *MockComputeClient) AttachVolume(ctx context.Context, instanceID, volumeID string) (core.VolumeAttachment, error) {
+func (c *MockComputeClient) AttachVolume(ctx context.Context, instanceID, volumeID string, isReadOnly, isShareable bool) (core.VolumeAttachment, error) {
 	return nil, nil
 }
 
@@ -761,7 +1200,7 @@ func TestControllerDriver_CreateVolume(t *testing.T) {
 			wantErr: errors.New("CreateVolume Name must be provided"),
 		},
 		{
-			name:   "Error for unsupported VolumeCapabilities: MULTI_NODE_MULTI_WRITER provided in CreateVolumeRequest",
+			name:   "Error for VolumeCapabilities: MULTI_NODE_MULTI_WRITER without raw block access provided in CreateVolumeRequest",
 			fields: fields{},
 			args: args{
 				ctx: nil,
@@ -775,7 +1214,7 @@ func TestControllerDriver_CreateVolume(t *testing.T) {
 				},
 			},
 			want:    nil,
-			wantErr: errors.New("invalid volume capabilities requested. Only SINGLE_NODE_WRITER is supported ('accessModes.ReadWriteOnce' on Kubernetes)"),
+			wantErr: errors.New("invalid volume capabilities requested. MULTI_NODE_MULTI_WRITER is only supported for raw block volumes ('volumeMode: Block' on Kubernetes)"),
 		},
 		{
 			name:   "Error for no VolumeCapabilities provided in CreateVolumeRequest",
@@ -791,7 +1230,7 @@ func TestControllerDriver_CreateVolume(t *testing.T) {
 			wantErr: errors.New("VolumeCapabilities must be provided in CreateVolumeRequest"),
 		},
 		{
-			name:   "Error for unsupported VolumeCapabilities: MULTI_NODE_READER_ONLY provided in CreateVolumeRequest",
+			name:   "VolumeCapabilities: MULTI_NODE_READER_ONLY provided in CreateVolumeRequest",
 			fields: fields{},
 			args: args{
 				ctx: nil,
@@ -805,7 +1244,7 @@ func TestControllerDriver_CreateVolume(t *testing.T) {
 				},
 			},
 			want:    nil,
-			wantErr: errors.New("invalid volume capabilities requested. Only SINGLE_NODE_WRITER is supported ('accessModes.ReadWriteOnce' on Kubernetes)"),
+			wantErr: errors.New("required in PreferredTopologies or allowedTopologies"),
 		},
 		{
 			name:   "Error for unsupported VolumeCapabilities: MULTI_NODE_SINGLE_WRITER provided in CreateVolumeRequest",
@@ -822,7 +1261,7 @@ func TestControllerDriver_CreateVolume(t *testing.T) {
 				},
 			},
 			want:    nil,
-			wantErr: errors.New("invalid volume capabilities requested. Only SINGLE_NODE_WRITER is supported ('accessModes.ReadWriteOnce' on Kubernetes)"),
+			wantErr: errors.New("invalid volume capabilities requested. Only SINGLE_NODE_WRITER, MULTI_NODE_READER_ONLY and MULTI_NODE_MULTI_WRITER are supported ('accessModes.ReadWriteOnce', 'accessModes.ReadOnlyMany' and 'accessModes.ReadWriteMany' on Kubernetes)"),
 		},
 		{
 			name:   "Error for exceeding capacity range",
@@ -873,7 +1312,47 @@ func TestControllerDriver_CreateVolume(t *testing.T) {
 			wantErr: errors.New("required in PreferredTopologies or allowedTopologies"),
 		},
 		{
-			name:   "Error for unsupported volumeMode Block",
+			name:   "Error for volumeMode Block with unsupported access mode",
+			fields: fields{},
+			args: args{
+				ctx: nil,
+				req: &csi.CreateVolumeRequest{
+					Name: "ut-volume",
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessType: &csi.VolumeCapability_Block{
+							Block: &csi.VolumeCapability_BlockVolume{},
+						},
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_READER_ONLY,
+						},
+					}},
+				},
+			},
+			want:    nil,
+			wantErr: errors.New("invalid volume capabilities requested. Only SINGLE_NODE_WRITER, MULTI_NODE_READER_ONLY and MULTI_NODE_MULTI_WRITER are supported ('accessModes.ReadWriteOnce', 'accessModes.ReadOnlyMany' and 'accessModes.ReadWriteMany' on Kubernetes)"),
+		},
+		{
+			name:   "volumeMode Block with MULTI_NODE_MULTI_WRITER access mode",
+			fields: fields{},
+			args: args{
+				ctx: nil,
+				req: &csi.CreateVolumeRequest{
+					Name: "ut-volume",
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessType: &csi.VolumeCapability_Block{
+							Block: &csi.VolumeCapability_BlockVolume{},
+						},
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER,
+						},
+					}},
+				},
+			},
+			want:    nil,
+			wantErr: errors.New("required in PreferredTopologies or allowedTopologies"),
+		},
+		{
+			name:   "Error for volumeMode Block without topology requirements",
 			fields: fields{},
 			args: args{
 				ctx: nil,
@@ -883,11 +1362,14 @@ func TestControllerDriver_CreateVolume(t *testing.T) {
 						AccessType: &csi.VolumeCapability_Block{
 							Block: &csi.VolumeCapability_BlockVolume{},
 						},
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER,
+						},
 					}},
 				},
 			},
 			want:    nil,
-			wantErr: errors.New("driver does not support Block volumeMode. Please use Filesystem mode"),
+			wantErr: errors.New("required in PreferredTopologies or allowedTopologies"),
 		},
 		{
 			name:   "Create Volume times out waiting for volume to become available",
@@ -924,54 +1406,143 @@ func TestControllerDriver_CreateVolume(t *testing.T) {
 			wantErr: errors.New("failed to check existence of volume context deadline exceeded"),
 		},
 		{
-			name:   "Create Volume times out waiting for cloned volume to become available",
+			name:   "Error for restoring from a backup still being copied from another region",
 			fields: fields{},
 			args: args{
-				req: create_volume_requests["clone-volume-stuck-in-provisioning-state"],
+				req: &csi.CreateVolumeRequest{
+					Name: "ut-volume",
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER,
+						},
+					}},
+					VolumeContentSource: &csi.VolumeContentSource{
+						Type: &csi.VolumeContentSource_Snapshot{
+							Snapshot: &csi.VolumeContentSource_SnapshotSource{SnapshotId: "ocid1.volumebackup.oc1.phx.source-region-volume-backup-copying"},
+						},
+					},
+				},
 			},
 			want:    nil,
-			wantErr: errors.New("Create volume failed with time out timed out waiting for the condition"),
+			wantErr: errors.New("backup volume-backup-copy-creating is still being created or copied"),
 		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
-			defer cancel()
-			d := &BlockVolumeControllerDriver{ControllerDriver{
-				KubeClient: nil,
-				logger:     zap.S(),
-				config:     &providercfg.Config{CompartmentID: ""},
-				client:     NewClientProvisioner(nil, &MockBlockStorageClient{}, nil),
-				util:       &csi_util.Util{Logger: logging.Logger().Sugar()},
-			}}
-			got, err := d.CreateVolume(ctx, tt.args.req)
-			if tt.wantErr == nil && err != nil {
-				t.Errorf("got error %q, want none", err)
-			}
-			if tt.wantErr != nil && err == nil {
-				t.Errorf("want error %q, got none", tt.wantErr)
-			} else if tt.wantErr != nil && !strings.Contains(err.Error(), tt.wantErr.Error()) {
-				t.Errorf("want error %q to include %q", err, tt.wantErr)
-			}
-			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("ControllerDriver.CreateVolume() = %v, want %v", got, tt.want)
-			}
-		})
-	}
-}
-
-func TestControllerDriver_DeleteVolume(t *testing.T) {
-	type fields struct {
-		KubeClient kubernetes.Interface
-		logger     *zap.SugaredLogger
-		config     *providercfg.Config
-		client     client.Interface
-		util       *csi_util.Util
-	}
-	type args struct {
-		ctx context.Context
-		req *csi.DeleteVolumeRequest
-	}
+		{
+			name:   "Error for volume backup policy name that does not exist",
+			fields: fields{},
+			args: args{
+				req: &csi.CreateVolumeRequest{
+					Name: "ut-volume",
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER,
+						},
+					}},
+					Parameters: map[string]string{backupPolicy: "platinum"},
+				},
+			},
+			want:    nil,
+			wantErr: errors.New("volume backup policy platinum provided for storageclass not found"),
+		},
+		{
+			name:   "Error for volume backup policy OCID that does not exist",
+			fields: fields{},
+			args: args{
+				req: &csi.CreateVolumeRequest{
+					Name: "ut-volume",
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER,
+						},
+					}},
+					Parameters: map[string]string{backupPolicy: "ocid1.volumebackuppolicy.oc1..deleted"},
+				},
+			},
+			want:    nil,
+			wantErr: errors.New("volume backup policy ocid1.volumebackuppolicy.oc1..deleted provided for storageclass not found"),
+		},
+		{
+			name:   "Error for volume backup policy name shared by several policies",
+			fields: fields{},
+			args: args{
+				req: &csi.CreateVolumeRequest{
+					Name: "ut-volume",
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER,
+						},
+					}},
+					Parameters: map[string]string{backupPolicy: "weekly"},
+				},
+			},
+			want:    nil,
+			wantErr: errors.New("found 2 volume backup policies named weekly"),
+		},
+		{
+			name:   "Existing volume backup policy passes validation",
+			fields: fields{},
+			args: args{
+				req: &csi.CreateVolumeRequest{
+					Name: "ut-volume",
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER,
+						},
+					}},
+					Parameters: map[string]string{backupPolicy: "gold"},
+				},
+			},
+			want:    nil,
+			wantErr: errors.New("required in PreferredTopologies or allowedTopologies"),
+		},
+		{
+			name:   "Create Volume times out waiting for cloned volume to become available",
+			fields: fields{},
+			args: args{
+				req: create_volume_requests["clone-volume-stuck-in-provisioning-state"],
+			},
+			want:    nil,
+			wantErr: errors.New("Create volume failed with time out timed out waiting for the condition"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+			defer cancel()
+			d := &BlockVolumeControllerDriver{ControllerDriver{
+				KubeClient: nil,
+				logger:     zap.S(),
+				config:     &providercfg.Config{CompartmentID: ""},
+				client:     NewClientProvisioner(nil, &MockBlockStorageClient{}, nil),
+				util:       &csi_util.Util{Logger: logging.Logger().Sugar()},
+			}}
+			got, err := d.CreateVolume(ctx, tt.args.req)
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("got error %q, want none", err)
+			}
+			if tt.wantErr != nil && err == nil {
+				t.Errorf("want error %q, got none", tt.wantErr)
+			} else if tt.wantErr != nil && !strings.Contains(err.Error(), tt.wantErr.Error()) {
+				t.Errorf("want error %q to include %q", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ControllerDriver.CreateVolume() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestControllerDriver_DeleteVolume(t *testing.T) {
+	type fields struct {
+		KubeClient kubernetes.Interface
+		logger     *zap.SugaredLogger
+		config     *providercfg.Config
+		client     client.Interface
+		util       *csi_util.Util
+	}
+	type args struct {
+		ctx context.Context
+		req *csi.DeleteVolumeRequest
+	}
 	tests := []struct {
 		name    string
 		fields  fields
@@ -999,6 +1570,26 @@ func TestControllerDriver_DeleteVolume(t *testing.T) {
 			want:    &csi.DeleteVolumeResponse{},
 			wantErr: nil,
 		},
+		{
+			name:   "Delete volume with a backup policy assigned",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.DeleteVolumeRequest{VolumeId: "volume-with-backup-policy"},
+			},
+			want:    &csi.DeleteVolumeResponse{},
+			wantErr: nil,
+		},
+		{
+			name:   "Error for backup policy assignment that cannot be removed",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.DeleteVolumeRequest{VolumeId: "volume-with-locked-backup-policy"},
+			},
+			want:    nil,
+			wantErr: errors.New("failed to remove backup policy assignment of volume"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1125,7 +1716,7 @@ func TestControllerDriver_ControllerUnpublishVolume(t *testing.T) {
 			wantErr: errors.New("timed out waiting for volume to be detached"),
 		},
 		{
-			name: "FindVolumeAttachment times out",
+			name: "FindActiveVolumeAttachment times out",
 			args: args{
 				req: &csi.ControllerUnpublishVolumeRequest{
 					VolumeId: "find-volume-attachment-timeout",
@@ -1305,6 +1896,177 @@ func TestControllerDriver_ControllerExpandVolume(t *testing.T) {
 	}
 }
 
+func TestControllerDriver_ControllerModifyVolume(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *csi.ControllerModifyVolumeRequest
+		want    *csi.ControllerModifyVolumeResponse
+		wantErr error
+	}{
+		{
+			name:    "Error for volume OCID missing in controller modify volume",
+			req:     &csi.ControllerModifyVolumeRequest{},
+			want:    nil,
+			wantErr: errors.New("ModifyVolume volumeId must be provided"),
+		},
+		{
+			name: "Error for unsupported mutable parameter",
+			req: &csi.ControllerModifyVolumeRequest{
+				VolumeId:          "valid_volume_id",
+				MutableParameters: map[string]string{"attachment-type": "iscsi"},
+			},
+			want:    nil,
+			wantErr: errors.New("unsupported mutable parameter"),
+		},
+		{
+			name: "Error for invalid vpusPerGB",
+			req: &csi.ControllerModifyVolumeRequest{
+				VolumeId:          "valid_volume_id",
+				MutableParameters: map[string]string{csi_util.VpusPerGB: "130"},
+			},
+			want:    nil,
+			wantErr: errors.New("invalid performance option"),
+		},
+		{
+			name: "Error for volume that cannot be found",
+			req: &csi.ControllerModifyVolumeRequest{
+				VolumeId:          "invalid_volume_id",
+				MutableParameters: map[string]string{csi_util.VpusPerGB: "20"},
+			},
+			want:    nil,
+			wantErr: errors.New("failed to check existence of volume"),
+		},
+		{
+			name: "No update when volume already has the requested vpusPerGB",
+			req: &csi.ControllerModifyVolumeRequest{
+				VolumeId:          "valid_volume_id_valid_old_size_fail",
+				MutableParameters: map[string]string{csi_util.VpusPerGB: "10"},
+			},
+			want:    &csi.ControllerModifyVolumeResponse{},
+			wantErr: nil,
+		},
+		{
+			name: "Error when update volume fails",
+			req: &csi.ControllerModifyVolumeRequest{
+				VolumeId:          "valid_volume_id_valid_old_size_fail",
+				MutableParameters: map[string]string{csi_util.VpusPerGB: "20"},
+			},
+			want:    nil,
+			wantErr: errors.New("Update volume failed"),
+		},
+		{
+			name: "Modify vpusPerGB and auto-tune successfully",
+			req: &csi.ControllerModifyVolumeRequest{
+				VolumeId:          "valid_volume_id",
+				MutableParameters: map[string]string{csi_util.VpusPerGB: "20", autoTuneMaxVpusPerGB: "30"},
+			},
+			want:    &csi.ControllerModifyVolumeResponse{},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &BlockVolumeControllerDriver{ControllerDriver{
+				KubeClient: nil,
+				logger:     zap.S(),
+				config:     &providercfg.Config{CompartmentID: ""},
+				client:     NewClientProvisioner(nil, &MockBlockStorageClient{}, nil),
+				util:       &csi_util.Util{},
+			}}
+			got, err := d.ControllerModifyVolume(context.Background(), tt.req)
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("got error %q, want none", err)
+			}
+			if tt.wantErr != nil && err == nil {
+				t.Errorf("want error %q, got none", tt.wantErr)
+			} else if tt.wantErr != nil && !strings.Contains(err.Error(), tt.wantErr.Error()) {
+				t.Errorf("want error %q to include %q", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ControllerDriver.ControllerModifyVolume() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractModifyVolumeParameters(t *testing.T) {
+	tests := map[string]struct {
+		mutableParameters map[string]string
+		wantVpusPerGB     *int64
+		wantAutoTune      *int64
+		wantErr           bool
+	}{
+		"No mutable parameters": {
+			mutableParameters: map[string]string{},
+		},
+		"vpusPerGB only": {
+			mutableParameters: map[string]string{csi_util.VpusPerGB: "20"},
+			wantVpusPerGB:     common.Int64(20),
+		},
+		"Disable auto-tune": {
+			mutableParameters: map[string]string{autoTuneMaxVpusPerGB: "0"},
+			wantAutoTune:      common.Int64(0),
+		},
+		"Auto-tune above vpusPerGB": {
+			mutableParameters: map[string]string{csi_util.VpusPerGB: "10", autoTuneMaxVpusPerGB: "20"},
+			wantVpusPerGB:     common.Int64(10),
+			wantAutoTune:      common.Int64(20),
+		},
+		"Auto-tune below vpusPerGB": {
+			mutableParameters: map[string]string{csi_util.VpusPerGB: "20", autoTuneMaxVpusPerGB: "10"},
+			wantErr:           true,
+		},
+		"Invalid auto-tune value": {
+			mutableParameters: map[string]string{autoTuneMaxVpusPerGB: "abc"},
+			wantErr:           true,
+		},
+		"Unsupported parameter": {
+			mutableParameters: map[string]string{kmsKey: "ocid1.key"},
+			wantErr:           true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := extractModifyVolumeParameters(tt.mutableParameters)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("extractModifyVolumeParameters() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got.vpusPerGB, tt.wantVpusPerGB) {
+				t.Errorf("extractModifyVolumeParameters() vpusPerGB = %v, want %v", got.vpusPerGB, tt.wantVpusPerGB)
+			}
+			if !reflect.DeepEqual(got.autoTuneMaxVpusPerGB, tt.wantAutoTune) {
+				t.Errorf("extractModifyVolumeParameters() autoTuneMaxVpusPerGB = %v, want %v", got.autoTuneMaxVpusPerGB, tt.wantAutoTune)
+			}
+		})
+	}
+}
+
+func TestGetAutotunePolicies(t *testing.T) {
+	detached := core.DetachedVolumeAutotunePolicy{}
+	existing := []core.AutotunePolicy{detached, core.PerformanceBasedAutotunePolicy{MaxVpusPerGB: common.Int64(20)}}
+
+	got := getAutotunePolicies(existing, 30)
+	want := []core.AutotunePolicy{detached, core.PerformanceBasedAutotunePolicy{MaxVpusPerGB: common.Int64(30)}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("getAutotunePolicies() = %v, want %v", got, want)
+	}
+	if max := getPerformanceBasedAutotuneMaxVpusPerGB(got); max != 30 {
+		t.Errorf("getPerformanceBasedAutotuneMaxVpusPerGB() = %d, want 30", max)
+	}
+
+	got = getAutotunePolicies(existing, 0)
+	want = []core.AutotunePolicy{detached}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("getAutotunePolicies() = %v, want %v", got, want)
+	}
+	if max := getPerformanceBasedAutotuneMaxVpusPerGB(got); max != 0 {
+		t.Errorf("getPerformanceBasedAutotuneMaxVpusPerGB() = %d, want 0", max)
+	}
+}
+
 func Test_extractStorage(t *testing.T) {
 	type args struct {
 		capRange *csi.CapacityRange
@@ -1577,6 +2339,90 @@ func TestExtractVolumeParameters(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		"With LUKS encryption": {
+			storageParameters: map[string]string{
+				luksEncryption: "true",
+			},
+			volumeParameters: VolumeParameters{
+				diskEncryptionKey:   "",
+				attachmentParameter: make(map[string]string),
+				vpusPerGB:           10,
+				luksEncryption:      true,
+			},
+			wantErr: false,
+		},
+		"With LUKS encryption and vault secret": {
+			storageParameters: map[string]string{
+				luksEncryption:    "true",
+				luksVaultSecretID: "ocid1.vaultsecret.oc1.phx.xyz",
+			},
+			volumeParameters: VolumeParameters{
+				diskEncryptionKey:   "",
+				attachmentParameter: make(map[string]string),
+				vpusPerGB:           10,
+				luksEncryption:      true,
+				luksVaultSecretID:   "ocid1.vaultsecret.oc1.phx.xyz",
+			},
+			wantErr: false,
+		},
+		"Invalid LUKS encryption": {
+			storageParameters: map[string]string{
+				luksEncryption: "yes please",
+			},
+			volumeParameters: VolumeParameters{
+				diskEncryptionKey:   "",
+				attachmentParameter: make(map[string]string),
+				vpusPerGB:           10,
+			},
+			wantErr: true,
+		},
+		"Invalid LUKS vault secret": {
+			storageParameters: map[string]string{
+				luksVaultSecretID: "ocid1.key.oc1.phx.xyz",
+			},
+			volumeParameters: VolumeParameters{
+				diskEncryptionKey:   "",
+				attachmentParameter: make(map[string]string),
+				vpusPerGB:           10,
+			},
+			wantErr: true,
+		},
+		"LUKS vault secret without LUKS encryption": {
+			storageParameters: map[string]string{
+				luksVaultSecretID: "ocid1.vaultsecret.oc1.phx.xyz",
+			},
+			volumeParameters: VolumeParameters{
+				diskEncryptionKey:   "",
+				attachmentParameter: make(map[string]string),
+				vpusPerGB:           10,
+				luksVaultSecretID:   "ocid1.vaultsecret.oc1.phx.xyz",
+			},
+			wantErr: true,
+		},
+		"With backup policy name": {
+			storageParameters: map[string]string{
+				backupPolicy: "gold",
+			},
+			volumeParameters: VolumeParameters{
+				diskEncryptionKey:   "",
+				attachmentParameter: make(map[string]string),
+				vpusPerGB:           10,
+				backupPolicy:        "gold",
+			},
+			wantErr: false,
+		},
+		"With backup policy OCID": {
+			storageParameters: map[string]string{
+				backupPolicy: "ocid1.volumebackuppolicy.oc1..gold",
+			},
+			volumeParameters: VolumeParameters{
+				diskEncryptionKey:   "",
+				attachmentParameter: make(map[string]string),
+				vpusPerGB:           10,
+				backupPolicy:        "ocid1.volumebackuppolicy.oc1..gold",
+			},
+			wantErr: false,
+		},
 	}
 
 	for name, tt := range tests {
@@ -1676,6 +2522,26 @@ func TestExtractSnapshotParameters(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		"With backup copy destination region": {
+			inputParameters: map[string]string{
+				backupCopyDestinationRegion: "us-ashburn-1",
+			},
+			snapshotParameters: SnapshotParameters{
+				backupType:            core.CreateVolumeBackupDetailsTypeIncremental,
+				copyDestinationRegion: "us-ashburn-1",
+			},
+			wantErr: false,
+		},
+		"With backup copy destination region key": {
+			inputParameters: map[string]string{
+				backupCopyDestinationRegion: "iad",
+			},
+			snapshotParameters: SnapshotParameters{
+				backupType:            core.CreateVolumeBackupDetailsTypeIncremental,
+				copyDestinationRegion: "us-ashburn-1",
+			},
+			wantErr: false,
+		},
 	}
 
 	for name, tt := range tests {
@@ -1724,6 +2590,26 @@ func TestCreateSnapshot(t *testing.T) {
 			want:    nil,
 			wantErr: errors.New("Volume snapshot source ID must be provided"),
 		},
+		{
+			name: "Snapshot is not ready to use while it is being copied to another region",
+			args: args{
+				ctx: context.Background(),
+				req: &csi.CreateSnapshotRequest{
+					Name:           "demo",
+					SourceVolumeId: "volume-in-available-state",
+					Parameters:     map[string]string{backupCopyDestinationRegion: "iad"},
+				},
+			},
+			want: &csi.CreateSnapshotResponse{
+				Snapshot: &csi.Snapshot{
+					SnapshotId:     "oc1.volumebackup1.xxxx",
+					SourceVolumeId: "volume-in-available-state",
+					SizeBytes:      51200 * client.MiB,
+					CreationTime:   timestamppb.New(backupCreationTime),
+					ReadyToUse:     false,
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1748,6 +2634,54 @@ func TestCreateSnapshot(t *testing.T) {
 	}
 }
 
+func TestCopyVolumeBackup(t *testing.T) {
+	tests := []struct {
+		name           string
+		backupID       string
+		compartmentID  string
+		wantReadyToUse bool
+	}{
+		{
+			name:           "Copy is available",
+			backupID:       "ocid1.volumebackup.oc1.phx.source-region-volume-backup",
+			compartmentID:  "source-region-backup-compartment",
+			wantReadyToUse: true,
+		},
+		{
+			name:          "Copy is in flight",
+			backupID:      "ocid1.volumebackup.oc1.phx.source-region-volume-backup-copying",
+			compartmentID: "source-region-backup-compartment",
+		},
+		{
+			name:          "Faulty copy is copied again",
+			backupID:      "ocid1.volumebackup.oc1.phx.source-region-volume-backup-faulty",
+			compartmentID: "source-region-backup-compartment",
+		},
+		{
+			name:          "Copy is looked up in the compartment of the backup",
+			backupID:      "ocid1.volumebackup.oc1.phx.source-region-volume-backup",
+			compartmentID: "another-compartment",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &BlockVolumeControllerDriver{ControllerDriver{
+				logger: zap.S(),
+				config: &providercfg.Config{CompartmentID: ""},
+				client: NewClientProvisioner(nil, &MockBlockStorageClient{}, nil),
+			}}
+			backup := &core.VolumeBackup{Id: common.String(tt.backupID), CompartmentId: common.String(tt.compartmentID)}
+			got, err := d.copyVolumeBackup(context.Background(), zap.S(), backup, "demo", "us-ashburn-1")
+			if err != nil {
+				t.Fatalf("copyVolumeBackup() error = %v", err)
+			}
+			if got != tt.wantReadyToUse {
+				t.Errorf("copyVolumeBackup() = %v, want %v", got, tt.wantReadyToUse)
+			}
+		})
+	}
+}
+
 func TestControllerDriver_DeleteSnapshot(t *testing.T) {
 	type args struct {
 		ctx context.Context
@@ -1801,6 +2735,210 @@ func TestControllerDriver_DeleteSnapshot(t *testing.T) {
 	}
 }
 
+func TestControllerDriver_ListSnapshots(t *testing.T) {
+	entry := func(id, volumeID string, readyToUse bool) *csi.ListSnapshotsResponse_Entry {
+		return &csi.ListSnapshotsResponse_Entry{
+			Snapshot: &csi.Snapshot{
+				SnapshotId:     id,
+				SourceVolumeId: volumeID,
+				SizeBytes:      51200 * client.MiB,
+				ReadyToUse:     readyToUse,
+			},
+		}
+	}
+	tests := []struct {
+		name    string
+		req     *csi.ListSnapshotsRequest
+		want    *csi.ListSnapshotsResponse
+		wantErr error
+	}{
+		{
+			name:    "Error for negative max entries",
+			req:     &csi.ListSnapshotsRequest{MaxEntries: -1},
+			want:    nil,
+			wantErr: errors.New("max_entries must not be negative"),
+		},
+		{
+			name: "List snapshot by snapshot ID",
+			req:  &csi.ListSnapshotsRequest{SnapshotId: "volume-backup-available"},
+			want: &csi.ListSnapshotsResponse{
+				Entries: []*csi.ListSnapshotsResponse_Entry{entry("volume-backup-available", "volume-in-available-state", true)},
+			},
+		},
+		{
+			name: "List snapshot by snapshot ID of another source volume",
+			req:  &csi.ListSnapshotsRequest{SnapshotId: "volume-backup-available", SourceVolumeId: "volume-in-provisioning-state"},
+			want: &csi.ListSnapshotsResponse{},
+		},
+		{
+			name: "List terminated snapshot by snapshot ID",
+			req:  &csi.ListSnapshotsRequest{SnapshotId: "volume-backup-terminated"},
+			want: &csi.ListSnapshotsResponse{},
+		},
+		{
+			name: "List snapshot by ID of a backup in another region returns its copy",
+			req:  &csi.ListSnapshotsRequest{SnapshotId: "ocid1.volumebackup.oc1.phx.source-region-volume-backup"},
+			want: &csi.ListSnapshotsResponse{
+				Entries: []*csi.ListSnapshotsResponse_Entry{entry("volume-backup-copy-available", "source-region-volume", true)},
+			},
+		},
+		{
+			name: "List snapshots by source volume ID skips terminated snapshots",
+			req:  &csi.ListSnapshotsRequest{SourceVolumeId: "volume-in-available-state"},
+			want: &csi.ListSnapshotsResponse{
+				Entries: []*csi.ListSnapshotsResponse_Entry{
+					entry("volume-backup-available", "volume-in-available-state", true),
+					entry("volume-backup-creating", "volume-in-available-state", false),
+				},
+			},
+		},
+		{
+			name: "List snapshots with max entries returns next token",
+			req:  &csi.ListSnapshotsRequest{MaxEntries: 2},
+			want: &csi.ListSnapshotsResponse{
+				Entries: []*csi.ListSnapshotsResponse_Entry{
+					entry("volume-backup-available", "volume-in-available-state", true),
+					entry("volume-backup-creating", "volume-in-available-state", false),
+				},
+				NextToken: "2",
+			},
+		},
+		{
+			name: "List snapshots from starting token reports faulty snapshot as not ready",
+			req:  &csi.ListSnapshotsRequest{MaxEntries: 2, StartingToken: "2"},
+			want: &csi.ListSnapshotsResponse{
+				Entries: []*csi.ListSnapshotsResponse_Entry{
+					entry("volume-backup-of-other-volume", "volume-in-provisioning-state", false),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &BlockVolumeControllerDriver{ControllerDriver{
+				KubeClient: nil,
+				logger:     zap.S(),
+				config:     &providercfg.Config{CompartmentID: ""},
+				client:     NewClientProvisioner(nil, &MockBlockStorageClient{}, nil),
+				util:       &csi_util.Util{},
+			}}
+			got, err := d.ListSnapshots(context.Background(), tt.req)
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("got error %q, want none", err)
+			}
+			if tt.wantErr != nil && (err == nil || !strings.Contains(err.Error(), tt.wantErr.Error())) {
+				t.Errorf("want error %q to include %q", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ControllerDriver.ListSnapshots() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestControllerDriver_ControllerGetVolume(t *testing.T) {
+	response := func(volumeId string, capacityBytes int64, abnormal bool, message string) *csi.ControllerGetVolumeResponse {
+		return &csi.ControllerGetVolumeResponse{
+			Volume: &csi.Volume{VolumeId: volumeId, CapacityBytes: capacityBytes},
+			Status: &csi.ControllerGetVolumeResponse_VolumeStatus{
+				VolumeCondition: &csi.VolumeCondition{Abnormal: abnormal, Message: message},
+			},
+		}
+	}
+	tests := []struct {
+		name    string
+		req     *csi.ControllerGetVolumeRequest
+		want    *csi.ControllerGetVolumeResponse
+		wantErr error
+	}{
+		{
+			name:    "Error for empty volume ID",
+			req:     &csi.ControllerGetVolumeRequest{},
+			wantErr: errors.New("Volume ID must be provided"),
+		},
+		{
+			name:    "Error while getting volume",
+			req:     &csi.ControllerGetVolumeRequest{VolumeId: "invalid_volume_id"},
+			wantErr: errors.New("failed to get volume invalid_volume_id"),
+		},
+		{
+			name: "Abnormal condition for deleted volume",
+			req:  &csi.ControllerGetVolumeRequest{VolumeId: "volume-deleted"},
+			want: response("volume-deleted", 0, true, "volume volume-deleted not found, it may have been deleted"),
+		},
+		{
+			name: "Abnormal condition for faulty volume",
+			req:  &csi.ControllerGetVolumeRequest{VolumeId: "volume-in-faulty-state"},
+			want: response("volume-in-faulty-state", 50000*client.MiB, true, "volume is in FAULTY state"),
+		},
+		{
+			name: "Abnormal condition for detaching attachment",
+			req:  &csi.ControllerGetVolumeRequest{VolumeId: "volume-with-detaching-attachment"},
+			want: response("volume-with-detaching-attachment", 50000*client.MiB, true, "volume attachment to instance sample-instance-id is in DETACHING state"),
+		},
+		{
+			name: "Healthy shareable volume with a detaching attachment",
+			req:  &csi.ControllerGetVolumeRequest{VolumeId: "shareable-volume-with-detaching-attachment"},
+			want: response("shareable-volume-with-detaching-attachment", 50000*client.MiB, false, "volume is in AVAILABLE state"),
+		},
+		{
+			name: "Healthy volume",
+			req:  &csi.ControllerGetVolumeRequest{VolumeId: "volume-in-available-state"},
+			want: response("volume-in-available-state", 50000*client.MiB, false, "volume is in AVAILABLE state"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &BlockVolumeControllerDriver{ControllerDriver{
+				KubeClient: nil,
+				logger:     zap.S(),
+				config:     &providercfg.Config{CompartmentID: ""},
+				client:     NewClientProvisioner(nil, &MockBlockStorageClient{}, nil),
+				util:       &csi_util.Util{},
+			}}
+			got, err := d.ControllerGetVolume(context.Background(), tt.req)
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("got error %q, want none", err)
+			}
+			if tt.wantErr != nil && (err == nil || !strings.Contains(err.Error(), tt.wantErr.Error())) {
+				t.Errorf("want error %q to include %q", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ControllerDriver.ControllerGetVolume() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetAttachmentAccess(t *testing.T) {
+	tests := map[string]struct {
+		mode        csi.VolumeCapability_AccessMode_Mode
+		isReadOnly  bool
+		isShareable bool
+	}{
+		"single node writer": {
+			mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER,
+		},
+		"multi node reader only": {
+			mode:        csi.VolumeCapability_AccessMode_MULTI_NODE_READER_ONLY,
+			isReadOnly:  true,
+			isShareable: true,
+		},
+		"multi node multi writer": {
+			mode:        csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER,
+			isShareable: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			isReadOnly, isShareable := getAttachmentAccess(tt.mode)
+			if isReadOnly != tt.isReadOnly || isShareable != tt.isShareable {
+				t.Errorf("getAttachmentAccess() = (%v, %v), want (%v, %v)", isReadOnly, isShareable, tt.isReadOnly, tt.isShareable)
+			}
+		})
+	}
+}
+
 func TestGetAttachmentOptions(t *testing.T) {
 	tests := map[string]struct {
 		attachmentType         string
@@ -0,0 +1,478 @@
+// Copyright 2024 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/oracle/oci-cloud-controller-manager/pkg/metrics"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/util"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+)
+
+// A group snapshot is backed by an OCI volume group backup. OCI can only back up
+// volumes as a group through a volume group, so a volume group with the same
+// display name as the group snapshot is created for the source volumes and removed
+// again once the volume group backup is available. The volume backups of the group
+// are regular block volume backups, so every snapshot of the group can be restored
+// through CreateVolume like any other snapshot.
+
+// GroupControllerGetCapabilities returns the supported capabilities of the group controller service.
+func (d *BlockVolumeControllerDriver) GroupControllerGetCapabilities(ctx context.Context, req *csi.GroupControllerGetCapabilitiesRequest) (*csi.GroupControllerGetCapabilitiesResponse, error) {
+	return &csi.GroupControllerGetCapabilitiesResponse{
+		Capabilities: []*csi.GroupControllerServiceCapability{
+			{
+				Type: &csi.GroupControllerServiceCapability_Rpc{
+					Rpc: &csi.GroupControllerServiceCapability_RPC{
+						Type: csi.GroupControllerServiceCapability_RPC_CREATE_DELETE_GET_VOLUME_GROUP_SNAPSHOT,
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// CreateVolumeGroupSnapshot creates a crash consistent snapshot of a group of volumes.
+// The function is idempotent.
+func (d *BlockVolumeControllerDriver) CreateVolumeGroupSnapshot(ctx context.Context, req *csi.CreateVolumeGroupSnapshotRequest) (*csi.CreateVolumeGroupSnapshotResponse, error) {
+	startTime := time.Now()
+	dimensionsMap := make(map[string]string)
+	dimensionsMap[metrics.ResourceOCIDDimension] = req.Name
+	log := d.logger.With("groupSnapshotName", req.Name, "sourceVolumeIds", req.SourceVolumeIds, "csiOperation", "createGroupSnapshot")
+
+	if req.Name == "" {
+		log.Error("Volume group snapshot name must be provided.")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Error(codes.InvalidArgument, "Volume group snapshot name must be provided")
+	}
+
+	if len(req.SourceVolumeIds) == 0 {
+		log.Error("Volume group snapshot source volume IDs must be provided.")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Error(codes.InvalidArgument, "Volume group snapshot source volume IDs must be provided")
+	}
+
+	groupSnapshots, err := d.client.BlockStorage().GetVolumeGroupBackupsByName(ctx, req.Name, d.config.CompartmentID)
+	if err != nil {
+		log.With("service", "blockstorage", "verb", "get", "resource", "volumeGroupBackup", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to check the existence of the group snapshot.")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.Internal, "failed to check existence of group snapshot %v", err)
+	}
+
+	if len(groupSnapshots) > 1 {
+		log.Errorf("Duplicate group snapshot %q exists", req.Name)
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.Internal, "duplicate group snapshot %q exists", req.Name)
+	}
+
+	var groupSnapshot *core.VolumeGroupBackup
+	if len(groupSnapshots) > 0 {
+		log.Info("Group snapshot already created, checking if lifecycleState is Available")
+		groupSnapshot = &groupSnapshots[0]
+	} else {
+		snapshotParams, err := extractSnapshotParameters(req.GetParameters())
+		if err != nil {
+			log.With(zap.Error(err)).Error("Failed to parse volumegroupsnapshotclass parameters.")
+			dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+			metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, status.Errorf(codes.InvalidArgument, "failed to parse volumegroupsnapshotclass parameters %v", err)
+		}
+
+		volumeGroup, err := d.getOrCreateVolumeGroup(ctx, req.Name, req.SourceVolumeIds, snapshotParams, log)
+		if err != nil {
+			dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+			metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, err
+		}
+
+		groupBackupType := core.CreateVolumeGroupBackupDetailsTypeIncremental
+		if snapshotParams.backupType == core.CreateVolumeBackupDetailsTypeFull {
+			groupBackupType = core.CreateVolumeGroupBackupDetailsTypeFull
+		}
+
+		groupSnapshot, err = d.client.BlockStorage().CreateVolumeGroupBackup(ctx, core.CreateVolumeGroupBackupDetails{
+			VolumeGroupId: volumeGroup.Id,
+			CompartmentId: volumeGroup.CompartmentId,
+			Type:          groupBackupType,
+			DisplayName:   &req.Name,
+			FreeformTags:  snapshotParams.freeformTags,
+			DefinedTags:   snapshotParams.definedTags,
+		})
+		if err != nil {
+			log.With("service", "blockstorage", "verb", "create", "resource", "volumeGroupBackup", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Could not create group snapshot.")
+			dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+			metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, status.Errorf(codes.Internal, "Could not create group snapshot %q: %v", req.Name, err)
+		}
+
+		availableGroupSnapshot, err := d.client.BlockStorage().AwaitVolumeGroupBackupAvailableOrTimeout(ctx, *groupSnapshot.Id)
+		if err == nil {
+			groupSnapshot = availableGroupSnapshot
+		} else if strings.Contains(err.Error(), "timed out") {
+			log.Info("Group snapshot did not become available immediately after creation, controller will retry")
+			if latest, getErr := d.client.BlockStorage().GetVolumeGroupBackup(ctx, *groupSnapshot.Id); getErr == nil {
+				groupSnapshot = latest
+			}
+		} else {
+			log.With("service", "blockstorage", "verb", "get", "resource", "volumeGroupBackup", "statusCode", util.GetHttpStatusCode(err)).
+				With("volumeGroupBackupId", *groupSnapshot.Id).With(zap.Error(err)).Error("Error while waiting for group snapshot to become available.")
+			dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+			metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, status.Errorf(codes.Internal, "Group snapshot did not become available %q: %v", req.Name, err)
+		}
+	}
+
+	log = log.With("volumeGroupBackupId", *groupSnapshot.Id)
+	dimensionsMap[metrics.ResourceOCIDDimension] = *groupSnapshot.Id
+
+	csiGroupSnapshot, err := d.getCsiVolumeGroupSnapshot(ctx, groupSnapshot)
+	if err != nil {
+		log.With(zap.Error(err)).Error("Failed to get the snapshots of the group snapshot.")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.Internal, "failed to get snapshots of group snapshot %q: %v", req.Name, err)
+	}
+
+	// the volume backups are added to the group backup while it is being created, so the
+	// source volumes can only be compared once all of them are there
+	if len(csiGroupSnapshot.Snapshots) == 0 || (groupSnapshot.LifecycleState != core.VolumeGroupBackupLifecycleStateAvailable &&
+		len(csiGroupSnapshot.Snapshots) != len(req.SourceVolumeIds)) {
+		log.Info("Volume backups of the group snapshot are not created yet, controller will retry")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.BackupCreating, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.Unavailable, "group snapshot %q is still being created", req.Name)
+	}
+
+	if !isSameIdSet(getGroupSnapshotSourceVolumeIds(csiGroupSnapshot), req.SourceVolumeIds) {
+		log.Errorf("Group snapshot %s exists for another set of volumes", req.Name)
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.AlreadyExists, "group snapshot %s exists for another set of volumes", req.Name)
+	}
+
+	if csiGroupSnapshot.ReadyToUse {
+		d.deleteGroupSnapshotVolumeGroup(ctx, groupSnapshot, log)
+		log.Info("Group snapshot is created and available.")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.Success, util.CSIStorageType)
+	} else {
+		log.Info("Group snapshot has not become available yet, controller will retry")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.BackupCreating, util.CSIStorageType)
+	}
+	metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+
+	return &csi.CreateVolumeGroupSnapshotResponse{GroupSnapshot: csiGroupSnapshot}, nil
+}
+
+// DeleteVolumeGroupSnapshot deletes a group snapshot together with all the snapshots in the group.
+func (d *BlockVolumeControllerDriver) DeleteVolumeGroupSnapshot(ctx context.Context, req *csi.DeleteVolumeGroupSnapshotRequest) (*csi.DeleteVolumeGroupSnapshotResponse, error) {
+	startTime := time.Now()
+	dimensionsMap := make(map[string]string)
+	dimensionsMap[metrics.ResourceOCIDDimension] = req.GroupSnapshotId
+	log := d.logger.With("groupSnapshotId", req.GroupSnapshotId, "csiOperation", "deleteGroupSnapshot")
+
+	if req.GroupSnapshotId == "" {
+		log.Error("GroupSnapshotId is empty")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotDelete, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Error(codes.InvalidArgument, "GroupSnapshotId must be provided")
+	}
+
+	groupSnapshot, err := d.client.BlockStorage().GetVolumeGroupBackup(ctx, req.GroupSnapshotId)
+	if err != nil {
+		if client.IsNotFound(err) {
+			log.Info("Group snapshot not found, assuming it is already deleted.")
+			dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.Success, util.CSIStorageType)
+			metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotDelete, time.Since(startTime).Seconds(), dimensionsMap)
+			return &csi.DeleteVolumeGroupSnapshotResponse{}, nil
+		}
+		log.With("service", "blockstorage", "verb", "get", "resource", "volumeGroupBackup", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to get group snapshot.")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotDelete, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.Internal, "failed to get group snapshot %s: %v", req.GroupSnapshotId, err)
+	}
+
+	if len(req.SnapshotIds) > 0 && !isSameIdSet(req.SnapshotIds, groupSnapshot.VolumeBackupIds) {
+		log.With("snapshotIds", req.SnapshotIds, "volumeBackupIds", groupSnapshot.VolumeBackupIds).
+			Error("Snapshot IDs do not match the snapshots of the group snapshot.")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotDelete, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.InvalidArgument, "snapshot IDs %v do not match the snapshots of group snapshot %s", req.SnapshotIds, req.GroupSnapshotId)
+	}
+
+	// deleting a volume group backup also deletes the volume backups in the group
+	err = d.client.BlockStorage().DeleteVolumeGroupBackup(ctx, req.GroupSnapshotId)
+	if err != nil && !client.IsNotFound(err) {
+		log.With("service", "blockstorage", "verb", "delete", "resource", "volumeGroupBackup", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to delete group snapshot.")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotDelete, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.Internal, "failed to delete group snapshot %s: %v", req.GroupSnapshotId, err)
+	}
+
+	// the volume group is left behind if the group snapshot never became available
+	d.deleteGroupSnapshotVolumeGroup(ctx, groupSnapshot, log)
+
+	log.Info("Group snapshot is deleted.")
+	dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.Success, util.CSIStorageType)
+	metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotDelete, time.Since(startTime).Seconds(), dimensionsMap)
+	return &csi.DeleteVolumeGroupSnapshotResponse{}, nil
+}
+
+// GetVolumeGroupSnapshot returns the current state of a group snapshot.
+func (d *BlockVolumeControllerDriver) GetVolumeGroupSnapshot(ctx context.Context, req *csi.GetVolumeGroupSnapshotRequest) (*csi.GetVolumeGroupSnapshotResponse, error) {
+	log := d.logger.With("groupSnapshotId", req.GroupSnapshotId, "csiOperation", "getGroupSnapshot")
+
+	if req.GroupSnapshotId == "" {
+		log.Error("GroupSnapshotId is empty")
+		return nil, status.Error(codes.InvalidArgument, "GroupSnapshotId must be provided")
+	}
+
+	groupSnapshot, err := d.client.BlockStorage().GetVolumeGroupBackup(ctx, req.GroupSnapshotId)
+	if err != nil {
+		if client.IsNotFound(err) {
+			log.Info("Group snapshot not found.")
+			return nil, status.Errorf(codes.NotFound, "group snapshot %s not found", req.GroupSnapshotId)
+		}
+		log.With("service", "blockstorage", "verb", "get", "resource", "volumeGroupBackup", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to get group snapshot.")
+		return nil, status.Errorf(codes.Internal, "failed to get group snapshot %s: %v", req.GroupSnapshotId, err)
+	}
+
+	if groupSnapshot.LifecycleState == core.VolumeGroupBackupLifecycleStateTerminating ||
+		groupSnapshot.LifecycleState == core.VolumeGroupBackupLifecycleStateTerminated {
+		log.Infof("Group snapshot is in %s state.", groupSnapshot.LifecycleState)
+		return nil, status.Errorf(codes.NotFound, "group snapshot %s is in %s state", req.GroupSnapshotId, groupSnapshot.LifecycleState)
+	}
+
+	if len(req.SnapshotIds) > 0 && !isSameIdSet(req.SnapshotIds, groupSnapshot.VolumeBackupIds) {
+		log.With("snapshotIds", req.SnapshotIds, "volumeBackupIds", groupSnapshot.VolumeBackupIds).
+			Error("Snapshot IDs do not match the snapshots of the group snapshot.")
+		return nil, status.Errorf(codes.InvalidArgument, "snapshot IDs %v do not match the snapshots of group snapshot %s", req.SnapshotIds, req.GroupSnapshotId)
+	}
+
+	csiGroupSnapshot, err := d.getCsiVolumeGroupSnapshot(ctx, groupSnapshot)
+	if err != nil {
+		log.With(zap.Error(err)).Error("Failed to get the snapshots of the group snapshot.")
+		return nil, status.Errorf(codes.Internal, "failed to get snapshots of group snapshot %s: %v", req.GroupSnapshotId, err)
+	}
+
+	if csiGroupSnapshot.ReadyToUse {
+		d.deleteGroupSnapshotVolumeGroup(ctx, groupSnapshot, log)
+	}
+
+	return &csi.GetVolumeGroupSnapshotResponse{GroupSnapshot: csiGroupSnapshot}, nil
+}
+
+// getOrCreateVolumeGroup returns the volume group named after the group snapshot, creating it
+// from the source volumes if it does not exist yet. The returned error is a gRPC status.
+func (d *BlockVolumeControllerDriver) getOrCreateVolumeGroup(ctx context.Context, name string, sourceVolumeIds []string,
+	snapshotParams SnapshotParameters, log *zap.SugaredLogger) (*core.VolumeGroup, error) {
+	volumeGroups, err := d.client.BlockStorage().GetVolumeGroupsByName(ctx, name, d.config.CompartmentID)
+	if err != nil {
+		log.With("service", "blockstorage", "verb", "get", "resource", "volumeGroup", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to check the existence of the volume group.")
+		return nil, status.Errorf(codes.Internal, "failed to check existence of volume group %v", err)
+	}
+
+	if len(volumeGroups) > 1 {
+		log.Errorf("Duplicate volume group %q exists", name)
+		return nil, status.Errorf(codes.Internal, "duplicate volume group %q exists", name)
+	}
+
+	var volumeGroup *core.VolumeGroup
+	if len(volumeGroups) > 0 {
+		volumeGroup = &volumeGroups[0]
+		if !isSameIdSet(volumeGroup.VolumeIds, sourceVolumeIds) {
+			log.With("volumeGroupId", *volumeGroup.Id).Errorf("Volume group %s exists for another set of volumes", name)
+			return nil, status.Errorf(codes.AlreadyExists, "volume group %s exists for another set of volumes", name)
+		}
+	} else {
+		// a volume group can only hold volumes of a single availability domain
+		var availabilityDomain string
+		for _, volumeId := range sourceVolumeIds {
+			volume, err := d.client.BlockStorage().GetVolume(ctx, volumeId)
+			if err != nil {
+				log.With("service", "blockstorage", "verb", "get", "resource", "volume", "statusCode", util.GetHttpStatusCode(err)).
+					With("volumeId", volumeId).With(zap.Error(err)).Error("Failed to get source volume.")
+				if client.IsNotFound(err) {
+					return nil, status.Errorf(codes.NotFound, "source volume %s not found", volumeId)
+				}
+				return nil, status.Errorf(codes.Internal, "failed to get source volume %s: %v", volumeId, err)
+			}
+			if volume == nil || volume.AvailabilityDomain == nil {
+				log.With("volumeId", volumeId).Error("Source volume not found.")
+				return nil, status.Errorf(codes.NotFound, "source volume %s not found", volumeId)
+			}
+			if availabilityDomain == "" {
+				availabilityDomain = *volume.AvailabilityDomain
+			} else if availabilityDomain != *volume.AvailabilityDomain {
+				log.With("volumeId", volumeId).Errorf("Source volumes span availability domains %s and %s", availabilityDomain, *volume.AvailabilityDomain)
+				return nil, status.Errorf(codes.InvalidArgument, "source volumes of a group snapshot must be in the same availability domain, "+
+					"volume %s is in %s and not in %s", volumeId, *volume.AvailabilityDomain, availabilityDomain)
+			}
+		}
+
+		volumeGroup, err = d.client.BlockStorage().CreateVolumeGroup(ctx, core.CreateVolumeGroupDetails{
+			AvailabilityDomain: &availabilityDomain,
+			CompartmentId:      &d.config.CompartmentID,
+			DisplayName:        &name,
+			SourceDetails:      core.VolumeGroupSourceFromVolumesDetails{VolumeIds: sourceVolumeIds},
+			FreeformTags:       snapshotParams.freeformTags,
+			DefinedTags:        snapshotParams.definedTags,
+		})
+		if err != nil {
+			log.With("service", "blockstorage", "verb", "create", "resource", "volumeGroup", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Could not create volume group.")
+			if isVolumeInVolumeGroupError(err) {
+				return nil, status.Errorf(codes.FailedPrecondition, "could not create volume group %q, a source volume "+
+					"is already part of another volume group: %v", name, err)
+			}
+			return nil, status.Errorf(codes.Internal, "could not create volume group %q: %v", name, err)
+		}
+	}
+
+	log = log.With("volumeGroupId", *volumeGroup.Id)
+	volumeGroup, err = d.client.BlockStorage().AwaitVolumeGroupAvailableOrTimeout(ctx, *volumeGroup.Id)
+	if err != nil {
+		log.With("service", "blockstorage", "verb", "get", "resource", "volumeGroup", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Volume group did not become available.")
+		return nil, status.Errorf(codes.Internal, "volume group %q did not become available: %v", name, err)
+	}
+	return volumeGroup, nil
+}
+
+// deleteGroupSnapshotVolumeGroup removes the volume group that was created for a group snapshot.
+// The volumes of the group are not deleted with it. Failures are only logged since the group
+// snapshot itself is not affected by them.
+func (d *BlockVolumeControllerDriver) deleteGroupSnapshotVolumeGroup(ctx context.Context, groupSnapshot *core.VolumeGroupBackup, log *zap.SugaredLogger) {
+	if groupSnapshot.VolumeGroupId == nil {
+		return
+	}
+	log = log.With("volumeGroupId", *groupSnapshot.VolumeGroupId)
+
+	volumeGroup, err := d.client.BlockStorage().GetVolumeGroup(ctx, *groupSnapshot.VolumeGroupId)
+	if err != nil {
+		if !client.IsNotFound(err) {
+			log.With("service", "blockstorage", "verb", "get", "resource", "volumeGroup", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Warn("Failed to get the volume group of the group snapshot.")
+		}
+		return
+	}
+
+	// only volume groups created by the driver are named after the group snapshot
+	if volumeGroup.DisplayName == nil || groupSnapshot.DisplayName == nil || *volumeGroup.DisplayName != *groupSnapshot.DisplayName ||
+		volumeGroup.LifecycleState == core.VolumeGroupLifecycleStateTerminating ||
+		volumeGroup.LifecycleState == core.VolumeGroupLifecycleStateTerminated {
+		return
+	}
+
+	if err := d.client.BlockStorage().DeleteVolumeGroup(ctx, *groupSnapshot.VolumeGroupId); err != nil && !client.IsNotFound(err) {
+		log.With("service", "blockstorage", "verb", "delete", "resource", "volumeGroup", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Warn("Failed to delete the volume group of the group snapshot.")
+		return
+	}
+	log.Info("Deleted the volume group of the group snapshot.")
+}
+
+// getCsiVolumeGroupSnapshot converts a volume group backup and the volume backups in it into a
+// CSI group snapshot. The group snapshot is ready to use once all of its snapshots are.
+func (d *BlockVolumeControllerDriver) getCsiVolumeGroupSnapshot(ctx context.Context, groupSnapshot *core.VolumeGroupBackup) (*csi.VolumeGroupSnapshot, error) {
+	readyToUse := groupSnapshot.LifecycleState == core.VolumeGroupBackupLifecycleStateAvailable
+	snapshots := make([]*csi.Snapshot, 0, len(groupSnapshot.VolumeBackupIds))
+	for _, volumeBackupId := range groupSnapshot.VolumeBackupIds {
+		volumeBackup, err := d.client.BlockStorage().GetVolumeBackup(ctx, volumeBackupId)
+		if err != nil {
+			return nil, err
+		}
+		entry := getListSnapshotsEntry(*volumeBackup)
+		if entry == nil {
+			return nil, status.Errorf(codes.NotFound, "snapshot %s is in %s state", volumeBackupId, volumeBackup.LifecycleState)
+		}
+		entry.Snapshot.GroupSnapshotId = *groupSnapshot.Id
+		readyToUse = readyToUse && entry.Snapshot.ReadyToUse
+		snapshots = append(snapshots, entry.Snapshot)
+	}
+
+	csiGroupSnapshot := &csi.VolumeGroupSnapshot{
+		GroupSnapshotId: *groupSnapshot.Id,
+		Snapshots:       snapshots,
+		ReadyToUse:      readyToUse && len(snapshots) > 0,
+	}
+	if groupSnapshot.TimeCreated != nil {
+		csiGroupSnapshot.CreationTime = timestamppb.New(groupSnapshot.TimeCreated.Time)
+	}
+	return csiGroupSnapshot, nil
+}
+
+func getGroupSnapshotSourceVolumeIds(groupSnapshot *csi.VolumeGroupSnapshot) []string {
+	sourceVolumeIds := make([]string, 0, len(groupSnapshot.Snapshots))
+	for _, snapshot := range groupSnapshot.Snapshots {
+		sourceVolumeIds = append(sourceVolumeIds, snapshot.SourceVolumeId)
+	}
+	return sourceVolumeIds
+}
+
+// isSameIdSet reports whether both lists hold the same IDs, irrespective of their order.
+func isSameIdSet(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sortedA := append([]string(nil), a...)
+	sortedB := append([]string(nil), b...)
+	sort.Strings(sortedA)
+	sort.Strings(sortedB)
+	for i := range sortedA {
+		if sortedA[i] != sortedB[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// isVolumeInVolumeGroupError returns true if OCI refused to create a volume group because
+// one of the source volumes is already part of another volume group.
+func isVolumeInVolumeGroupError(err error) bool {
+	serviceErr, ok := common.IsServiceError(errors.Cause(err))
+	if !ok {
+		return false
+	}
+	switch serviceErr.GetHTTPStatusCode() {
+	case http.StatusConflict:
+		return true
+	case http.StatusBadRequest:
+		return strings.Contains(strings.ToLower(serviceErr.GetMessage()), "volume group")
+	}
+	return false
+}
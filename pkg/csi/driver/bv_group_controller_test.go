@@ -0,0 +1,291 @@
+// Copyright 2024 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	providercfg "github.com/oracle/oci-cloud-controller-manager/pkg/cloudprovider/providers/oci/config"
+	csi_util "github.com/oracle/oci-cloud-controller-manager/pkg/csi-util"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
+)
+
+func newTestBlockVolumeControllerDriver() *BlockVolumeControllerDriver {
+	return &BlockVolumeControllerDriver{ControllerDriver{
+		KubeClient: nil,
+		logger:     zap.S(),
+		config:     &providercfg.Config{CompartmentID: ""},
+		client:     NewClientProvisioner(nil, &MockBlockStorageClient{}, nil),
+		util:       &csi_util.Util{},
+	}}
+}
+
+func groupSnapshotMember(snapshotId, sourceVolumeId, groupSnapshotId string, readyToUse bool) *csi.Snapshot {
+	return &csi.Snapshot{
+		SnapshotId:      snapshotId,
+		SourceVolumeId:  sourceVolumeId,
+		SizeBytes:       51200 * client.MiB,
+		ReadyToUse:      readyToUse,
+		GroupSnapshotId: groupSnapshotId,
+	}
+}
+
+func TestControllerDriver_CreateVolumeGroupSnapshot(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *csi.CreateVolumeGroupSnapshotRequest
+		want    *csi.CreateVolumeGroupSnapshotResponse
+		wantErr error
+	}{
+		{
+			name:    "Error for empty name",
+			req:     &csi.CreateVolumeGroupSnapshotRequest{SourceVolumeIds: []string{"volume-in-available-state"}},
+			wantErr: errors.New("Volume group snapshot name must be provided"),
+		},
+		{
+			name:    "Error for no source volumes",
+			req:     &csi.CreateVolumeGroupSnapshotRequest{Name: "group-snapshot-new"},
+			wantErr: errors.New("Volume group snapshot source volume IDs must be provided"),
+		},
+		{
+			name: "Error for invalid backup type",
+			req: &csi.CreateVolumeGroupSnapshotRequest{
+				Name:            "group-snapshot-new",
+				SourceVolumeIds: []string{"volume-in-available-state"},
+				Parameters:      map[string]string{backupType: "differential"},
+			},
+			wantErr: errors.New("invalid backupType"),
+		},
+		{
+			name: "Error for source volumes in different availability domains",
+			req: &csi.CreateVolumeGroupSnapshotRequest{
+				Name:            "group-snapshot-new",
+				SourceVolumeIds: []string{"volume-in-available-state", "valid_volume_id"},
+			},
+			wantErr: errors.New("source volumes of a group snapshot must be in the same availability domain"),
+		},
+		{
+			name: "Error for unknown source volume",
+			req: &csi.CreateVolumeGroupSnapshotRequest{
+				Name:            "group-snapshot-new",
+				SourceVolumeIds: []string{"volume-in-available-state", "unknown-volume"},
+			},
+			wantErr: errors.New("source volume unknown-volume not found"),
+		},
+		{
+			name: "Error for existing volume group of other volumes",
+			req: &csi.CreateVolumeGroupSnapshotRequest{
+				Name:            "group-snapshot-with-other-volume-group",
+				SourceVolumeIds: []string{"volume-in-available-state"},
+			},
+			wantErr: errors.New("volume group group-snapshot-with-other-volume-group exists for another set of volumes"),
+		},
+		{
+			name: "Error for source volume in another volume group",
+			req: &csi.CreateVolumeGroupSnapshotRequest{
+				Name:            "group-snapshot-in-other-volume-group",
+				SourceVolumeIds: []string{"volume-in-available-state"},
+			},
+			wantErr: errors.New("a source volume is already part of another volume group"),
+		},
+		{
+			name: "Error when the volume group backup cannot be created",
+			req: &csi.CreateVolumeGroupSnapshotRequest{
+				Name:            "group-snapshot-create-fails",
+				SourceVolumeIds: []string{"volume-in-available-state"},
+			},
+			wantErr: errors.New("Could not create group snapshot"),
+		},
+		{
+			name: "Create a new group snapshot",
+			req: &csi.CreateVolumeGroupSnapshotRequest{
+				Name:            "group-snapshot-new",
+				SourceVolumeIds: []string{"volume-with-detaching-attachment", "volume-in-available-state"},
+				Parameters:      map[string]string{backupType: backupTypeFull},
+			},
+			want: &csi.CreateVolumeGroupSnapshotResponse{
+				GroupSnapshot: &csi.VolumeGroupSnapshot{
+					GroupSnapshotId: "group-backup-new",
+					Snapshots: []*csi.Snapshot{
+						groupSnapshotMember("group-member-backup-1", "volume-in-available-state", "group-backup-new", true),
+						groupSnapshotMember("group-member-backup-2", "volume-with-detaching-attachment", "group-backup-new", true),
+					},
+					ReadyToUse: true,
+				},
+			},
+		},
+		{
+			name: "Existing group snapshot that is still being created",
+			req: &csi.CreateVolumeGroupSnapshotRequest{
+				Name:            "group-snapshot-creating",
+				SourceVolumeIds: []string{"volume-in-available-state"},
+			},
+			want: &csi.CreateVolumeGroupSnapshotResponse{
+				GroupSnapshot: &csi.VolumeGroupSnapshot{
+					GroupSnapshotId: "group-backup-creating",
+					Snapshots: []*csi.Snapshot{
+						groupSnapshotMember("group-member-backup-creating", "volume-in-available-state", "group-backup-creating", false),
+					},
+					ReadyToUse: false,
+				},
+			},
+		},
+		{
+			name: "Existing group snapshot whose volume backups are still being added",
+			req: &csi.CreateVolumeGroupSnapshotRequest{
+				Name:            "group-snapshot-partially-created",
+				SourceVolumeIds: []string{"volume-in-available-state", "volume-with-detaching-attachment"},
+			},
+			wantErr: errors.New("group snapshot \"group-snapshot-partially-created\" is still being created"),
+		},
+		{
+			name: "Error for existing group snapshot of other volumes",
+			req: &csi.CreateVolumeGroupSnapshotRequest{
+				Name:            "group-snapshot-available",
+				SourceVolumeIds: []string{"volume-in-available-state"},
+			},
+			wantErr: errors.New("group snapshot group-snapshot-available exists for another set of volumes"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newTestBlockVolumeControllerDriver()
+			got, err := d.CreateVolumeGroupSnapshot(context.Background(), tt.req)
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("got error %q, want none", err)
+			}
+			if tt.wantErr != nil && (err == nil || !strings.Contains(err.Error(), tt.wantErr.Error())) {
+				t.Errorf("want error %q to include %q", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ControllerDriver.CreateVolumeGroupSnapshot() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestControllerDriver_DeleteVolumeGroupSnapshot(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *csi.DeleteVolumeGroupSnapshotRequest
+		wantErr error
+	}{
+		{
+			name:    "Error for empty group snapshot ID",
+			req:     &csi.DeleteVolumeGroupSnapshotRequest{},
+			wantErr: errors.New("GroupSnapshotId must be provided"),
+		},
+		{
+			name: "Group snapshot that is already deleted",
+			req:  &csi.DeleteVolumeGroupSnapshotRequest{GroupSnapshotId: "group-backup-deleted"},
+		},
+		{
+			name: "Error for snapshot IDs of another group snapshot",
+			req: &csi.DeleteVolumeGroupSnapshotRequest{
+				GroupSnapshotId: "group-backup-available",
+				SnapshotIds:     []string{"group-member-backup-creating"},
+			},
+			wantErr: errors.New("do not match the snapshots of group snapshot group-backup-available"),
+		},
+		{
+			name: "Delete a group snapshot",
+			req: &csi.DeleteVolumeGroupSnapshotRequest{
+				GroupSnapshotId: "group-backup-available",
+				SnapshotIds:     []string{"group-member-backup-2", "group-member-backup-1"},
+			},
+		},
+		{
+			name:    "Error when the volume group backup cannot be deleted",
+			req:     &csi.DeleteVolumeGroupSnapshotRequest{GroupSnapshotId: "group-backup-delete-fails"},
+			wantErr: errors.New("failed to delete group snapshot group-backup-delete-fails"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newTestBlockVolumeControllerDriver()
+			_, err := d.DeleteVolumeGroupSnapshot(context.Background(), tt.req)
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("got error %q, want none", err)
+			}
+			if tt.wantErr != nil && (err == nil || !strings.Contains(err.Error(), tt.wantErr.Error())) {
+				t.Errorf("want error %q to include %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestControllerDriver_GetVolumeGroupSnapshot(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *csi.GetVolumeGroupSnapshotRequest
+		want    *csi.GetVolumeGroupSnapshotResponse
+		wantErr error
+	}{
+		{
+			name:    "Error for empty group snapshot ID",
+			req:     &csi.GetVolumeGroupSnapshotRequest{},
+			wantErr: errors.New("GroupSnapshotId must be provided"),
+		},
+		{
+			name:    "Error for unknown group snapshot",
+			req:     &csi.GetVolumeGroupSnapshotRequest{GroupSnapshotId: "group-backup-deleted"},
+			wantErr: errors.New("group snapshot group-backup-deleted not found"),
+		},
+		{
+			name: "Error for snapshot IDs of another group snapshot",
+			req: &csi.GetVolumeGroupSnapshotRequest{
+				GroupSnapshotId: "group-backup-available",
+				SnapshotIds:     []string{"group-member-backup-1"},
+			},
+			wantErr: errors.New("do not match the snapshots of group snapshot group-backup-available"),
+		},
+		{
+			name: "Get an available group snapshot",
+			req:  &csi.GetVolumeGroupSnapshotRequest{GroupSnapshotId: "group-backup-available"},
+			want: &csi.GetVolumeGroupSnapshotResponse{
+				GroupSnapshot: &csi.VolumeGroupSnapshot{
+					GroupSnapshotId: "group-backup-available",
+					Snapshots: []*csi.Snapshot{
+						groupSnapshotMember("group-member-backup-1", "volume-in-available-state", "group-backup-available", true),
+						groupSnapshotMember("group-member-backup-2", "volume-with-detaching-attachment", "group-backup-available", true),
+					},
+					ReadyToUse: true,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newTestBlockVolumeControllerDriver()
+			got, err := d.GetVolumeGroupSnapshot(context.Background(), tt.req)
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("got error %q, want none", err)
+			}
+			if tt.wantErr != nil && (err == nil || !strings.Contains(err.Error(), tt.wantErr.Error())) {
+				t.Errorf("want error %q to include %q", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ControllerDriver.GetVolumeGroupSnapshot() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
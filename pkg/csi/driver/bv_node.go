@@ -19,17 +19,21 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
 
 	"github.com/container-storage-interface/spec/lib/go/csi"
+	"github.com/oracle/oci-go-sdk/v65/common/auth"
 	"github.com/oracle/oci-go-sdk/v65/core"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	kubeAPI "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/kubernetes/pkg/volume"
 	"k8s.io/kubernetes/pkg/volume/util/hostutil"
 
@@ -39,11 +43,34 @@ import (
 )
 
 const (
-	maxVolumesPerNode               = 32
 	volumeOperationAlreadyExistsFmt = "An operation for the volume: %s already exists."
 	FSTypeXfs                       = "xfs"
+	// luksPassphraseKey is the key of the LUKS passphrase in the node-stage secret
+	luksPassphraseKey = "luksPassphrase"
 )
 
+const (
+	// defaultMaxVolumeAttachments is the number of consistent device paths an
+	// instance has for block volumes. Every attachment made by the driver
+	// takes one, so it bounds the volumes of a node.
+	defaultMaxVolumeAttachments = 32
+	// vmVolumeAttachmentsPerOcpu is the number of block volumes a VM instance
+	// can have attached per OCPU, up to the default.
+	vmVolumeAttachmentsPerOcpu = 16
+	// consistentDevicePathDir holds a link for the consistent device path of
+	// every volume attached to the instance.
+	consistentDevicePathDir = "/dev/oracleoci"
+	// bootVolumeDevice is the consistent device name of the boot volume.
+	bootVolumeDevice = "oraclevda"
+	// maxVolumesPerNodeLabel is a node label that overrides the number of
+	// block volumes the driver reports it can attach to the node.
+	maxVolumesPerNodeLabel = "oci.oraclecloud.com/max-volumes-per-node"
+)
+
+// consistentDeviceNamePattern matches the consistent device name of a volume
+// but not of its partitions.
+var consistentDeviceNamePattern = regexp.MustCompile(`^oraclevd[a-z]+$`)
+
 // NodeStageVolume mounts the volume to a staging path on the node.
 func (d BlockVolumeNodeDriver) NodeStageVolume(ctx context.Context, req *csi.NodeStageVolumeRequest) (*csi.NodeStageVolumeResponse, error) {
 	if req.VolumeId == "" {
@@ -128,13 +155,35 @@ func (d BlockVolumeNodeDriver) NodeStageVolume(ctx context.Context, req *csi.Nod
 
 	defer d.volumeLocks.Release(req.VolumeId)
 
-	isMounted, oErr := mountHandler.DeviceOpened(devicePath)
-	if oErr != nil {
-		logger.With(zap.Error(oErr)).Error("getting error to get the details about volume is already mounted or not.")
-		return nil, status.Error(codes.Internal, oErr.Error())
-	} else if isMounted {
-		logger.Info("volume is already mounted on the staging path.")
-		return &csi.NodeStageVolumeResponse{}, nil
+	isRawBlockVolume := req.VolumeCapability.GetBlock() != nil
+	stagingDevicePath := getRawBlockStagingDevicePath(req.StagingTargetPath, req.VolumeId)
+
+	luksEncrypted, err := isLuksEncryptionEnabled(req.VolumeContext)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to determine if the volume is LUKS encrypted")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if isRawBlockVolume {
+		if _, err := disk.GetDiskPathFromBindDeviceFilePath(logger, stagingDevicePath); err == nil {
+			logger.Info("raw block volume is already staged.")
+			return &csi.NodeStageVolumeResponse{}, nil
+		}
+	} else {
+		// The device of a LUKS encrypted volume is held open by dm-crypt, the
+		// mapper device is the one that gets mounted.
+		openedPath := devicePath
+		if luksEncrypted {
+			openedPath = disk.LuksMapperPath(req.VolumeId)
+		}
+		isMounted, oErr := mountHandler.DeviceOpened(openedPath)
+		if oErr != nil {
+			logger.With(zap.Error(oErr)).Error("getting error to get the details about volume is already mounted or not.")
+			return nil, status.Error(codes.Internal, oErr.Error())
+		} else if isMounted {
+			logger.Info("volume is already mounted on the staging path.")
+			return &csi.NodeStageVolumeResponse{}, nil
+		}
 	}
 
 	err = mountHandler.AddToDB()
@@ -191,6 +240,30 @@ func (d BlockVolumeNodeDriver) NodeStageVolume(ctx context.Context, req *csi.Nod
 		return nil, status.Error(codes.DeadlineExceeded, "Failed to wait for device to exist.")
 	}
 
+	if luksEncrypted {
+		devicePath, err = openLuksDevice(ctx, logger, req, mountHandler, devicePath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Raw block volumes are not formatted. The device node is bind mounted to a
+	// file under the staging path so that unstage can find the device again.
+	if isRawBlockVolume {
+		if err := createRawBlockDeviceFile(stagingDevicePath); err != nil {
+			logger.With(zap.Error(err)).Error("Failed to create raw block device file in StagingTargetPath")
+			return nil, status.Error(codes.Internal, "Failed to create raw block device file in StagingTargetPath")
+		}
+		logger.With("devicePath", devicePath).Info("bind mounting the raw block device to staging path.")
+		if err := mountHandler.Mount(devicePath, stagingDevicePath, "", []string{"bind"}); err != nil {
+			logger.With(zap.Error(err)).Error("failed to bind mount raw block device to staging path.")
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		logger.With("devicePath", devicePath, "attachmentType", attachment).
+			Info("Staging the raw block volume is completed.")
+		return &csi.NodeStageVolumeResponse{}, nil
+	}
+
 	mnt := req.VolumeCapability.GetMount()
 	options := mnt.MountFlags
 
@@ -218,6 +291,14 @@ func (d BlockVolumeNodeDriver) NodeStageVolume(ctx context.Context, req *csi.Nod
 		}
 	}
 
+	// A volume shared read-only is attached read-only, so it cannot be
+	// formatted or mounted read/write.
+	if req.VolumeCapability.GetAccessMode().GetMode() == csi.VolumeCapability_AccessMode_MULTI_NODE_READER_ONLY {
+		if !hasMountOption(options, "ro") {
+			options = append(options, "ro")
+		}
+	}
+
 	//XFS does not allow mounting two volumes with same UUID,
 	//this block is needed for mounting a volume and a volume
 	//restored from it's snapshot on the same node
@@ -270,15 +351,40 @@ func (d BlockVolumeNodeDriver) NodeUnstageVolume(ctx context.Context, req *csi.N
 
 	defer d.volumeLocks.Release(req.VolumeId)
 
-	diskPath, err := disk.GetDiskPathFromMountPath(d.logger, req.GetStagingTargetPath())
+	unmountPath := req.GetStagingTargetPath()
+	stagingDevicePath := getRawBlockStagingDevicePath(req.GetStagingTargetPath(), req.VolumeId)
+	isRawBlockVolume, err := isRawBlockDeviceFile(stagingDevicePath)
+	if err != nil {
+		logger.With(zap.Error(err)).With("stagingDevicePath", stagingDevicePath).Error("unable to determine the volume mode")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if isRawBlockVolume {
+		unmountPath = stagingDevicePath
+	}
+
+	diskPath, err := getDiskPathFromVolumePath(d.logger, unmountPath, isRawBlockVolume)
 
 	if err != nil {
 		// do a clean exit in case of mount point not found
 		if err == disk.ErrMountPointNotFound {
-			logger.With(zap.Error(err)).With("mountPath", req.GetStagingTargetPath()).Warn("unable to fetch mount point")
+			logger.With(zap.Error(err)).With("mountPath", unmountPath).Warn("unable to fetch mount point")
+			if isRawBlockVolume {
+				if err := os.Remove(stagingDevicePath); err != nil && !os.IsNotExist(err) {
+					logger.With(zap.Error(err)).With("stagingDevicePath", stagingDevicePath).Warn("unable to remove raw block device file")
+				}
+			}
+			if err := disk.LuksClose(logger, disk.LuksMapperName(req.VolumeId)); err != nil {
+				logger.With(zap.Error(err)).Warn("unable to close LUKS device")
+			}
 			return &csi.NodeUnstageVolumeResponse{}, nil
 		}
-		logger.With(zap.Error(err)).With("mountPath", req.GetStagingTargetPath()).Error("unable to get diskPath from mount path")
+		logger.With(zap.Error(err)).With("mountPath", unmountPath).Error("unable to get diskPath from mount path")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	diskPath, isLuksDevice, err := resolveLuksDiskPath(logger, req.VolumeId, diskPath)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("unable to get the device backing the LUKS device")
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -318,21 +424,38 @@ func (d BlockVolumeNodeDriver) NodeUnstageVolume(ctx context.Context, req *csi.N
 		logger.Error("unknown attachment type. supported attachment types are iscsi and paravirtualized")
 		return nil, status.Error(codes.InvalidArgument, "unknown attachment type. supported attachment types are iscsi and paravirtualized")
 	}
-	isMounted, oErr := mountHandler.DeviceOpened(devicePath)
-	if oErr != nil {
-		logger.With(zap.Error(oErr)).Error("getting error to get the details about volume is already mounted or not.")
-		return nil, status.Error(codes.Internal, oErr.Error())
-	} else if !isMounted {
-		logger.Info("volume is already mounted on the staging path.")
-		return &csi.NodeUnstageVolumeResponse{}, nil
+	// A raw block device is only bind mounted, so it does not show up as opened.
+	if !isRawBlockVolume {
+		isMounted, oErr := mountHandler.DeviceOpened(devicePath)
+		if oErr != nil {
+			logger.With(zap.Error(oErr)).Error("getting error to get the details about volume is already mounted or not.")
+			return nil, status.Error(codes.Internal, oErr.Error())
+		} else if !isMounted {
+			logger.Info("volume is already mounted on the staging path.")
+			return &csi.NodeUnstageVolumeResponse{}, nil
+		}
 	}
 
-	err = mountHandler.UnmountPath(req.StagingTargetPath)
+	err = mountHandler.UnmountPath(unmountPath)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to unmount the staging path")
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	if isRawBlockVolume {
+		if err := os.Remove(stagingDevicePath); err != nil && !os.IsNotExist(err) {
+			logger.With(zap.Error(err)).With("stagingDevicePath", stagingDevicePath).Error("failed to remove the raw block device file")
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	if isLuksDevice {
+		if err := disk.LuksClose(logger, disk.LuksMapperName(req.VolumeId)); err != nil {
+			logger.With(zap.Error(err)).Error("failed to close the LUKS device")
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
 	err = mountHandler.Logout()
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to logout from the iSCSI target")
@@ -387,13 +510,23 @@ func (d BlockVolumeNodeDriver) NodePublishVolume(ctx context.Context, req *csi.N
 
 	defer d.volumeLocks.Release(req.VolumeId)
 
-	// k8s v1.20+ will not create the TargetPath directory
-	// https://github.com/kubernetes/kubernetes/pull/88759
-	// if the path exists already (<v1.20) this is a no op
-	// https://golang.org/pkg/os/#MkdirAll
-	if err := os.MkdirAll(req.TargetPath, 0750); err != nil {
-		logger.With(zap.Error(err)).Error("Failed to create TargetPath directory")
-		return nil, status.Error(codes.Internal, "Failed to create TargetPath directory")
+	isRawBlockVolume := req.VolumeCapability.GetBlock() != nil
+
+	if isRawBlockVolume {
+		// For raw block volumes the TargetPath is a file onto which the device is bind mounted
+		if err := createRawBlockDeviceFile(req.TargetPath); err != nil {
+			logger.With(zap.Error(err)).Error("Failed to create TargetPath device file")
+			return nil, status.Error(codes.Internal, "Failed to create TargetPath device file")
+		}
+	} else {
+		// k8s v1.20+ will not create the TargetPath directory
+		// https://github.com/kubernetes/kubernetes/pull/88759
+		// if the path exists already (<v1.20) this is a no op
+		// https://golang.org/pkg/os/#MkdirAll
+		if err := os.MkdirAll(req.TargetPath, 0750); err != nil {
+			logger.With(zap.Error(err)).Error("Failed to create TargetPath directory")
+			return nil, status.Error(codes.Internal, "Failed to create TargetPath directory")
+		}
 	}
 
 	multipathEnabledVolume := false
@@ -431,26 +564,39 @@ func (d BlockVolumeNodeDriver) NodePublishVolume(ctx context.Context, req *csi.N
 		return nil, status.Error(codes.InvalidArgument, "unknown attachment type. supported attachment types are iscsi and paravirtualized")
 	}
 
-	mnt := req.VolumeCapability.GetMount()
-	options := mnt.MountFlags
+	var options []string
+	var fsType string
+	source := req.StagingTargetPath
+	stagingDevicePath := getRawBlockStagingDevicePath(req.StagingTargetPath, req.VolumeId)
 
-	options = append(options, "bind")
-	if req.Readonly {
-		options = append(options, "ro")
-	}
+	if isRawBlockVolume {
+		source = stagingDevicePath
+		options = append(options, "bind")
+		if req.Readonly {
+			options = append(options, "ro")
+		}
+	} else {
+		mnt := req.VolumeCapability.GetMount()
+		options = mnt.MountFlags
 
-	fsType := csi_util.ValidateFsType(logger, mnt.FsType)
+		options = append(options, "bind")
+		if req.Readonly {
+			options = append(options, "ro")
+		}
 
-	//XFS does not allow mounting two volumes with same UUID,
-	//this block is needed for mounting a volume and a volume
-	//restored from it's snapshot on the same node
-	if fsType == FSTypeXfs {
-		if !hasMountOption(options, "nouuid") {
-			options = append(options, "nouuid")
+		fsType = csi_util.ValidateFsType(logger, mnt.FsType)
+
+		//XFS does not allow mounting two volumes with same UUID,
+		//this block is needed for mounting a volume and a volume
+		//restored from it's snapshot on the same node
+		if fsType == FSTypeXfs {
+			if !hasMountOption(options, "nouuid") {
+				options = append(options, "nouuid")
+			}
 		}
 	}
 
-	err := mountHandler.Mount(req.StagingTargetPath, req.TargetPath, fsType, options)
+	err := mountHandler.Mount(source, req.TargetPath, fsType, options)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to format and mount.")
 		return nil, status.Error(codes.Internal, err.Error())
@@ -475,14 +621,20 @@ func (d BlockVolumeNodeDriver) NodePublishVolume(ctx context.Context, req *csi.N
 			}
 			requestedSizeGB := csi_util.RoundUpSize(requestedSize, 1*client.GiB)
 
-			diskPath, err := disk.GetDiskPathFromMountPath(d.logger, req.StagingTargetPath)
+			diskPath, err := getDiskPathFromVolumePath(d.logger, source, isRawBlockVolume)
 			if err != nil {
 				// do a clean exit in case of mount point not found
 				if err == disk.ErrMountPointNotFound {
-					logger.With(zap.Error(err)).With("volumePath", req.StagingTargetPath).Warn("unable to fetch mount point")
+					logger.With(zap.Error(err)).With("volumePath", source).Warn("unable to fetch mount point")
 					return &csi.NodePublishVolumeResponse{}, err
 				}
-				logger.With(zap.Error(err)).With("volumePath", req.StagingTargetPath).Error("unable to get diskPath from mount path")
+				logger.With(zap.Error(err)).With("volumePath", source).Error("unable to get diskPath from mount path")
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+
+			diskPath, isLuksDevice, err := resolveLuksDiskPath(logger, req.VolumeId, diskPath)
+			if err != nil {
+				logger.With(zap.Error(err)).Error("unable to get the device backing the LUKS device")
 				return nil, status.Error(codes.Internal, err.Error())
 			}
 
@@ -514,8 +666,19 @@ func (d BlockVolumeNodeDriver) NodePublishVolume(ctx context.Context, req *csi.N
 			}
 			logger.With("devicePath", devicePath).Debug("Rescan completed")
 
-			if _, err := mountHandler.Resize(devicePath, req.TargetPath); err != nil {
-				return nil, status.Errorf(codes.Internal, "Failed to resize volume %q (%q):  %v", req.VolumeId, devicePath, err)
+			fsDevicePath := devicePath
+			if isLuksDevice {
+				if err := disk.LuksResize(logger, disk.LuksMapperName(req.VolumeId)); err != nil {
+					return nil, status.Errorf(codes.Internal, "Failed to resize LUKS device of volume %q:  %v", req.VolumeId, err)
+				}
+				fsDevicePath = disk.LuksMapperPath(req.VolumeId)
+			}
+
+			// Raw block volumes have no filesystem to grow, rescanning the device is enough
+			if !isRawBlockVolume {
+				if _, err := mountHandler.Resize(fsDevicePath, req.TargetPath); err != nil {
+					return nil, status.Errorf(codes.Internal, "Failed to resize volume %q (%q):  %v", req.VolumeId, fsDevicePath, err)
+				}
 			}
 
 			allocatedSizeBytes, err := csi_util.GetBlockSizeBytes(logger, devicePath)
@@ -555,11 +718,22 @@ func (d BlockVolumeNodeDriver) NodeUnpublishVolume(ctx context.Context, req *csi
 
 	defer d.volumeLocks.Release(req.VolumeId)
 
-	diskPath, err := disk.GetDiskPathFromMountPath(d.logger, req.TargetPath)
+	isRawBlockVolume, err := isRawBlockDeviceFile(req.TargetPath)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("unable to determine the volume mode")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	diskPath, err := getDiskPathFromVolumePath(d.logger, req.TargetPath, isRawBlockVolume)
 	if err != nil {
 		// do a clean exit in case of mount point not found
 		if err == disk.ErrMountPointNotFound {
 			logger.With(zap.Error(err)).With("mountPath", req.TargetPath).Warn("unable to fetch mount point")
+			if isRawBlockVolume {
+				if err := os.Remove(req.TargetPath); err != nil && !os.IsNotExist(err) {
+					logger.With(zap.Error(err)).Warn("unable to remove raw block device file")
+				}
+			}
 			return &csi.NodeUnpublishVolumeResponse{}, nil
 		}
 		logger.With(zap.Error(err)).With("mountPath", req.TargetPath).Error("unable to get diskPath from mount path")
@@ -600,6 +774,47 @@ func (d BlockVolumeNodeDriver) NodeUnpublishVolume(ctx context.Context, req *csi
 	return &csi.NodeUnpublishVolumeResponse{}, nil
 }
 
+// getRawBlockStagingDevicePath returns the file under the staging path onto
+// which the device of a raw block volume is bind mounted.
+func getRawBlockStagingDevicePath(stagingTargetPath string, volumeID string) string {
+	return filepath.Join(stagingTargetPath, filepath.Base(volumeID))
+}
+
+// createRawBlockDeviceFile creates the (empty) file used as bind mount target
+// for a raw block device, along with its parent directories.
+func createRawBlockDeviceFile(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0640)
+	if err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+// isRawBlockDeviceFile reports whether path is a raw block volume device file
+// rather than a filesystem mount directory. A missing path is not an error.
+func isRawBlockDeviceFile(path string) (bool, error) {
+	fileInfo, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return !fileInfo.IsDir(), nil
+}
+
+// getDiskPathFromVolumePath resolves the disk paths backing a filesystem mount
+// point or, for raw block volumes, a bind mounted device file.
+func getDiskPathFromVolumePath(logger *zap.SugaredLogger, volumePath string, isRawBlockVolume bool) ([]string, error) {
+	if isRawBlockVolume {
+		return disk.GetDiskPathFromBindDeviceFilePath(logger, volumePath)
+	}
+	return disk.GetDiskPathFromMountPath(logger, volumePath)
+}
+
 func getDevicePathAndAttachmentType(path []string) (string, string, error) {
 	for _, diskByPath := range path {
 		matched, _ := regexp.MatchString(csi_util.DiskByPathPatternPV, diskByPath)
@@ -620,6 +835,99 @@ func getDevicePathAndAttachmentType(path []string) (string, string, error) {
 	return "", "", errors.New("unable to determine the attachment type")
 }
 
+func isLuksEncryptionEnabled(volumeContext map[string]string) (bool, error) {
+	if volumeContext != nil {
+		if enabled, ok := volumeContext[luksEncryption]; ok {
+			return strconv.ParseBool(enabled)
+		}
+	}
+	return false, nil
+}
+
+// resolveLuksDiskPath returns the disk paths of the device backing the LUKS
+// device of the volume when diskPath is that LUKS device. Otherwise diskPath
+// is returned as is.
+func resolveLuksDiskPath(logger *zap.SugaredLogger, volumeID string, diskPath []string) ([]string, bool, error) {
+	if len(diskPath) != 1 || diskPath[0] != disk.LuksMapperPath(volumeID) {
+		return diskPath, false, nil
+	}
+	backingDiskPath, err := disk.GetDiskPathFromLuksDevice(logger, disk.LuksMapperName(volumeID))
+	if err != nil {
+		return nil, true, err
+	}
+	return backingDiskPath, true, nil
+}
+
+// openLuksDevice opens the LUKS device of the volume and returns its path. An
+// empty volume is formatted with LUKS first, a volume holding any other data
+// is never overwritten.
+func openLuksDevice(ctx context.Context, logger *zap.SugaredLogger, req *csi.NodeStageVolumeRequest, mountHandler disk.Interface, devicePath string) (string, error) {
+	passphrase, err := getLuksPassphrase(ctx, logger, req.Secrets, req.VolumeContext)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get the LUKS passphrase.")
+		return "", err
+	}
+
+	readOnly := req.VolumeCapability.GetAccessMode().GetMode() == csi.VolumeCapability_AccessMode_MULTI_NODE_READER_ONLY
+
+	existingFs, err := mountHandler.GetDiskFormat(devicePath)
+	if err != nil {
+		logger.With("devicePath", devicePath, zap.Error(err)).Error("GetDiskFormatFailed")
+		return "", status.Error(codes.Internal, err.Error())
+	}
+	switch existingFs {
+	case disk.LuksFsType:
+	case "":
+		if readOnly {
+			return "", status.Error(codes.FailedPrecondition, "the volume is not LUKS formatted yet and cannot be formatted through a read-only attachment")
+		}
+		if err := disk.LuksFormat(logger, devicePath, passphrase); err != nil {
+			logger.With(zap.Error(err)).Error("failed to format the volume with LUKS.")
+			return "", status.Error(codes.Internal, err.Error())
+		}
+	default:
+		returnError := fmt.Sprintf("the volume already holds %q data and cannot be LUKS formatted", existingFs)
+		logger.Error(returnError)
+		return "", status.Error(codes.FailedPrecondition, returnError)
+	}
+
+	if err := disk.LuksOpen(logger, devicePath, disk.LuksMapperName(req.VolumeId), passphrase, readOnly); err != nil {
+		logger.With(zap.Error(err)).Error("failed to open the LUKS device.")
+		return "", status.Error(codes.Internal, err.Error())
+	}
+	return disk.LuksMapperPath(req.VolumeId), nil
+}
+
+// getLuksPassphrase returns the LUKS passphrase of a volume. It is read from
+// the OCI Vault secret set on the storage class with the instance principal of
+// the node, or else from the node-stage secret.
+func getLuksPassphrase(ctx context.Context, logger *zap.SugaredLogger, secrets map[string]string, volumeContext map[string]string) (string, error) {
+	if secretID := volumeContext[luksVaultSecretID]; secretID != "" {
+		cp, err := auth.InstancePrincipalConfigurationProvider()
+		if err != nil {
+			return "", status.Errorf(codes.Internal, "failed to create instance principal auth provider: %v", err)
+		}
+		rateLimiter := client.NewRateLimiter(logger, nil)
+		secretsClient, err := client.NewSecretsClient(logger, cp, &rateLimiter)
+		if err != nil {
+			return "", status.Errorf(codes.Internal, "failed to create secrets client: %v", err)
+		}
+		passphrase, err := secretsClient.GetSecretContent(ctx, secretID)
+		if err != nil {
+			return "", status.Errorf(codes.Internal, "failed to get the LUKS passphrase from vault secret %s: %v", secretID, err)
+		}
+		if len(passphrase) == 0 {
+			return "", status.Errorf(codes.InvalidArgument, "vault secret %s holding the LUKS passphrase is empty", secretID)
+		}
+		return string(passphrase), nil
+	}
+	passphrase, ok := secrets[luksPassphraseKey]
+	if !ok || passphrase == "" {
+		return "", status.Errorf(codes.InvalidArgument, "a LUKS encrypted volume needs a passphrase in the %q key of the node-stage secret or a %s storage class parameter", luksPassphraseKey, luksVaultSecretID)
+	}
+	return passphrase, nil
+}
+
 // NodeGetCapabilities returns the supported capabilities of the node server
 func (d BlockVolumeNodeDriver) NodeGetCapabilities(ctx context.Context, req *csi.NodeGetCapabilitiesRequest) (*csi.NodeGetCapabilitiesResponse, error) {
 	var nscaps []*csi.NodeServiceCapability
@@ -651,6 +959,9 @@ func (d BlockVolumeNodeDriver) NodeGetInfo(ctx context.Context, req *csi.NodeGet
 	}
 
 	d.logger.With("nodeId", d.nodeID, "availabilityDomain", ad).Info("Availability domain of node identified.")
+
+	maxVolumesPerNode := d.getMaxVolumesPerNode(ctx, consistentDevicePathDir)
+	d.logger.With("nodeId", d.nodeID, "maxVolumesPerNode", maxVolumesPerNode).Info("Volume attachment limit of node identified.")
 	return &csi.NodeGetInfoResponse{
 		NodeId:            d.nodeID,
 		MaxVolumesPerNode: maxVolumesPerNode,
@@ -665,6 +976,101 @@ func (d BlockVolumeNodeDriver) NodeGetInfo(ctx context.Context, req *csi.NodeGet
 	}, nil
 }
 
+// getMaxVolumesPerNode returns how many block volumes the driver can attach
+// to the node. A positive value of the max volumes per node label of the node
+// is used as is. Otherwise the volumes attached to the node by anything but
+// the driver are taken off the attachment limit of the instance shape.
+func (d BlockVolumeNodeDriver) getMaxVolumesPerNode(ctx context.Context, devicePathDir string) int64 {
+	logger := d.logger.With("nodeId", d.nodeID)
+
+	node, err := d.KubeClient.CoreV1().Nodes().Get(ctx, d.nodeID, metav1.GetOptions{})
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("Failed to get node, using the default volume attachment limit.")
+		return defaultMaxVolumeAttachments
+	}
+	if value, ok := node.Labels[maxVolumesPerNodeLabel]; ok {
+		limit, err := strconv.ParseInt(value, 10, 64)
+		if err == nil && limit > 0 {
+			return limit
+		}
+		logger.Warnf("Ignoring invalid value %q of node label %s.", value, maxVolumesPerNodeLabel)
+	}
+
+	shapeLimit := int64(defaultMaxVolumeAttachments)
+	if d.metadata != nil {
+		md, err := d.metadata.Get()
+		if err != nil {
+			logger.With(zap.Error(err)).Warn("Failed to get instance metadata, using the default volume attachment limit of the shape.")
+		} else {
+			shapeLimit = getShapeMaxVolumeAttachments(md.Shape, md.ShapeConfig.Ocpus)
+			logger.With("shape", md.Shape, "ocpus", md.ShapeConfig.Ocpus).Infof("Shape of node attaches up to %d volumes.", shapeLimit)
+		}
+	}
+
+	attached, err := countAttachedVolumes(devicePathDir)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("Failed to count the volumes attached to the node, using the volume attachment limit of the shape.")
+		return shapeLimit
+	}
+	vaList, err := d.KubeClient.StorageV1().VolumeAttachments().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("Failed to list volume attachments, using the volume attachment limit of the shape.")
+		return shapeLimit
+	}
+	attachedByDriver := 0
+	for _, va := range vaList.Items {
+		if va.Spec.Attacher == BlockVolumeDriverName && va.Spec.NodeName == d.nodeID && va.Status.Attached {
+			attachedByDriver++
+		}
+	}
+
+	limit := shapeLimit
+	if attachedElsewhere := attached - attachedByDriver; attachedElsewhere > 0 {
+		logger.Infof("%d volumes are attached to the node outside of the driver.", attachedElsewhere)
+		limit -= int64(attachedElsewhere)
+	}
+	// Zero would leave the limit to the container orchestrator.
+	if limit < 1 {
+		logger.Warn("No consistent device path is left for block volumes on the node.")
+		limit = 1
+	}
+	return limit
+}
+
+// getShapeMaxVolumeAttachments returns how many block volumes an instance of
+// the shape can have attached. Bare metal instances use every consistent
+// device path, VM instances are bounded by their number of OCPUs as well.
+// Unknown shapes get the default.
+func getShapeMaxVolumeAttachments(shape string, ocpus float32) int64 {
+	if !strings.HasPrefix(shape, "VM.") || ocpus <= 0 {
+		return defaultMaxVolumeAttachments
+	}
+	limit := int64(math.Ceil(float64(ocpus) * vmVolumeAttachmentsPerOcpu))
+	if limit > defaultMaxVolumeAttachments {
+		return defaultMaxVolumeAttachments
+	}
+	return limit
+}
+
+// countAttachedVolumes counts the volumes other than the boot volume that
+// have a consistent device path in the directory.
+func countAttachedVolumes(devicePathDir string) (int, error) {
+	entries, err := os.ReadDir(devicePathDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	count := 0
+	for _, entry := range entries {
+		if entry.Name() != bootVolumeDevice && consistentDeviceNamePattern.MatchString(entry.Name()) {
+			count++
+		}
+	}
+	return count, nil
+}
+
 // NodeGetVolumeStats return the stats of the volume
 func (d BlockVolumeNodeDriver) NodeGetVolumeStats(ctx context.Context, req *csi.NodeGetVolumeStatsRequest) (*csi.NodeGetVolumeStatsResponse, error) {
 	logger := d.logger.With("volumeID", req.VolumeId, "volumePath", req.VolumePath)
@@ -691,6 +1097,27 @@ func (d BlockVolumeNodeDriver) NodeGetVolumeStats(ctx context.Context, req *csi.
 		return nil, status.Errorf(codes.NotFound, "path %s does not exist", volumePath)
 	}
 
+	isRawBlockVolume, err := isRawBlockDeviceFile(volumePath)
+	if err != nil {
+		logger.With(zap.Error(err)).Errorf("Failed to determine the volume mode of path %s", volumePath)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if isRawBlockVolume {
+		sizeBytes, err := csi_util.GetBlockSizeBytes(logger, volumePath)
+		if err != nil {
+			logger.With(zap.Error(err)).Errorf("failed to get size of raw block volume on path %s: %v", volumePath, err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return &csi.NodeGetVolumeStatsResponse{
+			Usage: []*csi.VolumeUsage{
+				{
+					Unit:  csi.VolumeUsage_BYTES,
+					Total: sizeBytes,
+				},
+			},
+		}, nil
+	}
+
 	metricsProvider := volume.NewMetricsStatFS(volumePath)
 	metrics, err := metricsProvider.GetMetrics()
 	if err != nil {
@@ -744,7 +1171,13 @@ func (d BlockVolumeNodeDriver) NodeExpandVolume(ctx context.Context, req *csi.No
 		return nil, status.Errorf(codes.OutOfRange, "invalid capacity range: %v", err)
 	}
 
-	diskPath, err := disk.GetDiskPathFromMountPath(d.logger, volumePath)
+	isRawBlockVolume, err := isRawBlockDeviceFile(volumePath)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("unable to determine the volume mode")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	diskPath, err := getDiskPathFromVolumePath(d.logger, volumePath, isRawBlockVolume)
 	if err != nil {
 		// do a clean exit in case of mount point not found
 		if err == disk.ErrMountPointNotFound {
@@ -755,6 +1188,12 @@ func (d BlockVolumeNodeDriver) NodeExpandVolume(ctx context.Context, req *csi.No
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	diskPath, isLuksDevice, err := resolveLuksDiskPath(logger, volumeID, diskPath)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("unable to get the device backing the LUKS device")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	attachmentType, devicePath, err := getDevicePathAndAttachmentType(diskPath)
 	if err != nil {
 		logger.With(zap.Error(err)).With("diskPath", diskPath).Error("unable to determine the attachment type")
@@ -786,8 +1225,20 @@ func (d BlockVolumeNodeDriver) NodeExpandVolume(ctx context.Context, req *csi.No
 	}
 	logger.With("devicePath", devicePath).Debug("Rescan completed")
 
-	if _, err := mountHandler.Resize(devicePath, volumePath); err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to resize volume %q (%q):  %v", volumeID, devicePath, err)
+	// The LUKS device is grown online to the new size of the volume before
+	// the filesystem on it.
+	fsDevicePath := devicePath
+	if isLuksDevice {
+		if err := disk.LuksResize(logger, disk.LuksMapperName(volumeID)); err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to resize LUKS device of volume %q:  %v", volumeID, err)
+		}
+		fsDevicePath = disk.LuksMapperPath(volumeID)
+	}
+
+	if !isRawBlockVolume {
+		if _, err := mountHandler.Resize(fsDevicePath, volumePath); err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to resize volume %q (%q):  %v", volumeID, fsDevicePath, err)
+		}
 	}
 
 	allocatedSizeBytes, err := csi_util.GetBlockSizeBytes(logger, devicePath)
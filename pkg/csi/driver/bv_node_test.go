@@ -15,7 +15,18 @@
 package driver
 
 import (
+	"context"
+	"os"
+	"path/filepath"
 	"testing"
+
+	"go.uber.org/zap"
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/instance/metadata"
 )
 
 func Test_getDevicePathAndAttachmentType(t *testing.T) {
@@ -81,3 +92,240 @@ func Test_getDevicePathAndAttachmentType(t *testing.T) {
 		})
 	}
 }
+
+func Test_isRawBlockDeviceFile(t *testing.T) {
+	dir := t.TempDir()
+	deviceFile := getRawBlockStagingDevicePath(dir, "ocid1.volume.oc1.phx.abc")
+	if err := createRawBlockDeviceFile(deviceFile); err != nil {
+		t.Fatalf("createRawBlockDeviceFile() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		want    bool
+		wantErr bool
+	}{
+		{
+			"Testing raw block device file",
+			deviceFile,
+			true,
+			false,
+		},
+		{
+			"Testing filesystem mount directory",
+			dir,
+			false,
+			false,
+		},
+		{
+			"Testing missing path",
+			filepath.Join(dir, "missing"),
+			false,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := isRawBlockDeviceFile(tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("isRawBlockDeviceFile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("isRawBlockDeviceFile() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_getRawBlockStagingDevicePath(t *testing.T) {
+	stagingPath := "/var/lib/kubelet/plugins/kubernetes.io/csi/volumeDevices/staging/pv-1"
+	got := getRawBlockStagingDevicePath(stagingPath, "ocid1.volume.oc1.phx.abc")
+	want := stagingPath + "/ocid1.volume.oc1.phx.abc"
+	if got != want {
+		t.Errorf("getRawBlockStagingDevicePath() got = %v, want %v", got, want)
+	}
+}
+
+func Test_countAttachedVolumes(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"oraclevda", "oraclevda1", "oraclevdb", "oraclevdb1", "oraclevdac"} {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0644); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+	}
+
+	got, err := countAttachedVolumes(dir)
+	if err != nil {
+		t.Fatalf("countAttachedVolumes() error = %v", err)
+	}
+	if got != 2 {
+		t.Errorf("countAttachedVolumes() got = %v, want %v", got, 2)
+	}
+
+	got, err = countAttachedVolumes(filepath.Join(dir, "missing"))
+	if err != nil || got != 0 {
+		t.Errorf("countAttachedVolumes() of missing directory got = %v, %v, want 0, nil", got, err)
+	}
+}
+
+func Test_getMaxVolumesPerNode(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"oraclevda", "oraclevdb", "oraclevdc", "oraclevdd"} {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0644); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+	}
+	attachment := func(name, attacher, nodeName string) *storagev1.VolumeAttachment {
+		return &storagev1.VolumeAttachment{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       storagev1.VolumeAttachmentSpec{Attacher: attacher, NodeName: nodeName},
+			Status:     storagev1.VolumeAttachmentStatus{Attached: true},
+		}
+	}
+
+	tests := map[string]struct {
+		labels      map[string]string
+		attachments []*storagev1.VolumeAttachment
+		metadata    metadata.Interface
+		want        int64
+	}{
+		"no attachments by the driver": {
+			want: defaultMaxVolumeAttachments - 3,
+		},
+		"shape limit": {
+			metadata: metadata.NewMock(&metadata.InstanceMetadata{Shape: "VM.Standard.E4.Flex", ShapeConfig: metadata.ShapeConfig{Ocpus: 1}}),
+			want:     16 - 3,
+		},
+		"shape limit without instance metadata": {
+			metadata: metadata.NewErrorMock(),
+			want:     defaultMaxVolumeAttachments - 3,
+		},
+		"attachments by the driver": {
+			attachments: []*storagev1.VolumeAttachment{
+				attachment("va-1", BlockVolumeDriverName, "node-1"),
+				attachment("va-2", BlockVolumeDriverName, "node-1"),
+				attachment("va-3", BlockVolumeDriverName, "node-2"),
+				attachment("va-4", FSSDriverName, "node-1"),
+			},
+			want: defaultMaxVolumeAttachments - 1,
+		},
+		"label override": {
+			labels: map[string]string{maxVolumesPerNodeLabel: "8"},
+			want:   8,
+		},
+		"invalid label override": {
+			labels:      map[string]string{maxVolumesPerNodeLabel: "0"},
+			attachments: []*storagev1.VolumeAttachment{attachment("va-1", BlockVolumeDriverName, "node-1")},
+			want:        defaultMaxVolumeAttachments - 2,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			kubeClient := fake.NewSimpleClientset(&v1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: tt.labels},
+			})
+			for _, va := range tt.attachments {
+				if _, err := kubeClient.StorageV1().VolumeAttachments().Create(context.Background(), va, metav1.CreateOptions{}); err != nil {
+					t.Fatalf("Create() error = %v", err)
+				}
+			}
+			d := BlockVolumeNodeDriver{NodeDriver{nodeID: "node-1", KubeClient: kubeClient, logger: zap.S(), metadata: tt.metadata}}
+			if got := d.getMaxVolumesPerNode(context.Background(), dir); got != tt.want {
+				t.Errorf("getMaxVolumesPerNode() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_getShapeMaxVolumeAttachments(t *testing.T) {
+	tests := map[string]struct {
+		shape string
+		ocpus float32
+		want  int64
+	}{
+		"bare metal shape": {
+			shape: "BM.Standard.E4.128",
+			ocpus: 128,
+			want:  32,
+		},
+		"VM shape with one OCPU": {
+			shape: "VM.Standard.E2.1",
+			ocpus: 1,
+			want:  16,
+		},
+		"VM shape with a fractional OCPU": {
+			shape: "VM.Standard.E4.Flex",
+			ocpus: 1.5,
+			want:  24,
+		},
+		"VM shape with many OCPUs": {
+			shape: "VM.Standard.A1.Flex",
+			ocpus: 4,
+			want:  32,
+		},
+		"VM shape without OCPUs": {
+			shape: "VM.Standard2.1",
+			want:  32,
+		},
+		"unknown shape": {
+			shape: "",
+			ocpus: 1,
+			want:  32,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := getShapeMaxVolumeAttachments(tt.shape, tt.ocpus); got != tt.want {
+				t.Errorf("getShapeMaxVolumeAttachments() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_getLuksPassphrase(t *testing.T) {
+	tests := map[string]struct {
+		secrets       map[string]string
+		volumeContext map[string]string
+		want          string
+		wantErr       bool
+	}{
+		"passphrase from node-stage secret": {
+			secrets:       map[string]string{luksPassphraseKey: "passphrase"},
+			volumeContext: map[string]string{luksEncryption: "true"},
+			want:          "passphrase",
+		},
+		"no node-stage secret": {
+			volumeContext: map[string]string{luksEncryption: "true"},
+			wantErr:       true,
+		},
+		"empty passphrase": {
+			secrets:       map[string]string{luksPassphraseKey: ""},
+			volumeContext: map[string]string{luksEncryption: "true"},
+			wantErr:       true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := getLuksPassphrase(context.Background(), zap.S(), tt.secrets, tt.volumeContext)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getLuksPassphrase() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("getLuksPassphrase() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_resolveLuksDiskPath(t *testing.T) {
+	diskPath := []string{"/dev/mapper/mpatha"}
+	got, isLuksDevice, err := resolveLuksDiskPath(zap.S(), "ocid1.volume.oc1.phx.abc", diskPath)
+	if err != nil || isLuksDevice {
+		t.Fatalf("resolveLuksDiskPath() got = %v, %v, want false, nil", isLuksDevice, err)
+	}
+	if len(got) != 1 || got[0] != diskPath[0] {
+		t.Errorf("resolveLuksDiskPath() got = %v, want %v", got, diskPath)
+	}
+}
@@ -104,6 +104,7 @@ type NodeDriver struct {
 	logger      *zap.SugaredLogger
 	util        *csi_util.Util
 	volumeLocks *csi_util.VolumeLocks
+	metadata    metadata.Interface
 }
 
 // BlockVolumeNodeDriver extends NodeDriver
@@ -145,6 +146,7 @@ func newNodeDriver(nodeID string, kubeClientSet kubernetes.Interface, logger *za
 		logger:      logger,
 		util:        &csi_util.Util{Logger: logger},
 		volumeLocks: csi_util.NewVolumeLocks(),
+		metadata:    metadata.New(),
 	}
 }
 
@@ -303,6 +305,9 @@ func (d *Driver) Run() error {
 	csi.RegisterIdentityServer(d.srv, d)
 	if d.enableControllerServer {
 		csi.RegisterControllerServer(d.srv, d.GetControllerDriver())
+		if groupControllerDriver, ok := d.GetControllerDriver().(csi.GroupControllerServer); ok {
+			csi.RegisterGroupControllerServer(d.srv, groupControllerDriver)
+		}
 	} else {
 		csi.RegisterNodeServer(d.srv, d.GetNodeDriver())
 	}
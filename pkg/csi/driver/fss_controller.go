@@ -18,6 +18,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/container-storage-interface/spec/lib/go/csi"
@@ -26,11 +28,25 @@ import (
 	"github.com/oracle/oci-cloud-controller-manager/pkg/metrics"
 	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
 	"github.com/oracle/oci-cloud-controller-manager/pkg/util"
+	"github.com/oracle/oci-go-sdk/v65/common"
 	fss "github.com/oracle/oci-go-sdk/v65/filestorage"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// fssSnapshotSourceVolumeTag records the volume handle a snapshot was taken
+	// from, since an OCI snapshot only references its file system.
+	fssSnapshotSourceVolumeTag = "sourceVolumeId"
+	// fssCloneSnapshotTag marks the snapshot taken of a source volume to clone it
+	// with the name of the clone, so it is not reported as a CSI snapshot.
+	fssCloneSnapshotTag = "cloneVolumeName"
+	// fssCloneSourceSnapshotTag records on a cloned file system the snapshot it was
+	// cloned from, which is deleted together with the clone.
+	fssCloneSourceSnapshotTag = "cloneSourceSnapshotId"
 )
 
 var (
@@ -71,6 +87,8 @@ type StorageClassParameters struct {
 	mountTargetSubnetOcid string
 	// encryptInTransit if enabled, it will be passed in the volume context
 	encryptInTransit string
+	// enforceQuota if enabled, the requested capacity is enforced as a hard quota on the file system
+	enforceQuota bool
 	// tags
 	scTags *config.TagConfig
 }
@@ -105,6 +123,46 @@ func (d *FSSControllerDriver) CreateVolume(ctx context.Context, req *csi.CreateV
 		return response, err
 	}
 
+	sourceSnapshotOcid := ""
+	if snapshot := req.GetVolumeContentSource().GetSnapshot(); snapshot != nil {
+		sourceSnapshotOcid = snapshot.GetSnapshotId()
+		log = log.With("sourceSnapshotOcid", sourceSnapshotOcid)
+		if err := d.validateSourceSnapshot(ctx, sourceSnapshotOcid, storageClassParameters.availabilityDomain, log); err != nil {
+			dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+			metrics.SendMetricData(d.metricPusher, metrics.FSSSnapshotRestore, time.Since(startTime).Seconds(), dimensionsMap)
+			metrics.SendMetricData(d.metricPusher, metrics.FssAllProvision, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, err
+		}
+	}
+
+	cloneSnapshotOcid := ""
+	if sourceVolume := req.GetVolumeContentSource().GetVolume(); sourceVolume != nil {
+		log = log.With("sourceVolumeId", sourceVolume.GetVolumeId())
+		cloneSnapshotOcid, err = d.getOrCreateCloneSnapshot(ctx, sourceVolume.GetVolumeId(), volumeName, storageClassParameters.availabilityDomain, log)
+		if err != nil {
+			dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+			metrics.SendMetricData(d.metricPusher, metrics.PVClone, time.Since(startTime).Seconds(), dimensionsMap)
+			metrics.SendMetricData(d.metricPusher, metrics.FssAllProvision, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, err
+		}
+		sourceSnapshotOcid = cloneSnapshotOcid
+		log = log.With("cloneSnapshotOcid", cloneSnapshotOcid)
+	}
+
+	var quotaBytes int64
+	if storageClassParameters.enforceQuota {
+		quotaBytes = getRequestedCapacityBytes(req.GetCapacityRange())
+		if quotaBytes <= 0 {
+			log.Error("Capacity must be requested when enforceQuota is enabled in storage class")
+			dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+			metrics.SendMetricData(d.metricPusher, metrics.FssAllProvision, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, status.Error(codes.InvalidArgument, "CapacityRange must be provided when enforceQuota is enabled in storage class")
+		}
+		// quota rules are set in whole gigabytes
+		quotaBytes = csi_util.RoundUpSize(quotaBytes, client.GiB) * client.GiB
+		log = log.With("quotaBytes", quotaBytes)
+	}
+
 	log, mountTargetOCID, mountTargetIp, exportSetId, response, err, done := d.getOrCreateMountTarget(ctx, *storageClassParameters, volumeName, log, dimensionsMap)
 	if done {
 		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
@@ -126,14 +184,27 @@ func (d *FSSControllerDriver) CreateVolume(ctx context.Context, req *csi.CreateV
 	freeformTags["isDeleteMountTarget"] = isDeleteMountTarget
 	freeformTags["mountTargetOCID"] = mountTargetOCID
 	freeformTags["exportSetId"] = exportSetId
+	if cloneSnapshotOcid != "" {
+		freeformTags[fssCloneSourceSnapshotTag] = cloneSnapshotOcid
+	}
 
-	log, filesystemOCID, response, err, done := d.getOrCreateFileSystem(ctx, *storageClassParameters, volumeName, log, dimensionsMap)
+	log, filesystemOCID, response, err, done := d.getOrCreateFileSystem(ctx, *storageClassParameters, volumeName, sourceSnapshotOcid, log, dimensionsMap)
 	if done {
 		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
 		metrics.SendMetricData(d.metricPusher, metrics.FssAllProvision, time.Since(startTime).Seconds(), dimensionsMap)
 		return response, err
 	}
 
+	if quotaBytes > 0 {
+		if err = d.ensureFssQuota(ctx, filesystemOCID, quotaBytes, log); err != nil {
+			log.With("service", "fss", "verb", "create", "resource", "quotaRule", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Failed to enforce the quota of the file system.")
+			dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+			metrics.SendMetricData(d.metricPusher, metrics.FssAllProvision, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, status.Errorf(codes.Internal, "failed to enforce quota on file system %s: %v", filesystemOCID, err)
+		}
+	}
+
 	log, response, err, done = d.getOrCreateExport(ctx, err, *storageClassParameters, filesystemOCID, exportSetId, log, dimensionsMap)
 	if done {
 		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
@@ -147,18 +218,120 @@ func (d *FSSControllerDriver) CreateVolume(ctx context.Context, req *csi.CreateV
 	dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
 	dimensionsMap[metrics.ResourceOCIDDimension] = fssVolumeHandle
 	metrics.SendMetricData(d.metricPusher, metrics.FssAllProvision, time.Since(startTime).Seconds(), dimensionsMap)
+	if cloneSnapshotOcid != "" {
+		metrics.SendMetricData(d.metricPusher, metrics.PVClone, time.Since(startTime).Seconds(), dimensionsMap)
+	} else if sourceSnapshotOcid != "" {
+		metrics.SendMetricData(d.metricPusher, metrics.FSSSnapshotRestore, time.Since(startTime).Seconds(), dimensionsMap)
+	}
 	return &csi.CreateVolumeResponse{
 		Volume: &csi.Volume{
 			VolumeId:      fssVolumeHandle,
-			CapacityBytes: 0,
+			CapacityBytes: quotaBytes,
 
 			VolumeContext: map[string]string{
 				"encryptInTransit": storageClassParameters.encryptInTransit,
 			},
+			ContentSource: req.GetVolumeContentSource(),
 		},
 	}, nil
 }
 
+// validateSourceSnapshot checks that the snapshot a volume is restored from is
+// ACTIVE and belongs to a file system in the availability domain the clone is
+// created in, as OCI only supports cloning within the same availability domain.
+func (d *FSSControllerDriver) validateSourceSnapshot(ctx context.Context, snapshotOcid, availabilityDomain string, log *zap.SugaredLogger) error {
+	snapshot, err := d.client.FSS().GetSnapshot(ctx, snapshotOcid)
+	if err != nil {
+		if client.IsNotFound(err) {
+			log.With(zap.Error(err)).Error("Source snapshot not found.")
+			return status.Errorf(codes.NotFound, "failed to get snapshot %s: %v", snapshotOcid, err)
+		}
+		log.With("service", "fss", "verb", "get", "resource", "snapshot", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to get source snapshot.")
+		return status.Errorf(codes.Internal, "failed to get snapshot %s: %v", snapshotOcid, err)
+	}
+	if snapshot.LifecycleState != fss.SnapshotLifecycleStateActive {
+		log.Errorf("Source snapshot is in %s state", snapshot.LifecycleState)
+		return status.Errorf(codes.Unavailable, "snapshot %s is in %s state, not ACTIVE", snapshotOcid, snapshot.LifecycleState)
+	}
+
+	sourceFileSystem, err := d.client.FSS().GetFileSystem(ctx, *snapshot.FileSystemId)
+	if err != nil {
+		log.With("service", "fss", "verb", "get", "resource", "fileSystem", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to get file system of source snapshot.")
+		return status.Errorf(codes.Internal, "failed to get file system %s of snapshot %s: %v", *snapshot.FileSystemId, snapshotOcid, err)
+	}
+	if sourceFileSystem.AvailabilityDomain != nil && *sourceFileSystem.AvailabilityDomain != availabilityDomain {
+		log.Errorf("Source snapshot is in availability domain %s", *sourceFileSystem.AvailabilityDomain)
+		return status.Errorf(codes.InvalidArgument, "snapshot %s is in availability domain %s, cannot restore to %s",
+			snapshotOcid, *sourceFileSystem.AvailabilityDomain, availabilityDomain)
+	}
+	return nil
+}
+
+// getOrCreateCloneSnapshot returns the snapshot of the source volume that the clone named
+// volumeName is created from, taking it on the first call. OCI clones file systems from
+// snapshots only, and only within the availability domain of the source file system.
+func (d *FSSControllerDriver) getOrCreateCloneSnapshot(ctx context.Context, sourceVolumeId, volumeName, availabilityDomain string, log *zap.SugaredLogger) (string, error) {
+	filesystemOcid := csi_util.ValidateFssId(sourceVolumeId).FilesystemOcid
+	if filesystemOcid == "" {
+		log.Error("Unable to parse source Volume Id")
+		return "", status.Errorf(codes.InvalidArgument, "Invalid source Volume ID provided %s", sourceVolumeId)
+	}
+
+	sourceFileSystem, err := d.client.FSS().GetFileSystem(ctx, filesystemOcid)
+	if err != nil {
+		if client.IsNotFound(err) {
+			log.With(zap.Error(err)).Error("Source file system not found.")
+			return "", status.Errorf(codes.NotFound, "source volume %s not found", sourceVolumeId)
+		}
+		log.With("service", "fss", "verb", "get", "resource", "fileSystem", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to get source file system.")
+		return "", status.Errorf(codes.Internal, "failed to get file system %s of source volume: %v", filesystemOcid, err)
+	}
+	if sourceFileSystem.AvailabilityDomain != nil && *sourceFileSystem.AvailabilityDomain != availabilityDomain {
+		log.Errorf("Source file system is in availability domain %s", *sourceFileSystem.AvailabilityDomain)
+		return "", status.Errorf(codes.InvalidArgument, "source volume %s is in availability domain %s, cannot clone to %s",
+			sourceVolumeId, *sourceFileSystem.AvailabilityDomain, availabilityDomain)
+	}
+
+	snapshots, err := d.client.FSS().GetSnapshotsByName(ctx, filesystemOcid, volumeName)
+	if err != nil {
+		log.With("service", "fss", "verb", "list", "resource", "snapshot", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to check the existence of the clone snapshot.")
+		return "", status.Errorf(codes.Internal, "failed to check existence of snapshot of source volume %s: %v", sourceVolumeId, err)
+	}
+	if len(snapshots) > 1 {
+		log.Errorf("Duplicate clone snapshot %q exists", volumeName)
+		return "", status.Errorf(codes.Internal, "duplicate snapshot %q of source volume %s exists", volumeName, sourceVolumeId)
+	}
+
+	var snapshotId, lifecycleState string
+	if len(snapshots) > 0 {
+		snapshotId, lifecycleState = *snapshots[0].Id, string(snapshots[0].LifecycleState)
+		log.With("cloneSnapshotOcid", snapshotId).Infof("Clone snapshot already created, lifecycleState is %s", lifecycleState)
+	} else {
+		log.Info("Creating snapshot of source file system to clone")
+		snapshot, err := d.client.FSS().CreateSnapshot(ctx, fss.CreateSnapshotDetails{
+			FileSystemId: &filesystemOcid,
+			Name:         &volumeName,
+			FreeformTags: map[string]string{fssCloneSnapshotTag: volumeName},
+		})
+		if err != nil {
+			log.With("service", "fss", "verb", "create", "resource", "snapshot", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Could not create clone snapshot.")
+			return "", status.Errorf(codes.Internal, "failed to create snapshot of source volume %s: %v", sourceVolumeId, err)
+		}
+		snapshotId, lifecycleState = *snapshot.Id, string(snapshot.LifecycleState)
+	}
+
+	if lifecycleState != string(fss.SnapshotLifecycleStateActive) {
+		log.With("cloneSnapshotOcid", snapshotId).Infof("Clone snapshot is in %s state, provisioner will retry", lifecycleState)
+		return "", status.Errorf(codes.Unavailable, "snapshot %s of source volume %s is in %s state, not ACTIVE", snapshotId, sourceVolumeId, lifecycleState)
+	}
+	return snapshotId, nil
+}
+
 func checkForSupportedVolumeCapabilities(volumeCaps []*csi.VolumeCapability) error {
 	hasSupport := func(cap *csi.VolumeCapability) error {
 		if blk := cap.GetBlock(); blk != nil {
@@ -180,7 +353,7 @@ func checkForSupportedVolumeCapabilities(volumeCaps []*csi.VolumeCapability) err
 	return nil
 }
 
-func (d *FSSControllerDriver) getOrCreateFileSystem(ctx context.Context, storageClassParameters StorageClassParameters, volumeName string, log *zap.SugaredLogger, dimensionsMap map[string]string) (*zap.SugaredLogger, string, *csi.CreateVolumeResponse, error, bool) {
+func (d *FSSControllerDriver) getOrCreateFileSystem(ctx context.Context, storageClassParameters StorageClassParameters, volumeName, sourceSnapshotOcid string, log *zap.SugaredLogger, dimensionsMap map[string]string) (*zap.SugaredLogger, string, *csi.CreateVolumeResponse, error, bool) {
 	startTimeFileSystem := time.Now()
 	//make sure this method is idempotent by checking existence of volume with same name.
 	log.Info("searching for existing filesystem")
@@ -220,7 +393,7 @@ func (d *FSSControllerDriver) getOrCreateFileSystem(ctx context.Context, storage
 
 	} else {
 		// Creating new file system
-		provisionedFileSystem, err = provisionFileSystem(ctx, log, d.client, volumeName, storageClassParameters)
+		provisionedFileSystem, err = provisionFileSystem(ctx, log, d.client, volumeName, sourceSnapshotOcid, storageClassParameters)
 		if err != nil {
 			log.With("service", "fss", "verb", "create", "resource", "fileSystem", "statusCode", util.GetHttpStatusCode(err)).
 				With(zap.Error(err)).Error("New File System creation failed")
@@ -515,6 +688,18 @@ func extractStorageClassParameters(ctx context.Context, d *FSSControllerDriver,
 		storageClassParameters.encryptInTransit = "true"
 	}
 
+	enforceQuota, ok := parameters["enforceQuota"]
+	if ok {
+		enabled, err := strconv.ParseBool(enforceQuota)
+		if err != nil {
+			log.Errorf("invalid enforceQuota %q provided in storage class", enforceQuota)
+			dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+			metrics.SendMetricData(d.metricPusher, metrics.FssAllProvision, time.Since(startTime).Seconds(), dimensionsMap)
+			return log, nil, nil, status.Errorf(codes.InvalidArgument, "invalid enforceQuota: %s provided for storage class. supported values are true and false", enforceQuota), true
+		}
+		storageClassParameters.enforceQuota = enabled
+	}
+
 	kmsKey, ok := parameters["kmsKeyOcid"]
 	if !ok {
 		log.Info("kmsKeyOcid not provided, using oracle managed keys")
@@ -572,7 +757,7 @@ func extractStorageClassParameters(ctx context.Context, d *FSSControllerDriver,
 	return log, nil, storageClassParameters, nil, false
 }
 
-func provisionFileSystem(ctx context.Context, log *zap.SugaredLogger, c client.Interface, volumeName string, storageClassParameters StorageClassParameters) (*fss.FileSystem, error) {
+func provisionFileSystem(ctx context.Context, log *zap.SugaredLogger, c client.Interface, volumeName, sourceSnapshotOcid string, storageClassParameters StorageClassParameters) (*fss.FileSystem, error) {
 	log.Info("Creating new File System")
 	createFileSystemDetails := fss.CreateFileSystemDetails{
 		AvailabilityDomain: &storageClassParameters.availabilityDomain,
@@ -584,6 +769,9 @@ func provisionFileSystem(ctx context.Context, log *zap.SugaredLogger, c client.I
 	if storageClassParameters.kmsKey != "" {
 		createFileSystemDetails.KmsKeyId = &storageClassParameters.kmsKey
 	}
+	if sourceSnapshotOcid != "" {
+		createFileSystemDetails.SourceSnapshotId = &sourceSnapshotOcid
+	}
 	return c.FSS().CreateFileSystem(ctx, createFileSystemDetails)
 }
 
@@ -654,6 +842,7 @@ func (d *FSSControllerDriver) DeleteVolume(ctx context.Context, req *csi.DeleteV
 
 	mountTargetOCID := ""
 	exportSetId := ""
+	cloneSnapshotOcid := ""
 	isDeleteMountTarget := false
 
 	if freeformTags != nil {
@@ -667,6 +856,8 @@ func (d *FSSControllerDriver) DeleteVolume(ctx context.Context, req *csi.DeleteV
 				}
 			case "exportSetId":
 				exportSetId = freeformTags["exportSetId"]
+			case fssCloneSourceSnapshotTag:
+				cloneSnapshotOcid = v
 			}
 		}
 	}
@@ -755,6 +946,18 @@ func (d *FSSControllerDriver) DeleteVolume(ctx context.Context, req *csi.DeleteV
 		metrics.SendMetricData(d.metricPusher, metrics.FSSDelete, time.Since(startTimeFileSystem).Seconds(), dimensionsMap)
 		metrics.SendMetricData(d.metricPusher, metrics.FssAllDelete, time.Since(startTime).Seconds(), dimensionsMap)
 	}
+
+	if cloneSnapshotOcid != "" {
+		log = log.With("cloneSnapshotOcid", cloneSnapshotOcid)
+		log.Info("file system was cloned from a snapshot of another volume, deleting the snapshot")
+		err = d.client.FSS().DeleteSnapshot(ctx, cloneSnapshotOcid)
+		if err != nil && !client.IsNotFound(err) {
+			log.With("service", "fss", "verb", "delete", "resource", "snapshot", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Failed to delete clone snapshot.")
+			return nil, status.Errorf(codes.Internal, "failed to delete snapshot %s the volume was cloned from, error: %s", cloneSnapshotOcid, err.Error())
+		}
+		log.Info("Clone snapshot is deleted.")
+	}
 	return &csi.DeleteVolumeResponse{}, nil
 }
 
@@ -771,6 +974,12 @@ func (d *FSSControllerDriver) ControllerGetCapabilities(ctx context.Context, req
 	var caps []*csi.ControllerServiceCapability
 	for _, capability := range []csi.ControllerServiceCapability_RPC_Type{
 		csi.ControllerServiceCapability_RPC_CREATE_DELETE_VOLUME,
+		csi.ControllerServiceCapability_RPC_CREATE_DELETE_SNAPSHOT,
+		csi.ControllerServiceCapability_RPC_LIST_SNAPSHOTS,
+		csi.ControllerServiceCapability_RPC_GET_VOLUME,
+		csi.ControllerServiceCapability_RPC_VOLUME_CONDITION,
+		csi.ControllerServiceCapability_RPC_EXPAND_VOLUME,
+		csi.ControllerServiceCapability_RPC_CLONE_VOLUME,
 	} {
 		caps = append(caps, newCap(capability))
 	}
@@ -915,22 +1124,446 @@ func (d *FSSControllerDriver) GetCapacity(ctx context.Context, request *csi.GetC
 	return nil, status.Error(codes.Unimplemented, "")
 }
 
-func (d *FSSControllerDriver) CreateSnapshot(ctx context.Context, request *csi.CreateSnapshotRequest) (*csi.CreateSnapshotResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "")
+// CreateSnapshot creates an OCI File Storage snapshot of the file system behind
+// the source volume. The function is idempotent.
+func (d *FSSControllerDriver) CreateSnapshot(ctx context.Context, req *csi.CreateSnapshotRequest) (*csi.CreateSnapshotResponse, error) {
+	startTime := time.Now()
+	dimensionsMap := make(map[string]string)
+	dimensionsMap[metrics.ResourceOCIDDimension] = req.Name
+	log := d.logger.With("snapshotName", req.Name, "sourceVolumeId", req.SourceVolumeId, "csiOperation", "createSnapshot")
+
+	if req.Name == "" {
+		log.Error("Volume Snapshot name must be provided.")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.FSSSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Error(codes.InvalidArgument, "Volume snapshot name must be provided")
+	}
+
+	sourceVolumeId := req.SourceVolumeId
+	filesystemOcid := csi_util.ValidateFssId(sourceVolumeId).FilesystemOcid
+	if filesystemOcid == "" {
+		log.Error("Unable to parse source Volume Id")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.FSSSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid source Volume ID provided %s", sourceVolumeId)
+	}
+	log = log.With("fssID", filesystemOcid)
+
+	snapshots, err := d.client.FSS().GetSnapshotsByName(ctx, filesystemOcid, req.Name)
+	if err != nil {
+		log.With("service", "fss", "verb", "list", "resource", "snapshot", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to check the existence of the snapshot.")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.FSSSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.Internal, "failed to check existence of snapshot %v", err)
+	}
+
+	if len(snapshots) > 1 {
+		log.Errorf("Duplicate snapshot %q exists", req.Name)
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.FSSSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, fmt.Errorf("duplicate snapshot %q exists", req.Name)
+	}
+
+	if len(snapshots) > 0 {
+		snapshot := snapshots[0]
+		log = log.With("snapshotId", *snapshot.Id)
+		log.Infof("Snapshot already created, lifecycleState is %s", snapshot.LifecycleState)
+		readyToUse := snapshot.LifecycleState == fss.SnapshotSummaryLifecycleStateActive
+		if readyToUse {
+			dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.Success, util.CSIStorageType)
+		} else {
+			dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.BackupCreating, util.CSIStorageType)
+		}
+		dimensionsMap[metrics.ResourceOCIDDimension] = *snapshot.Id
+		metrics.SendMetricData(d.metricPusher, metrics.FSSSnapshotProvision, time.Since(snapshot.TimeCreated.Time).Seconds(), dimensionsMap)
+		return &csi.CreateSnapshotResponse{
+			Snapshot: getFssCsiSnapshot(*snapshot.Id, sourceVolumeId, snapshot.TimeCreated, readyToUse),
+		}, nil
+	}
+
+	snapshotParams, err := extractSnapshotParameters(req.GetParameters())
+	if err != nil {
+		log.With(zap.Error(err)).Error("Failed to parse volumesnapshotclass parameters.")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.FSSSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse volumesnapshotclass parameters %v", err)
+	}
+
+	freeformTags := make(map[string]string)
+	for k, v := range snapshotParams.freeformTags {
+		freeformTags[k] = v
+	}
+	freeformTags[fssSnapshotSourceVolumeTag] = sourceVolumeId
+
+	snapshot, err := d.client.FSS().CreateSnapshot(ctx, fss.CreateSnapshotDetails{
+		FileSystemId: &filesystemOcid,
+		Name:         &req.Name,
+		FreeformTags: freeformTags,
+		DefinedTags:  snapshotParams.definedTags,
+	})
+	if err != nil {
+		log.With("service", "fss", "verb", "create", "resource", "snapshot", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Could not create snapshot.")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.FSSSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.Internal, "Could not create snapshot %q: %v", req.Name, err)
+	}
+
+	log = log.With("snapshotId", *snapshot.Id)
+	readyToUse := snapshot.LifecycleState == fss.SnapshotLifecycleStateActive
+	if readyToUse {
+		log.Info("Snapshot is created and active.")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.Success, util.CSIStorageType)
+	} else {
+		log.Info("Snapshot is not active yet, controller will retry")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.BackupCreating, util.CSIStorageType)
+	}
+	dimensionsMap[metrics.ResourceOCIDDimension] = *snapshot.Id
+	metrics.SendMetricData(d.metricPusher, metrics.FSSSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+	return &csi.CreateSnapshotResponse{
+		Snapshot: getFssCsiSnapshot(*snapshot.Id, sourceVolumeId, snapshot.TimeCreated, readyToUse),
+	}, nil
 }
 
-func (d *FSSControllerDriver) DeleteSnapshot(ctx context.Context, request *csi.DeleteSnapshotRequest) (*csi.DeleteSnapshotResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "")
+// DeleteSnapshot deletes the OCI File Storage snapshot. Deleting a snapshot
+// that no longer exists is not an error.
+func (d *FSSControllerDriver) DeleteSnapshot(ctx context.Context, req *csi.DeleteSnapshotRequest) (*csi.DeleteSnapshotResponse, error) {
+	startTime := time.Now()
+	dimensionsMap := make(map[string]string)
+	dimensionsMap[metrics.ResourceOCIDDimension] = req.SnapshotId
+	log := d.logger.With("snapshotId", req.SnapshotId, "csiOperation", "deleteSnapshot")
+
+	if req.SnapshotId == "" {
+		log.Error("SnapshotId is empty")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.FSSSnapshotDelete, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Error(codes.InvalidArgument, "SnapshotId must be provided")
+	}
+
+	err := d.client.FSS().DeleteSnapshot(ctx, req.SnapshotId)
+	if err != nil && !client.IsNotFound(err) {
+		log.With("service", "fss", "verb", "delete", "resource", "snapshot", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to delete snapshot.")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.FSSSnapshotDelete, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.Internal, "failed to delete snapshot, snapshotId: %s, error: %v", req.SnapshotId, err)
+	}
+
+	log.Info("Snapshot is deleted.")
+	dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.Success, util.CSIStorageType)
+	metrics.SendMetricData(d.metricPusher, metrics.FSSSnapshotDelete, time.Since(startTime).Seconds(), dimensionsMap)
+	return &csi.DeleteSnapshotResponse{}, nil
 }
 
-func (d *FSSControllerDriver) ListSnapshots(ctx context.Context, request *csi.ListSnapshotsRequest) (*csi.ListSnapshotsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "")
+// ListSnapshots returns the snapshots matching the request. Snapshots that were
+// not created through the driver are only listed when filtering by source
+// volume, as there is no volume handle to report for them otherwise.
+func (d *FSSControllerDriver) ListSnapshots(ctx context.Context, req *csi.ListSnapshotsRequest) (*csi.ListSnapshotsResponse, error) {
+	log := d.logger.With("snapshotId", req.SnapshotId, "sourceVolumeId", req.SourceVolumeId, "csiOperation", "listSnapshots")
+
+	if req.MaxEntries < 0 {
+		log.Errorf("Invalid max entries %d", req.MaxEntries)
+		return nil, status.Errorf(codes.InvalidArgument, "max_entries must not be negative, got %d", req.MaxEntries)
+	}
+
+	filesystemOcid := ""
+	if req.SourceVolumeId != "" {
+		filesystemOcid = csi_util.ValidateFssId(req.SourceVolumeId).FilesystemOcid
+		if filesystemOcid == "" {
+			log.Info("Unable to parse source Volume Id, returning empty list")
+			return &csi.ListSnapshotsResponse{}, nil
+		}
+	}
+
+	if req.SnapshotId != "" {
+		snapshot, err := d.client.FSS().GetSnapshot(ctx, req.SnapshotId)
+		if err != nil {
+			if client.IsNotFound(err) {
+				log.Info("Snapshot not found, returning empty list")
+				return &csi.ListSnapshotsResponse{}, nil
+			}
+			log.With("service", "fss", "verb", "get", "resource", "snapshot", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Failed to get snapshot.")
+			return nil, status.Errorf(codes.Internal, "failed to get snapshot %s: %v", req.SnapshotId, err)
+		}
+		if filesystemOcid != "" && (snapshot.FileSystemId == nil || *snapshot.FileSystemId != filesystemOcid) {
+			log.Info("Snapshot does not belong to the requested source volume, returning empty list")
+			return &csi.ListSnapshotsResponse{}, nil
+		}
+		var entries []*csi.ListSnapshotsResponse_Entry
+		if entry := getFssListSnapshotsEntry(req.SourceVolumeId, *snapshot.Id, string(snapshot.LifecycleState), snapshot.TimeCreated, snapshot.FreeformTags); entry != nil {
+			entries = append(entries, entry)
+		}
+		return &csi.ListSnapshotsResponse{Entries: entries}, nil
+	}
+
+	// FSS only lists the snapshots of a file system, or those taken by a snapshot policy
+	if filesystemOcid == "" {
+		log.Info("Listing snapshots without a source volume is not supported, returning empty list")
+		return &csi.ListSnapshotsResponse{}, nil
+	}
+
+	snapshots, nextToken, err := d.client.FSS().ListSnapshots(ctx, filesystemOcid, int(req.MaxEntries), req.StartingToken)
+	if err != nil {
+		log.With("service", "fss", "verb", "list", "resource", "snapshot", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to list snapshots.")
+		if req.StartingToken != "" && util.GetHttpStatusCode(err) == http.StatusBadRequest {
+			return nil, status.Errorf(codes.Aborted, "invalid starting_token %s: %v", req.StartingToken, err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list snapshots: %v", err)
+	}
+
+	var entries []*csi.ListSnapshotsResponse_Entry
+	for _, snapshot := range snapshots {
+		if entry := getFssListSnapshotsEntry(req.SourceVolumeId, *snapshot.Id, string(snapshot.LifecycleState), snapshot.TimeCreated, snapshot.FreeformTags); entry != nil {
+			entries = append(entries, entry)
+		}
+	}
+	return &csi.ListSnapshotsResponse{
+		Entries:   entries,
+		NextToken: nextToken,
+	}, nil
 }
 
-func (d *FSSControllerDriver) ControllerExpandVolume(ctx context.Context, request *csi.ControllerExpandVolumeRequest) (*csi.ControllerExpandVolumeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "")
+// getFssListSnapshotsEntry converts an OCI snapshot into a ListSnapshots entry.
+// It returns nil for snapshots that are being deleted, that were taken to clone
+// a volume, or whose source volume handle is unknown.
+func getFssListSnapshotsEntry(sourceVolumeId, snapshotId, lifecycleState string, timeCreated *common.SDKTime, freeformTags map[string]string) *csi.ListSnapshotsResponse_Entry {
+	switch lifecycleState {
+	case string(fss.SnapshotLifecycleStateDeleting), string(fss.SnapshotLifecycleStateDeleted):
+		return nil
+	}
+	if _, ok := freeformTags[fssCloneSnapshotTag]; ok {
+		return nil
+	}
+	if sourceVolumeId == "" {
+		sourceVolumeId = freeformTags[fssSnapshotSourceVolumeTag]
+		if sourceVolumeId == "" {
+			return nil
+		}
+	}
+	return &csi.ListSnapshotsResponse_Entry{
+		Snapshot: getFssCsiSnapshot(snapshotId, sourceVolumeId, timeCreated, lifecycleState == string(fss.SnapshotLifecycleStateActive)),
+	}
 }
 
-func (d *FSSControllerDriver) ControllerGetVolume(ctx context.Context, request *csi.ControllerGetVolumeRequest) (*csi.ControllerGetVolumeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "")
+func getFssCsiSnapshot(snapshotId, sourceVolumeId string, timeCreated *common.SDKTime, readyToUse bool) *csi.Snapshot {
+	snapshot := &csi.Snapshot{
+		SnapshotId:     snapshotId,
+		SourceVolumeId: sourceVolumeId,
+		ReadyToUse:     readyToUse,
+	}
+	if timeCreated != nil {
+		snapshot.CreationTime = timestamppb.New(timeCreated.Time)
+	}
+	return snapshot
+}
+
+// ControllerExpandVolume raises the hard quota of a file system provisioned with enforceQuota.
+// File systems grow on demand, so volumes without a quota are expanded without any change in OCI.
+func (d *FSSControllerDriver) ControllerExpandVolume(ctx context.Context, req *csi.ControllerExpandVolumeRequest) (*csi.ControllerExpandVolumeResponse, error) {
+	startTime := time.Now()
+	volumeId := req.GetVolumeId()
+	log := d.logger.With("volumeID", volumeId, "csiOperation", "expandVolume")
+	dimensionsMap := make(map[string]string)
+	dimensionsMap[metrics.ResourceOCIDDimension] = volumeId
+
+	volumeHandler := csi_util.ValidateFssId(volumeId)
+	filesystemOcid, mountTargetIP, exportPath := volumeHandler.FilesystemOcid, volumeHandler.MountTargetIPAddress, volumeHandler.FsExportPath
+	if filesystemOcid == "" || mountTargetIP == "" || exportPath == "" {
+		log.Error("Unable to parse Volume Id")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.FSSExpand, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid Volume ID provided %s", volumeId)
+	}
+	log = log.With("fssID", filesystemOcid)
+
+	newSize := getRequestedCapacityBytes(req.GetCapacityRange())
+	if newSize <= 0 {
+		log.Error("Capacity range must be provided")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.FSSExpand, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Error(codes.InvalidArgument, "CapacityRange must be provided")
+	}
+	log = log.With("newSize", newSize)
+
+	_, err := d.client.FSS().GetFileSystem(ctx, filesystemOcid)
+	if err != nil {
+		log.With("service", "fss", "verb", "get", "resource", "fileSystem", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to get file system.")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.FSSExpand, time.Since(startTime).Seconds(), dimensionsMap)
+		if client.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "file system %s not found", filesystemOcid)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get file system %s: %v", filesystemOcid, err)
+	}
+
+	quotaRule, err := d.client.FSS().GetFileSystemQuotaRule(ctx, filesystemOcid)
+	if err != nil {
+		log.With("service", "fss", "verb", "list", "resource", "quotaRule", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to get the quota of the file system.")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.FSSExpand, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.Internal, "failed to get quota of file system %s: %v", filesystemOcid, err)
+	}
+
+	if quotaRule == nil || quotaRule.QuotaLimitInGigabytes == nil {
+		log.Info("File system has no quota, nothing to update in OCI.")
+	} else if quotaBytes := int64(*quotaRule.QuotaLimitInGigabytes) * client.GiB; newSize <= quotaBytes {
+		log.With("quotaBytes", quotaBytes).Info("File system quota already satisfies the requested size.")
+		newSize = quotaBytes
+	} else {
+		newSize = csi_util.RoundUpSize(newSize, client.GiB) * client.GiB
+		if _, err = d.client.FSS().UpdateQuotaRule(ctx, filesystemOcid, *quotaRule.Id, int(newSize/client.GiB)); err != nil {
+			log.With("service", "fss", "verb", "update", "resource", "quotaRule", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Failed to update the quota of the file system.")
+			dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+			metrics.SendMetricData(d.metricPusher, metrics.FSSExpand, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, status.Errorf(codes.Internal, "failed to update quota of file system %s: %v", filesystemOcid, err)
+		}
+		log.With("oldQuotaBytes", quotaBytes, "quotaBytes", newSize).Info("File system quota is updated.")
+	}
+
+	dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.Success, util.CSIStorageType)
+	metrics.SendMetricData(d.metricPusher, metrics.FSSExpand, time.Since(startTime).Seconds(), dimensionsMap)
+	return &csi.ControllerExpandVolumeResponse{
+		CapacityBytes:         newSize,
+		NodeExpansionRequired: false,
+	}, nil
+}
+
+// ensureFssQuota makes sure the file system has a hard quota of at least
+// quotaBytes on its total usage and that its quota rules are enforced.
+func (d *FSSControllerDriver) ensureFssQuota(ctx context.Context, filesystemOcid string, quotaBytes int64, log *zap.SugaredLogger) error {
+	limitInGigabytes := int(quotaBytes / client.GiB)
+	quotaRule, err := d.client.FSS().GetFileSystemQuotaRule(ctx, filesystemOcid)
+	if err != nil {
+		return err
+	}
+	if quotaRule == nil {
+		if _, err = d.client.FSS().CreateFileSystemQuotaRule(ctx, filesystemOcid, limitInGigabytes); err != nil {
+			return err
+		}
+		log.Info("File system quota rule is created.")
+	} else if quotaRule.QuotaLimitInGigabytes == nil || *quotaRule.QuotaLimitInGigabytes < limitInGigabytes {
+		if _, err = d.client.FSS().UpdateQuotaRule(ctx, filesystemOcid, *quotaRule.Id, limitInGigabytes); err != nil {
+			return err
+		}
+		log.Info("File system quota rule is updated.")
+	}
+
+	fileSystem, err := d.client.FSS().GetFileSystem(ctx, filesystemOcid)
+	if err != nil {
+		return err
+	}
+	if fileSystem.AreQuotaRulesEnabled == nil || !*fileSystem.AreQuotaRulesEnabled {
+		if err = d.client.FSS().EnableQuotaRules(ctx, filesystemOcid); err != nil {
+			return err
+		}
+		log.Info("File system quota rules are enabled.")
+	}
+	return nil
+}
+
+// getRequestedCapacityBytes returns the required capacity of the range, falling back to its limit.
+func getRequestedCapacityBytes(capacityRange *csi.CapacityRange) int64 {
+	if capacityRange.GetRequiredBytes() > 0 {
+		return capacityRange.GetRequiredBytes()
+	}
+	return capacityRange.GetLimitBytes()
+}
+
+// ControllerGetVolume reports the VolumeCondition of a file system volume based on
+// the lifecycle state of the file system, its mount target and its export.
+func (d *FSSControllerDriver) ControllerGetVolume(ctx context.Context, req *csi.ControllerGetVolumeRequest) (*csi.ControllerGetVolumeResponse, error) {
+	volumeId := req.GetVolumeId()
+	log := d.logger.With("volumeID", volumeId, "csiOperation", "getVolume")
+	volumeHandler := csi_util.ValidateFssId(volumeId)
+	filesystemOcid, mountTargetIP, exportPath := volumeHandler.FilesystemOcid, volumeHandler.MountTargetIPAddress, volumeHandler.FsExportPath
+	if filesystemOcid == "" || mountTargetIP == "" || exportPath == "" {
+		log.Error("Unable to parse Volume Id")
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid Volume ID provided %s", volumeId)
+	}
+	log = log.With("fssID", filesystemOcid).With("mountTargetIP", mountTargetIP).With("exportPath", exportPath)
+
+	fileSystem, err := d.client.FSS().GetFileSystem(ctx, filesystemOcid)
+	if err != nil {
+		if !client.IsNotFound(err) {
+			log.With("service", "fss", "verb", "get", "resource", "fileSystem", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Failed to get file system.")
+			return nil, status.Errorf(codes.Internal, "failed to get file system %s: %v", filesystemOcid, err)
+		}
+		log.Warn("File system not found, it may have been deleted outside of Kubernetes.")
+		return getControllerGetVolumeResponse(volumeId, 0, true, fmt.Sprintf("file system %s not found, it may have been deleted", filesystemOcid)), nil
+	}
+
+	switch state := fileSystem.LifecycleState; state {
+	case fss.FileSystemLifecycleStateFailed,
+		fss.FileSystemLifecycleStateDeleting,
+		fss.FileSystemLifecycleStateDeleted:
+		log.With("lifecycleState", state).Warn("File system is in an abnormal lifecycle state.")
+		return getControllerGetVolumeResponse(volumeId, 0, true, fmt.Sprintf("file system is in %s state", state)), nil
+	}
+
+	// Only file systems provisioned by the driver carry the mount target and
+	// export set in their tags, statically provisioned volumes are checked
+	// against the file system alone.
+	mountTargetOCID := fileSystem.FreeformTags["mountTargetOCID"]
+	exportSetId := fileSystem.FreeformTags["exportSetId"]
+
+	if mountTargetOCID != "" {
+		mountTarget, err := d.client.FSS().GetMountTarget(ctx, mountTargetOCID)
+		if err != nil {
+			if !client.IsNotFound(err) {
+				log.With("service", "fss", "verb", "get", "resource", "mountTarget", "statusCode", util.GetHttpStatusCode(err)).
+					With(zap.Error(err)).Error("Failed to get mount target.")
+				return nil, status.Errorf(codes.Internal, "failed to get mount target %s: %v", mountTargetOCID, err)
+			}
+			log.With("mountTargetOCID", mountTargetOCID).Warn("Mount target not found.")
+			return getControllerGetVolumeResponse(volumeId, 0, true, fmt.Sprintf("mount target %s not found, it may have been deleted", mountTargetOCID)), nil
+		}
+		switch state := mountTarget.LifecycleState; state {
+		case fss.MountTargetLifecycleStateFailed,
+			fss.MountTargetLifecycleStateDeleting,
+			fss.MountTargetLifecycleStateDeleted:
+			log.With("mountTargetOCID", mountTargetOCID, "lifecycleState", state).Warn("Mount target is in an abnormal lifecycle state.")
+			return getControllerGetVolumeResponse(volumeId, 0, true, fmt.Sprintf("mount target %s is in %s state", mountTargetOCID, state)), nil
+		}
+	}
+
+	if exportSetId != "" {
+		export, err := d.client.FSS().FindExport(ctx, filesystemOcid, exportPath, exportSetId)
+		if err != nil {
+			if client.IsNotFound(err) {
+				log.With("exportSetId", exportSetId).Warn("Export not found.")
+				return getControllerGetVolumeResponse(volumeId, 0, true, fmt.Sprintf("export %s not found, it may have been deleted", exportPath)), nil
+			}
+			if export == nil {
+				log.With("service", "fss", "verb", "get", "resource", "export", "statusCode", util.GetHttpStatusCode(err)).
+					With(zap.Error(err)).Error("Failed to get export.")
+				return nil, status.Errorf(codes.Internal, "failed to get export %s: %v", exportPath, err)
+			}
+			log.With("exportSetId", exportSetId, "lifecycleState", export.LifecycleState).Warn("Export is in an abnormal lifecycle state.")
+			return getControllerGetVolumeResponse(volumeId, 0, true, fmt.Sprintf("export %s is in %s state", exportPath, export.LifecycleState)), nil
+		}
+	}
+
+	// writes fail once a file system reaches its hard quota
+	var quotaBytes int64
+	quotaRule, err := d.client.FSS().GetFileSystemQuotaRule(ctx, filesystemOcid)
+	if err != nil {
+		log.With("service", "fss", "verb", "list", "resource", "quotaRule", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Warn("Failed to get the quota of the file system.")
+	} else if quotaRule != nil && quotaRule.QuotaLimitInGigabytes != nil {
+		quotaBytes = int64(*quotaRule.QuotaLimitInGigabytes) * client.GiB
+		if quotaRule.UsageInBytes != nil && *quotaRule.UsageInBytes >= quotaBytes {
+			log.With("quotaBytes", quotaBytes, "usageInBytes", *quotaRule.UsageInBytes).Warn("File system is full.")
+			return getControllerGetVolumeResponse(volumeId, quotaBytes, true,
+				fmt.Sprintf("file system uses %d bytes, reaching its quota of %d bytes", *quotaRule.UsageInBytes, quotaBytes)), nil
+		}
+	}
+
+	return getControllerGetVolumeResponse(volumeId, quotaBytes, false, fmt.Sprintf("file system is in %s state", fileSystem.LifecycleState)), nil
 }
@@ -17,6 +17,7 @@ package driver
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"reflect"
 	"strings"
 	"testing"
@@ -33,6 +34,7 @@ import (
 	fss "github.com/oracle/oci-go-sdk/v65/filestorage"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	authv1 "k8s.io/api/authentication/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
@@ -50,6 +52,12 @@ var (
 			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
 			Id:                 common.String("mount-target-stuck-creating"),
 		},
+		"mount-target-failed": {
+			DisplayName:        common.String("mount-target-failed"),
+			LifecycleState:     fss.MountTargetLifecycleStateFailed,
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("mount-target-failed"),
+		},
 	}
 
 	fileSystems = map[string]*fss.FileSystem{
@@ -59,6 +67,153 @@ var (
 			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
 			Id:                 common.String("file-system-stuck-creating"),
 		},
+		"file-system-failed": {
+			DisplayName:        common.String("file-system-failed"),
+			LifecycleState:     fss.FileSystemLifecycleStateFailed,
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("file-system-failed"),
+		},
+		"file-system-ad1": {
+			DisplayName:        common.String("file-system-ad1"),
+			LifecycleState:     fss.FileSystemLifecycleStateActive,
+			AvailabilityDomain: common.String("AD1"),
+			Id:                 common.String("file-system-ad1"),
+		},
+		"file-system-with-failed-mount-target": {
+			DisplayName:        common.String("file-system-with-failed-mount-target"),
+			LifecycleState:     fss.FileSystemLifecycleStateActive,
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("file-system-with-failed-mount-target"),
+			FreeformTags:       map[string]string{"mountTargetOCID": "mount-target-failed"},
+		},
+		"file-system-with-quota": {
+			DisplayName:        common.String("file-system-with-quota"),
+			LifecycleState:     fss.FileSystemLifecycleStateActive,
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("file-system-with-quota"),
+			MeteredBytes:       common.Int64(10 * client.GiB),
+		},
+		"file-system-over-quota": {
+			DisplayName:        common.String("file-system-over-quota"),
+			LifecycleState:     fss.FileSystemLifecycleStateActive,
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("file-system-over-quota"),
+		},
+		"file-system-quota-fails": {
+			DisplayName:        common.String("file-system-quota-fails"),
+			LifecycleState:     fss.FileSystemLifecycleStateActive,
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("file-system-quota-fails"),
+		},
+		"file-system-update-fails": {
+			DisplayName:        common.String("file-system-update-fails"),
+			LifecycleState:     fss.FileSystemLifecycleStateActive,
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("file-system-update-fails"),
+		},
+		"file-system-clone": {
+			DisplayName:        common.String("file-system-clone"),
+			LifecycleState:     fss.FileSystemLifecycleStateActive,
+			AvailabilityDomain: common.String("AD1"),
+			CompartmentId:      common.String("oc1.comp.xxxx"),
+			Id:                 common.String("file-system-clone"),
+			FreeformTags:       map[string]string{fssCloneSourceSnapshotTag: "fss-snapshot-clone"},
+		},
+		"file-system-clone-snapshot-delete-fails": {
+			DisplayName:        common.String("file-system-clone-snapshot-delete-fails"),
+			LifecycleState:     fss.FileSystemLifecycleStateActive,
+			AvailabilityDomain: common.String("AD1"),
+			CompartmentId:      common.String("oc1.comp.xxxx"),
+			Id:                 common.String("file-system-clone-snapshot-delete-fails"),
+			FreeformTags:       map[string]string{fssCloneSourceSnapshotTag: "fss-snapshot-delete-fails"},
+		},
+		"file-system-with-deleting-export": {
+			DisplayName:        common.String("file-system-with-deleting-export"),
+			LifecycleState:     fss.FileSystemLifecycleStateActive,
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("file-system-with-deleting-export"),
+			FreeformTags:       map[string]string{"mountTargetOCID": "oc1.mounttarget.xxxx", "exportSetId": "oc1.exportset.xxxx"},
+		},
+	}
+
+	quotaRules = map[string]*fss.QuotaRuleSummary{
+		"file-system-with-quota": {
+			Id:                    common.String("quota-rule-with-quota"),
+			FileSystemId:          common.String("file-system-with-quota"),
+			QuotaLimitInGigabytes: common.Int(50),
+			UsageInBytes:          common.Int64(10 * client.GiB),
+			IsHardQuota:           common.Bool(true),
+		},
+		"file-system-over-quota": {
+			Id:                    common.String("quota-rule-over-quota"),
+			FileSystemId:          common.String("file-system-over-quota"),
+			QuotaLimitInGigabytes: common.Int(50),
+			UsageInBytes:          common.Int64(50 * client.GiB),
+			IsHardQuota:           common.Bool(true),
+		},
+		"file-system-update-fails": {
+			Id:                    common.String("quota-rule-update-fails"),
+			FileSystemId:          common.String("file-system-update-fails"),
+			QuotaLimitInGigabytes: common.Int(50),
+			IsHardQuota:           common.Bool(true),
+		},
+	}
+
+	fssSnapshots = map[string]*fss.Snapshot{
+		"fss-snapshot-active": {
+			Id:             common.String("fss-snapshot-active"),
+			Name:           common.String("snapshot-active"),
+			FileSystemId:   common.String("oc1.filesystem.xxxx"),
+			LifecycleState: fss.SnapshotLifecycleStateActive,
+			TimeCreated:    &common.SDKTime{Time: time.Unix(1700000000, 0)},
+			FreeformTags:   map[string]string{fssSnapshotSourceVolumeTag: "oc1.filesystem.xxxx:10.0.10.207:/export-path"},
+		},
+		"fss-snapshot-creating": {
+			Id:             common.String("fss-snapshot-creating"),
+			Name:           common.String("snapshot-creating"),
+			FileSystemId:   common.String("oc1.filesystem.xxxx"),
+			LifecycleState: fss.SnapshotLifecycleStateCreating,
+			TimeCreated:    &common.SDKTime{Time: time.Unix(1700000000, 0)},
+			FreeformTags:   map[string]string{fssSnapshotSourceVolumeTag: "oc1.filesystem.xxxx:10.0.10.207:/export-path"},
+		},
+		"fss-snapshot-deleting": {
+			Id:             common.String("fss-snapshot-deleting"),
+			Name:           common.String("snapshot-deleting"),
+			FileSystemId:   common.String("oc1.filesystem.xxxx"),
+			LifecycleState: fss.SnapshotLifecycleStateDeleting,
+			TimeCreated:    &common.SDKTime{Time: time.Unix(1700000000, 0)},
+			FreeformTags:   map[string]string{fssSnapshotSourceVolumeTag: "oc1.filesystem.xxxx:10.0.10.207:/export-path"},
+		},
+		"fss-snapshot-untagged": {
+			Id:             common.String("fss-snapshot-untagged"),
+			Name:           common.String("snapshot-untagged"),
+			FileSystemId:   common.String("oc1.filesystem.yyyy"),
+			LifecycleState: fss.SnapshotLifecycleStateActive,
+			TimeCreated:    &common.SDKTime{Time: time.Unix(1700000000, 0)},
+		},
+		"fss-snapshot-clone": {
+			Id:             common.String("fss-snapshot-clone"),
+			Name:           common.String("ut-clone-volume"),
+			FileSystemId:   common.String("file-system-ad1"),
+			LifecycleState: fss.SnapshotLifecycleStateActive,
+			TimeCreated:    &common.SDKTime{Time: time.Unix(1700000000, 0)},
+			FreeformTags:   map[string]string{fssCloneSnapshotTag: "ut-clone-volume"},
+		},
+		"fss-snapshot-clone-creating": {
+			Id:             common.String("fss-snapshot-clone-creating"),
+			Name:           common.String("ut-clone-volume-creating"),
+			FileSystemId:   common.String("file-system-ad1"),
+			LifecycleState: fss.SnapshotLifecycleStateCreating,
+			TimeCreated:    &common.SDKTime{Time: time.Unix(1700000000, 0)},
+			FreeformTags:   map[string]string{fssCloneSnapshotTag: "ut-clone-volume-creating"},
+		},
+		"fss-snapshot-ad1": {
+			Id:             common.String("fss-snapshot-ad1"),
+			Name:           common.String("snapshot-ad1"),
+			FileSystemId:   common.String("file-system-ad1"),
+			LifecycleState: fss.SnapshotLifecycleStateActive,
+			TimeCreated:    &common.SDKTime{Time: time.Unix(1700000000, 0)},
+		},
 	}
 
 	exports = map[string]*fss.Export{
@@ -66,6 +221,10 @@ var (
 			LifecycleState: fss.ExportLifecycleStateCreating,
 			Id:             common.String("export-stuck-creating"),
 		},
+		"/restored": {
+			LifecycleState: fss.ExportLifecycleStateActive,
+			Id:             common.String("/restored"),
+		},
 	}
 )
 
@@ -136,8 +295,27 @@ func (c *MockFileStorageClient) CreateFileSystem(ctx context.Context, details fi
 	}, nil
 }
 
+type mockNotFoundError struct{}
+
+func (mockNotFoundError) Error() string           { return "not found" }
+func (mockNotFoundError) GetHTTPStatusCode() int  { return http.StatusNotFound }
+func (mockNotFoundError) GetMessage() string      { return "not found" }
+func (mockNotFoundError) GetCode() string         { return "NotAuthorizedOrNotFound" }
+func (mockNotFoundError) GetOpcRequestID() string { return "" }
+
+type mockVolumeInVolumeGroupError struct{}
+
+func (mockVolumeInVolumeGroupError) Error() string           { return "volume in a volume group" }
+func (mockVolumeInVolumeGroupError) GetHTTPStatusCode() int  { return http.StatusBadRequest }
+func (mockVolumeInVolumeGroupError) GetMessage() string      { return "volume in a volume group" }
+func (mockVolumeInVolumeGroupError) GetCode() string         { return "InvalidParameter" }
+func (mockVolumeInVolumeGroupError) GetOpcRequestID() string { return "" }
+
 // GetFileSystem mocks the FileStorage GetFileSystem implementation.
 func (c *MockFileStorageClient) GetFileSystem(ctx context.Context, id string) (*filestorage.FileSystem, error) {
+	if id == "file-system-deleted" {
+		return nil, errors.WithStack(mockNotFoundError{})
+	}
 	if fileSystems[id] != nil {
 		return fileSystems[id], nil
 	}
@@ -154,6 +332,38 @@ func (c *MockFileStorageClient) GetFileSystem(ctx context.Context, id string) (*
 	}, nil
 }
 
+func (c *MockFileStorageClient) EnableQuotaRules(ctx context.Context, fileSystemID string) error {
+	return nil
+}
+
+func (c *MockFileStorageClient) CreateFileSystemQuotaRule(ctx context.Context, fileSystemID string, limitInGigabytes int) (*filestorage.QuotaRule, error) {
+	return &filestorage.QuotaRule{
+		Id:                    common.String("quota-rule"),
+		FileSystemId:          &fileSystemID,
+		QuotaLimitInGigabytes: &limitInGigabytes,
+		IsHardQuota:           common.Bool(true),
+	}, nil
+}
+
+func (c *MockFileStorageClient) GetFileSystemQuotaRule(ctx context.Context, fileSystemID string) (*filestorage.QuotaRuleSummary, error) {
+	if fileSystemID == "file-system-quota-fails" {
+		return nil, errors.New("internal error")
+	}
+	return quotaRules[fileSystemID], nil
+}
+
+func (c *MockFileStorageClient) UpdateQuotaRule(ctx context.Context, fileSystemID, quotaRuleID string, limitInGigabytes int) (*filestorage.QuotaRule, error) {
+	if fileSystemID == "file-system-update-fails" {
+		return nil, errors.New("internal error")
+	}
+	return &filestorage.QuotaRule{
+		Id:                    &quotaRuleID,
+		FileSystemId:          &fileSystemID,
+		QuotaLimitInGigabytes: &limitInGigabytes,
+		IsHardQuota:           common.Bool(true),
+	}, nil
+}
+
 func (c *MockFileStorageClient) AwaitFileSystemActive(ctx context.Context, logger *zap.SugaredLogger, id string) (*filestorage.FileSystem, error) {
 	var fs *fss.FileSystem
 	err := wait.PollImmediateUntil(testPollInterval, func() (bool, error) {
@@ -330,6 +540,70 @@ func (c *MockFileStorageClient) DeleteExport(ctx context.Context, id string) err
 	return nil
 }
 
+func fssSnapshotSummary(snapshot *fss.Snapshot) fss.SnapshotSummary {
+	return fss.SnapshotSummary{
+		Id:             snapshot.Id,
+		Name:           snapshot.Name,
+		FileSystemId:   snapshot.FileSystemId,
+		LifecycleState: fss.SnapshotSummaryLifecycleStateEnum(snapshot.LifecycleState),
+		TimeCreated:    snapshot.TimeCreated,
+		FreeformTags:   snapshot.FreeformTags,
+	}
+}
+
+func (c *MockFileStorageClient) CreateSnapshot(ctx context.Context, details filestorage.CreateSnapshotDetails) (*filestorage.Snapshot, error) {
+	if *details.Name == "snapshot-create-fails" {
+		return nil, errors.New("internal error")
+	}
+	return &filestorage.Snapshot{
+		Id:             common.String("fss-snapshot-" + *details.Name),
+		Name:           details.Name,
+		FileSystemId:   details.FileSystemId,
+		LifecycleState: fss.SnapshotLifecycleStateActive,
+		TimeCreated:    &common.SDKTime{Time: time.Unix(1700000000, 0)},
+		FreeformTags:   details.FreeformTags,
+	}, nil
+}
+
+func (c *MockFileStorageClient) GetSnapshot(ctx context.Context, id string) (*filestorage.Snapshot, error) {
+	if snapshot, ok := fssSnapshots[id]; ok {
+		return snapshot, nil
+	}
+	return nil, errors.WithStack(mockNotFoundError{})
+}
+
+func (c *MockFileStorageClient) GetSnapshotsByName(ctx context.Context, fileSystemID, name string) ([]filestorage.SnapshotSummary, error) {
+	snapshots := make([]filestorage.SnapshotSummary, 0)
+	for _, snapshot := range fssSnapshots {
+		if *snapshot.FileSystemId == fileSystemID && *snapshot.Name == name &&
+			(snapshot.LifecycleState == fss.SnapshotLifecycleStateActive || snapshot.LifecycleState == fss.SnapshotLifecycleStateCreating) {
+			snapshots = append(snapshots, fssSnapshotSummary(snapshot))
+		}
+	}
+	return snapshots, nil
+}
+
+func (c *MockFileStorageClient) ListSnapshots(ctx context.Context, fileSystemID string, limit int, page string) ([]filestorage.SnapshotSummary, string, error) {
+	ids := []string{"fss-snapshot-active", "fss-snapshot-creating", "fss-snapshot-deleting", "fss-snapshot-untagged"}
+	snapshots := make([]filestorage.SnapshotSummary, 0)
+	for _, id := range ids {
+		if *fssSnapshots[id].FileSystemId == fileSystemID {
+			snapshots = append(snapshots, fssSnapshotSummary(fssSnapshots[id]))
+		}
+	}
+	return snapshots, "", nil
+}
+
+func (c *MockFileStorageClient) DeleteSnapshot(ctx context.Context, id string) error {
+	if id == "fss-snapshot-delete-fails" {
+		return errors.New("internal error")
+	}
+	if _, ok := fssSnapshots[id]; !ok {
+		return errors.WithStack(mockNotFoundError{})
+	}
+	return nil
+}
+
 // GetMountTarget mocks the FileStorage GetMountTarget implementation
 func (c *MockFileStorageClient) AwaitMountTargetActive(ctx context.Context, logger *zap.SugaredLogger, id string) (*filestorage.MountTarget, error) {
 	var mt *fss.MountTarget
@@ -512,6 +786,24 @@ func TestFSSControllerDriver_CreateVolume(t *testing.T) {
 			want:    nil,
 			wantErr: errors.New("AvailabilityDomain not provided in storage class"),
 		},
+		{
+			name:   "Error when enforceQuota is enabled without a capacity range",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.CreateVolumeRequest{
+					Name:       "ut-volume",
+					Parameters: map[string]string{"availabilityDomain": "US-ASHBURN-AD-1", "mountTargetOcid": "oc1.mounttarget.xxxx", "enforceQuota": "true"},
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER,
+						},
+					}},
+				},
+			},
+			want:    nil,
+			wantErr: errors.New("CapacityRange must be provided when enforceQuota is enabled in storage class"),
+		},
 		{
 			name:   "Error when both mount target OCID and mount target subnet OCID are not specified",
 			fields: fields{},
@@ -639,6 +931,233 @@ func TestFSSControllerDriver_CreateVolume(t *testing.T) {
 			want:    nil,
 			wantErr: errors.New("await export failed with time out"),
 		},
+		{
+			name:   "Error when source snapshot is not found",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.CreateVolumeRequest{
+					Name:       "ut-volume",
+					Parameters: map[string]string{"availabilityDomain": "US-ASHBURN-AD-1", "mountTargetOcid": "oc1.mounttarget.xxxx"},
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER,
+						},
+					}},
+					VolumeContentSource: &csi.VolumeContentSource{
+						Type: &csi.VolumeContentSource_Snapshot{
+							Snapshot: &csi.VolumeContentSource_SnapshotSource{SnapshotId: "fss-snapshot-missing"},
+						},
+					},
+				},
+			},
+			want:    nil,
+			wantErr: errors.New("failed to get snapshot fss-snapshot-missing"),
+		},
+		{
+			name:   "Error when source snapshot is not active",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.CreateVolumeRequest{
+					Name:       "ut-volume",
+					Parameters: map[string]string{"availabilityDomain": "US-ASHBURN-AD-1", "mountTargetOcid": "oc1.mounttarget.xxxx"},
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER,
+						},
+					}},
+					VolumeContentSource: &csi.VolumeContentSource{
+						Type: &csi.VolumeContentSource_Snapshot{
+							Snapshot: &csi.VolumeContentSource_SnapshotSource{SnapshotId: "fss-snapshot-creating"},
+						},
+					},
+				},
+			},
+			want:    nil,
+			wantErr: errors.New("snapshot fss-snapshot-creating is in CREATING state"),
+		},
+		{
+			name:   "Error when source snapshot is in another availability domain",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.CreateVolumeRequest{
+					Name:       "ut-volume",
+					Parameters: map[string]string{"availabilityDomain": "US-ASHBURN-AD-1", "mountTargetOcid": "oc1.mounttarget.xxxx"},
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER,
+						},
+					}},
+					VolumeContentSource: &csi.VolumeContentSource{
+						Type: &csi.VolumeContentSource_Snapshot{
+							Snapshot: &csi.VolumeContentSource_SnapshotSource{SnapshotId: "fss-snapshot-active"},
+						},
+					},
+				},
+			},
+			want:    nil,
+			wantErr: errors.New("snapshot fss-snapshot-active is in availability domain zkJl:US-ASHBURN-AD-1, cannot restore to AD1"),
+		},
+		{
+			name:   "Create volume from snapshot",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.CreateVolumeRequest{
+					Name:       "ut-restored-volume",
+					Parameters: map[string]string{"availabilityDomain": "US-ASHBURN-AD-1", "mountTargetOcid": "oc1.mounttarget.xxxx", "exportPath": "/restored"},
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER,
+						},
+					}},
+					VolumeContentSource: &csi.VolumeContentSource{
+						Type: &csi.VolumeContentSource_Snapshot{
+							Snapshot: &csi.VolumeContentSource_SnapshotSource{SnapshotId: "fss-snapshot-ad1"},
+						},
+					},
+				},
+			},
+			want: &csi.CreateVolumeResponse{
+				Volume: &csi.Volume{
+					VolumeId:      "ut-restored-volume:10.0.20.1:/restored",
+					VolumeContext: map[string]string{"encryptInTransit": "false"},
+					ContentSource: &csi.VolumeContentSource{
+						Type: &csi.VolumeContentSource_Snapshot{
+							Snapshot: &csi.VolumeContentSource_SnapshotSource{SnapshotId: "fss-snapshot-ad1"},
+						},
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name:   "Error when source volume of clone is not found",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.CreateVolumeRequest{
+					Name:       "ut-clone-volume",
+					Parameters: map[string]string{"availabilityDomain": "US-ASHBURN-AD-1", "mountTargetOcid": "oc1.mounttarget.xxxx", "exportPath": "/restored"},
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER,
+						},
+					}},
+					VolumeContentSource: &csi.VolumeContentSource{
+						Type: &csi.VolumeContentSource_Volume{
+							Volume: &csi.VolumeContentSource_VolumeSource{VolumeId: "file-system-deleted:10.0.10.207:/export-path"},
+						},
+					},
+				},
+			},
+			want:    nil,
+			wantErr: errors.New("source volume file-system-deleted:10.0.10.207:/export-path not found"),
+		},
+		{
+			name:   "Error when source volume of clone is in another availability domain",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.CreateVolumeRequest{
+					Name:       "ut-clone-volume",
+					Parameters: map[string]string{"availabilityDomain": "US-ASHBURN-AD-1", "mountTargetOcid": "oc1.mounttarget.xxxx", "exportPath": "/restored"},
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER,
+						},
+					}},
+					VolumeContentSource: &csi.VolumeContentSource{
+						Type: &csi.VolumeContentSource_Volume{
+							Volume: &csi.VolumeContentSource_VolumeSource{VolumeId: "oc1.filesystem.xxxx:10.0.10.207:/export-path"},
+						},
+					},
+				},
+			},
+			want:    nil,
+			wantErr: errors.New("is in availability domain zkJl:US-ASHBURN-AD-1, cannot clone to AD1"),
+		},
+		{
+			name:   "Error when snapshot of source volume cannot be created",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.CreateVolumeRequest{
+					Name:       "snapshot-create-fails",
+					Parameters: map[string]string{"availabilityDomain": "US-ASHBURN-AD-1", "mountTargetOcid": "oc1.mounttarget.xxxx", "exportPath": "/restored"},
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER,
+						},
+					}},
+					VolumeContentSource: &csi.VolumeContentSource{
+						Type: &csi.VolumeContentSource_Volume{
+							Volume: &csi.VolumeContentSource_VolumeSource{VolumeId: "file-system-ad1:10.0.10.207:/export-path"},
+						},
+					},
+				},
+			},
+			want:    nil,
+			wantErr: errors.New("failed to create snapshot of source volume file-system-ad1:10.0.10.207:/export-path"),
+		},
+		{
+			name:   "Error when snapshot of source volume is not active yet",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.CreateVolumeRequest{
+					Name:       "ut-clone-volume-creating",
+					Parameters: map[string]string{"availabilityDomain": "US-ASHBURN-AD-1", "mountTargetOcid": "oc1.mounttarget.xxxx", "exportPath": "/restored"},
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER,
+						},
+					}},
+					VolumeContentSource: &csi.VolumeContentSource{
+						Type: &csi.VolumeContentSource_Volume{
+							Volume: &csi.VolumeContentSource_VolumeSource{VolumeId: "file-system-ad1:10.0.10.207:/export-path"},
+						},
+					},
+				},
+			},
+			want:    nil,
+			wantErr: errors.New("snapshot fss-snapshot-clone-creating of source volume file-system-ad1:10.0.10.207:/export-path is in CREATING state"),
+		},
+		{
+			name:   "Clone volume from another volume",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.CreateVolumeRequest{
+					Name:       "ut-clone-volume",
+					Parameters: map[string]string{"availabilityDomain": "US-ASHBURN-AD-1", "mountTargetOcid": "oc1.mounttarget.xxxx", "exportPath": "/restored"},
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER,
+						},
+					}},
+					VolumeContentSource: &csi.VolumeContentSource{
+						Type: &csi.VolumeContentSource_Volume{
+							Volume: &csi.VolumeContentSource_VolumeSource{VolumeId: "file-system-ad1:10.0.10.207:/export-path"},
+						},
+					},
+				},
+			},
+			want: &csi.CreateVolumeResponse{
+				Volume: &csi.Volume{
+					VolumeId:      "ut-clone-volume:10.0.20.1:/restored",
+					VolumeContext: map[string]string{"encryptInTransit": "false"},
+					ContentSource: &csi.VolumeContentSource{
+						Type: &csi.VolumeContentSource_Volume{
+							Volume: &csi.VolumeContentSource_VolumeSource{VolumeId: "file-system-ad1:10.0.10.207:/export-path"},
+						},
+					},
+				},
+			},
+			wantErr: nil,
+		},
 	}
 	for _, tt := range tests {
 		ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
@@ -736,6 +1255,26 @@ func TestFSSControllerDriver_DeleteVolume(t *testing.T) {
 			want:    &csi.DeleteVolumeResponse{},
 			wantErr: nil,
 		},
+		{
+			name:   "Delete cloned volume and the snapshot it was cloned from",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.DeleteVolumeRequest{VolumeId: "file-system-clone:10.0.10.207:/export-path"},
+			},
+			want:    &csi.DeleteVolumeResponse{},
+			wantErr: nil,
+		},
+		{
+			name:   "Error when the snapshot a volume was cloned from cannot be deleted",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.DeleteVolumeRequest{VolumeId: "file-system-clone-snapshot-delete-fails:10.0.10.207:/export-path"},
+			},
+			want:    nil,
+			wantErr: errors.New("failed to delete snapshot fss-snapshot-delete-fails the volume was cloned from"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -760,6 +1299,380 @@ func TestFSSControllerDriver_DeleteVolume(t *testing.T) {
 	}
 }
 
+func TestFSSControllerDriver_ControllerGetVolume(t *testing.T) {
+	response := func(volumeId string, capacityBytes int64, abnormal bool, message string) *csi.ControllerGetVolumeResponse {
+		return &csi.ControllerGetVolumeResponse{
+			Volume: &csi.Volume{VolumeId: volumeId, CapacityBytes: capacityBytes},
+			Status: &csi.ControllerGetVolumeResponse_VolumeStatus{
+				VolumeCondition: &csi.VolumeCondition{Abnormal: abnormal, Message: message},
+			},
+		}
+	}
+	tests := []struct {
+		name    string
+		req     *csi.ControllerGetVolumeRequest
+		want    *csi.ControllerGetVolumeResponse
+		wantErr error
+	}{
+		{
+			name:    "Error for invalid volume ID",
+			req:     &csi.ControllerGetVolumeRequest{VolumeId: "oc1.filesystem.xxxx:10.0.10.207:"},
+			wantErr: errors.New("Invalid Volume ID provided oc1.filesystem.xxxx:10.0.10.207:"),
+		},
+		{
+			name: "Abnormal condition for deleted file system",
+			req:  &csi.ControllerGetVolumeRequest{VolumeId: "file-system-deleted:10.0.10.207:/export-path"},
+			want: response("file-system-deleted:10.0.10.207:/export-path", 0, true, "file system file-system-deleted not found, it may have been deleted"),
+		},
+		{
+			name: "Abnormal condition for failed file system",
+			req:  &csi.ControllerGetVolumeRequest{VolumeId: "file-system-failed:10.0.10.207:/export-path"},
+			want: response("file-system-failed:10.0.10.207:/export-path", 0, true, "file system is in FAILED state"),
+		},
+		{
+			name: "Abnormal condition for failed mount target",
+			req:  &csi.ControllerGetVolumeRequest{VolumeId: "file-system-with-failed-mount-target:10.0.10.207:/export-path"},
+			want: response("file-system-with-failed-mount-target:10.0.10.207:/export-path", 0, true, "mount target mount-target-failed is in FAILED state"),
+		},
+		{
+			name: "Abnormal condition for deleting export",
+			req:  &csi.ControllerGetVolumeRequest{VolumeId: "file-system-with-deleting-export:10.0.10.207:/export-path"},
+			want: response("file-system-with-deleting-export:10.0.10.207:/export-path", 0, true, "export /export-path is in DELETING state"),
+		},
+		{
+			name: "Abnormal condition for file system reaching its quota",
+			req:  &csi.ControllerGetVolumeRequest{VolumeId: "file-system-over-quota:10.0.10.207:/export-path"},
+			want: response("file-system-over-quota:10.0.10.207:/export-path", 50*client.GiB, true, "file system uses 53687091200 bytes, reaching its quota of 53687091200 bytes"),
+		},
+		{
+			name: "Healthy file system within its quota",
+			req:  &csi.ControllerGetVolumeRequest{VolumeId: "file-system-with-quota:10.0.10.207:/export-path"},
+			want: response("file-system-with-quota:10.0.10.207:/export-path", 50*client.GiB, false, "file system is in ACTIVE state"),
+		},
+		{
+			name: "Healthy file system",
+			req:  &csi.ControllerGetVolumeRequest{VolumeId: "oc1.filesystem.xxxx:10.0.10.207:/export-path"},
+			want: response("oc1.filesystem.xxxx:10.0.10.207:/export-path", 0, false, "file system is in ACTIVE state"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &FSSControllerDriver{ControllerDriver{
+				KubeClient: nil,
+				logger:     zap.S(),
+				config:     &providercfg.Config{CompartmentID: ""},
+				client:     NewClientProvisioner(nil, nil, &MockFileStorageClient{}),
+				util:       &csi_util.Util{},
+			}}
+			got, err := d.ControllerGetVolume(context.Background(), tt.req)
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("got error %q, want none", err)
+			}
+			if tt.wantErr != nil && (err == nil || !strings.Contains(err.Error(), tt.wantErr.Error())) {
+				t.Errorf("want error %q to include %q", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ControllerDriver.ControllerGetVolume() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFSSControllerDriver_ControllerExpandVolume(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *csi.ControllerExpandVolumeRequest
+		want    *csi.ControllerExpandVolumeResponse
+		wantErr error
+	}{
+		{
+			name:    "Error for invalid volume ID",
+			req:     &csi.ControllerExpandVolumeRequest{VolumeId: "oc1.filesystem.xxxx:10.0.10.207:", CapacityRange: &csi.CapacityRange{RequiredBytes: 100 * client.GiB}},
+			wantErr: errors.New("Invalid Volume ID provided oc1.filesystem.xxxx:10.0.10.207:"),
+		},
+		{
+			name:    "Error for missing capacity range",
+			req:     &csi.ControllerExpandVolumeRequest{VolumeId: "file-system-with-quota:10.0.10.207:/export-path"},
+			wantErr: errors.New("CapacityRange must be provided"),
+		},
+		{
+			name:    "Error for deleted file system",
+			req:     &csi.ControllerExpandVolumeRequest{VolumeId: "file-system-deleted:10.0.10.207:/export-path", CapacityRange: &csi.CapacityRange{RequiredBytes: 100 * client.GiB}},
+			wantErr: errors.New("file system file-system-deleted not found"),
+		},
+		{
+			name:    "Error when the quota cannot be read",
+			req:     &csi.ControllerExpandVolumeRequest{VolumeId: "file-system-quota-fails:10.0.10.207:/export-path", CapacityRange: &csi.CapacityRange{RequiredBytes: 100 * client.GiB}},
+			wantErr: errors.New("failed to get quota of file system file-system-quota-fails"),
+		},
+		{
+			name:    "Error when the quota cannot be updated",
+			req:     &csi.ControllerExpandVolumeRequest{VolumeId: "file-system-update-fails:10.0.10.207:/export-path", CapacityRange: &csi.CapacityRange{RequiredBytes: 100 * client.GiB}},
+			wantErr: errors.New("failed to update quota of file system file-system-update-fails"),
+		},
+		{
+			name: "Expand a file system without quota",
+			req:  &csi.ControllerExpandVolumeRequest{VolumeId: "oc1.filesystem.xxxx:10.0.10.207:/export-path", CapacityRange: &csi.CapacityRange{RequiredBytes: 100 * client.GiB}},
+			want: &csi.ControllerExpandVolumeResponse{CapacityBytes: 100 * client.GiB},
+		},
+		{
+			name: "Expand the quota of a file system",
+			req:  &csi.ControllerExpandVolumeRequest{VolumeId: "file-system-with-quota:10.0.10.207:/export-path", CapacityRange: &csi.CapacityRange{RequiredBytes: 100 * client.GiB}},
+			want: &csi.ControllerExpandVolumeResponse{CapacityBytes: 100 * client.GiB},
+		},
+		{
+			name: "Expand the quota of a file system to whole gigabytes",
+			req:  &csi.ControllerExpandVolumeRequest{VolumeId: "file-system-with-quota:10.0.10.207:/export-path", CapacityRange: &csi.CapacityRange{RequiredBytes: 100*client.GiB + 1}},
+			want: &csi.ControllerExpandVolumeResponse{CapacityBytes: 101 * client.GiB},
+		},
+		{
+			name: "Quota already larger than the requested size",
+			req:  &csi.ControllerExpandVolumeRequest{VolumeId: "file-system-with-quota:10.0.10.207:/export-path", CapacityRange: &csi.CapacityRange{RequiredBytes: 20 * client.GiB}},
+			want: &csi.ControllerExpandVolumeResponse{CapacityBytes: 50 * client.GiB},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &FSSControllerDriver{ControllerDriver{
+				KubeClient: nil,
+				logger:     zap.S(),
+				config:     &providercfg.Config{CompartmentID: ""},
+				client:     NewClientProvisioner(nil, nil, &MockFileStorageClient{}),
+				util:       &csi_util.Util{},
+			}}
+			got, err := d.ControllerExpandVolume(context.Background(), tt.req)
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("got error %q, want none", err)
+			}
+			if tt.wantErr != nil && (err == nil || !strings.Contains(err.Error(), tt.wantErr.Error())) {
+				t.Errorf("want error %q to include %q", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ControllerDriver.ControllerExpandVolume() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFSSControllerDriver_CreateSnapshot(t *testing.T) {
+	creationTime := timestamppb.New(time.Unix(1700000000, 0))
+	tests := []struct {
+		name    string
+		req     *csi.CreateSnapshotRequest
+		want    *csi.CreateSnapshotResponse
+		wantErr error
+	}{
+		{
+			name:    "Error for name not provided",
+			req:     &csi.CreateSnapshotRequest{SourceVolumeId: "oc1.filesystem.xxxx:10.0.10.207:/export-path"},
+			wantErr: errors.New("Volume snapshot name must be provided"),
+		},
+		{
+			name:    "Error for invalid source volume ID",
+			req:     &csi.CreateSnapshotRequest{Name: "snapshot", SourceVolumeId: "oc1.filesystem.xxxx"},
+			wantErr: errors.New("Invalid source Volume ID provided oc1.filesystem.xxxx"),
+		},
+		{
+			name:    "Error for invalid snapshot class parameters",
+			req:     &csi.CreateSnapshotRequest{Name: "snapshot", SourceVolumeId: "oc1.filesystem.xxxx:10.0.10.207:/export-path", Parameters: map[string]string{backupFreeformTags: "invalid"}},
+			wantErr: errors.New("failed to parse freeform tags"),
+		},
+		{
+			name:    "Error when snapshot creation fails",
+			req:     &csi.CreateSnapshotRequest{Name: "snapshot-create-fails", SourceVolumeId: "oc1.filesystem.xxxx:10.0.10.207:/export-path"},
+			wantErr: errors.New("Could not create snapshot"),
+		},
+		{
+			name: "Existing snapshot still creating",
+			req:  &csi.CreateSnapshotRequest{Name: "snapshot-creating", SourceVolumeId: "oc1.filesystem.xxxx:10.0.10.207:/export-path"},
+			want: &csi.CreateSnapshotResponse{Snapshot: &csi.Snapshot{
+				SnapshotId:     "fss-snapshot-creating",
+				SourceVolumeId: "oc1.filesystem.xxxx:10.0.10.207:/export-path",
+				CreationTime:   creationTime,
+				ReadyToUse:     false,
+			}},
+		},
+		{
+			name: "Create new snapshot",
+			req:  &csi.CreateSnapshotRequest{Name: "snapshot-new", SourceVolumeId: "oc1.filesystem.xxxx:10.0.10.207:/export-path"},
+			want: &csi.CreateSnapshotResponse{Snapshot: &csi.Snapshot{
+				SnapshotId:     "fss-snapshot-snapshot-new",
+				SourceVolumeId: "oc1.filesystem.xxxx:10.0.10.207:/export-path",
+				CreationTime:   creationTime,
+				ReadyToUse:     true,
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &FSSControllerDriver{ControllerDriver{
+				KubeClient: nil,
+				logger:     zap.S(),
+				config:     &providercfg.Config{CompartmentID: ""},
+				client:     NewClientProvisioner(nil, nil, &MockFileStorageClient{}),
+				util:       &csi_util.Util{},
+			}}
+			got, err := d.CreateSnapshot(context.Background(), tt.req)
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("got error %q, want none", err)
+			}
+			if tt.wantErr != nil && (err == nil || !strings.Contains(err.Error(), tt.wantErr.Error())) {
+				t.Errorf("want error %q to include %q", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ControllerDriver.CreateSnapshot() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFSSControllerDriver_DeleteSnapshot(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *csi.DeleteSnapshotRequest
+		want    *csi.DeleteSnapshotResponse
+		wantErr error
+	}{
+		{
+			name:    "Error for snapshot ID not provided",
+			req:     &csi.DeleteSnapshotRequest{},
+			wantErr: errors.New("SnapshotId must be provided"),
+		},
+		{
+			name:    "Error when snapshot deletion fails",
+			req:     &csi.DeleteSnapshotRequest{SnapshotId: "fss-snapshot-delete-fails"},
+			wantErr: errors.New("failed to delete snapshot"),
+		},
+		{
+			name: "Delete snapshot",
+			req:  &csi.DeleteSnapshotRequest{SnapshotId: "fss-snapshot-active"},
+			want: &csi.DeleteSnapshotResponse{},
+		},
+		{
+			name: "Delete already deleted snapshot",
+			req:  &csi.DeleteSnapshotRequest{SnapshotId: "fss-snapshot-missing"},
+			want: &csi.DeleteSnapshotResponse{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &FSSControllerDriver{ControllerDriver{
+				KubeClient: nil,
+				logger:     zap.S(),
+				config:     &providercfg.Config{CompartmentID: ""},
+				client:     NewClientProvisioner(nil, nil, &MockFileStorageClient{}),
+				util:       &csi_util.Util{},
+			}}
+			got, err := d.DeleteSnapshot(context.Background(), tt.req)
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("got error %q, want none", err)
+			}
+			if tt.wantErr != nil && (err == nil || !strings.Contains(err.Error(), tt.wantErr.Error())) {
+				t.Errorf("want error %q to include %q", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ControllerDriver.DeleteSnapshot() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFSSControllerDriver_ListSnapshots(t *testing.T) {
+	entry := func(id, sourceVolumeId string, readyToUse bool) *csi.ListSnapshotsResponse_Entry {
+		return &csi.ListSnapshotsResponse_Entry{
+			Snapshot: &csi.Snapshot{
+				SnapshotId:     id,
+				SourceVolumeId: sourceVolumeId,
+				CreationTime:   timestamppb.New(time.Unix(1700000000, 0)),
+				ReadyToUse:     readyToUse,
+			},
+		}
+	}
+	volumeId := "oc1.filesystem.xxxx:10.0.10.207:/export-path"
+	tests := []struct {
+		name    string
+		req     *csi.ListSnapshotsRequest
+		want    *csi.ListSnapshotsResponse
+		wantErr error
+	}{
+		{
+			name:    "Error for negative max entries",
+			req:     &csi.ListSnapshotsRequest{MaxEntries: -1},
+			wantErr: errors.New("max_entries must not be negative"),
+		},
+		{
+			name: "List snapshot by snapshot ID",
+			req:  &csi.ListSnapshotsRequest{SnapshotId: "fss-snapshot-active"},
+			want: &csi.ListSnapshotsResponse{
+				Entries: []*csi.ListSnapshotsResponse_Entry{entry("fss-snapshot-active", volumeId, true)},
+			},
+		},
+		{
+			name: "List snapshot by snapshot ID of another source volume",
+			req:  &csi.ListSnapshotsRequest{SnapshotId: "fss-snapshot-active", SourceVolumeId: "oc1.filesystem.yyyy:10.0.10.207:/export-path"},
+			want: &csi.ListSnapshotsResponse{},
+		},
+		{
+			name: "List snapshot taken to clone a volume by snapshot ID",
+			req:  &csi.ListSnapshotsRequest{SnapshotId: "fss-snapshot-clone"},
+			want: &csi.ListSnapshotsResponse{},
+		},
+		{
+			name: "List missing snapshot by snapshot ID",
+			req:  &csi.ListSnapshotsRequest{SnapshotId: "fss-snapshot-missing"},
+			want: &csi.ListSnapshotsResponse{},
+		},
+		{
+			name: "List snapshots by invalid source volume ID",
+			req:  &csi.ListSnapshotsRequest{SourceVolumeId: "oc1.filesystem.xxxx"},
+			want: &csi.ListSnapshotsResponse{},
+		},
+		{
+			name: "List snapshots of source volume",
+			req:  &csi.ListSnapshotsRequest{SourceVolumeId: "oc1.filesystem.yyyy:10.0.10.207:/export-path"},
+			want: &csi.ListSnapshotsResponse{
+				Entries: []*csi.ListSnapshotsResponse_Entry{entry("fss-snapshot-untagged", "oc1.filesystem.yyyy:10.0.10.207:/export-path", true)},
+			},
+		},
+		{
+			name: "List snapshots of source volume skips deleting snapshots",
+			req:  &csi.ListSnapshotsRequest{SourceVolumeId: volumeId},
+			want: &csi.ListSnapshotsResponse{
+				Entries: []*csi.ListSnapshotsResponse_Entry{
+					entry("fss-snapshot-active", volumeId, true),
+					entry("fss-snapshot-creating", volumeId, false),
+				},
+			},
+		},
+		{
+			name: "List all snapshots without a source volume",
+			req:  &csi.ListSnapshotsRequest{},
+			want: &csi.ListSnapshotsResponse{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &FSSControllerDriver{ControllerDriver{
+				KubeClient: nil,
+				logger:     zap.S(),
+				config:     &providercfg.Config{CompartmentID: ""},
+				client:     NewClientProvisioner(nil, nil, &MockFileStorageClient{}),
+				util:       &csi_util.Util{},
+			}}
+			got, err := d.ListSnapshots(context.Background(), tt.req)
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("got error %q, want none", err)
+			}
+			if tt.wantErr != nil && (err == nil || !strings.Contains(err.Error(), tt.wantErr.Error())) {
+				t.Errorf("want error %q to include %q", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ControllerDriver.ListSnapshots() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestExtractStorageClassParameters(t *testing.T) {
 	tests := map[string]struct {
 		parameters                     map[string]string
@@ -865,6 +1778,27 @@ func TestExtractStorageClassParameters(t *testing.T) {
 			wantErr:        false,
 			wantErrMessage: "",
 		},
+		"Extract storage class parameters with quota enforcement": {
+			parameters: map[string]string{
+				"availabilityDomain": "AD1",
+				"mountTargetOcid":    "oc1.mounttarget.xxxx",
+				"enforceQuota":       "true",
+			},
+			expectedStorageClassParameters: &StorageClassParameters{
+				availabilityDomain:    "AD1",
+				compartmentOcid:       "oc1.compartment.xxxx",
+				kmsKey:                "",
+				exportPath:            "/ut-volume",
+				exportOptions:         []filestorage.ClientOptions{},
+				mountTargetOcid:       "oc1.mounttarget.xxxx",
+				mountTargetSubnetOcid: "",
+				encryptInTransit:      "false",
+				enforceQuota:          true,
+				scTags:                &config.TagConfig{},
+			},
+			wantErr:        false,
+			wantErrMessage: "",
+		},
 		"Extract storage class parameters with different compartment": {
 			parameters: map[string]string{
 				"availabilityDomain": "AD1",
@@ -901,6 +1835,16 @@ func TestExtractStorageClassParameters(t *testing.T) {
 			wantErr:                        true,
 			wantErrMessage:                 "Neither Mount Target Ocid nor Mount Target Subnet Ocid provided in storage class",
 		},
+		"Error when enforceQuota is invalid": {
+			parameters: map[string]string{
+				"availabilityDomain": "AD1",
+				"mountTargetOcid":    "oc1.mounttarget.xxxx",
+				"enforceQuota":       "yes",
+			},
+			expectedStorageClassParameters: &StorageClassParameters{},
+			wantErr:                        true,
+			wantErrMessage:                 "invalid enforceQuota: yes provided for storage class. supported values are true and false",
+		},
 	}
 	ctx := context.Background()
 	for name, tt := range tests {
@@ -20,6 +20,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
@@ -27,21 +28,39 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	kubeAPI "k8s.io/api/core/v1"
+	"k8s.io/kubernetes/pkg/volume"
 	"k8s.io/mount-utils"
 
 	"github.com/container-storage-interface/spec/lib/go/csi"
 	csi_util "github.com/oracle/oci-cloud-controller-manager/pkg/csi-util"
 	"github.com/oracle/oci-cloud-controller-manager/pkg/util/disk"
+	"github.com/pkg/errors"
 )
 
 const (
 	mountPath                  = "mount"
 	FipsEnabled                = "1"
 	fssUnmountSemaphoreTimeout = time.Second * 30
+	fssVolumeStatsTimeout      = time.Second * 10
 )
 
 var fssUnmountSemaphore = semaphore.NewWeighted(int64(4))
 
+var errFssVolumeStatsTimeout = errors.New("timed out getting volume stats")
+
+// fssVolumeStatsInFlight maps the volume paths whose stat call has not returned yet to that
+// call, so that a hung NFS mount blocks at most one goroutine no matter how often kubelet polls it.
+var fssVolumeStatsInFlight sync.Map
+
+// fssVolumeStatCall is a stat call on a volume path, shared by the requests that arrive while
+// it runs.
+type fssVolumeStatCall struct {
+	start   time.Time
+	done    chan struct{}
+	metrics *volume.Metrics
+	err     error
+}
+
 // NodeStageVolume mounts the volume to a staging path on the node.
 func (d FSSNodeDriver) NodeStageVolume(ctx context.Context, req *csi.NodeStageVolumeRequest) (*csi.NodeStageVolumeResponse, error) {
 	if req.VolumeId == "" {
@@ -396,19 +415,19 @@ func (d FSSNodeDriver) unmountAndCleanup(logger *zap.SugaredLogger, targetPath s
 
 // NodeGetCapabilities returns the supported capabilities of the node server
 func (d FSSNodeDriver) NodeGetCapabilities(ctx context.Context, req *csi.NodeGetCapabilitiesRequest) (*csi.NodeGetCapabilitiesResponse, error) {
-	nscap := &csi.NodeServiceCapability{
-		Type: &csi.NodeServiceCapability_Rpc{
-			Rpc: &csi.NodeServiceCapability_RPC{
-				Type: csi.NodeServiceCapability_RPC_STAGE_UNSTAGE_VOLUME,
+	nodeCaps := []csi.NodeServiceCapability_RPC_Type{csi.NodeServiceCapability_RPC_STAGE_UNSTAGE_VOLUME, csi.NodeServiceCapability_RPC_GET_VOLUME_STATS, csi.NodeServiceCapability_RPC_VOLUME_CONDITION}
+	var caps []*csi.NodeServiceCapability
+	for _, nodeCap := range nodeCaps {
+		c := &csi.NodeServiceCapability{
+			Type: &csi.NodeServiceCapability_Rpc{
+				Rpc: &csi.NodeServiceCapability_RPC{
+					Type: nodeCap,
+				},
 			},
-		},
+		}
+		caps = append(caps, c)
 	}
-
-	return &csi.NodeGetCapabilitiesResponse{
-		Capabilities: []*csi.NodeServiceCapability{
-			nscap,
-		},
-	}, nil
+	return &csi.NodeGetCapabilitiesResponse{Capabilities: caps}, nil
 }
 
 // NodeGetInfo returns the supported capabilities of the node server.
@@ -436,7 +455,100 @@ func (d FSSNodeDriver) NodeGetInfo(ctx context.Context, req *csi.NodeGetInfoRequ
 
 // NodeGetVolumeStats return the stats of the volume
 func (d FSSNodeDriver) NodeGetVolumeStats(ctx context.Context, req *csi.NodeGetVolumeStatsRequest) (*csi.NodeGetVolumeStatsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "NodeGetVolumeStats is not supported yet")
+	logger := d.logger.With("volumeID", req.VolumeId, "volumePath", req.VolumePath)
+
+	volumeID := req.GetVolumeId()
+	if len(volumeID) == 0 {
+		logger.Errorf("Volume ID not provided")
+		return nil, status.Error(codes.InvalidArgument, "Volume ID not provided")
+	}
+	volumePath := req.GetVolumePath()
+	if len(volumePath) == 0 {
+		logger.Errorf("Volume path not provided")
+		return nil, status.Error(codes.InvalidArgument, "volume path must be provided")
+	}
+
+	metrics, err := statFssVolume(volumePath, fssVolumeStatsTimeout, getFssVolumeMetrics)
+	if err != nil {
+		switch {
+		case err == errFssVolumeStatsTimeout:
+			logger.Errorf("Timed out getting stats of volume path %s, the NFS mount may be hung", volumePath)
+			return abnormalFssVolumeStats(fmt.Sprintf("timed out after %s getting stats of volume path %s, the NFS mount may be hung", fssVolumeStatsTimeout, volumePath)), nil
+		case os.IsNotExist(err):
+			logger.Infof("Path does not exist %s", volumePath)
+			return nil, status.Errorf(codes.NotFound, "path %s does not exist", volumePath)
+		case mount.IsCorruptedMnt(err):
+			logger.With(zap.Error(err)).Errorf("NFS mount on volume path %s is stale", volumePath)
+			return abnormalFssVolumeStats(fmt.Sprintf("NFS mount on volume path %s is stale: %v", volumePath, err)), nil
+		default:
+			logger.With(zap.Error(err)).Errorf("failed to get file system info on path %s: %v", volumePath, err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &csi.NodeGetVolumeStatsResponse{
+		Usage: []*csi.VolumeUsage{
+			{
+				Unit:      csi.VolumeUsage_BYTES,
+				Available: metrics.Available.AsDec().UnscaledBig().Int64(),
+				Total:     metrics.Capacity.AsDec().UnscaledBig().Int64(),
+				Used:      metrics.Used.AsDec().UnscaledBig().Int64(),
+			},
+			{
+				Unit:      csi.VolumeUsage_INODES,
+				Available: metrics.InodesFree.AsDec().UnscaledBig().Int64(),
+				Total:     metrics.Inodes.AsDec().UnscaledBig().Int64(),
+				Used:      metrics.InodesUsed.AsDec().UnscaledBig().Int64(),
+			},
+		},
+		VolumeCondition: &csi.VolumeCondition{
+			Abnormal: false,
+			Message:  "NFS mount is healthy",
+		},
+	}, nil
+}
+
+// statFssVolume runs getMetrics on the volume path and gives up after timeout, since stat
+// calls on an NFS mount whose mount target is unreachable block indefinitely.
+func statFssVolume(volumePath string, timeout time.Duration, getMetrics func(string) (*volume.Metrics, error)) (*volume.Metrics, error) {
+	call := &fssVolumeStatCall{start: time.Now(), done: make(chan struct{})}
+	if inFlight, loaded := fssVolumeStatsInFlight.LoadOrStore(volumePath, call); loaded {
+		// wait on the pending call, it only counts as timed out once it has run for longer than timeout
+		call = inFlight.(*fssVolumeStatCall)
+	} else {
+		go func() {
+			call.metrics, call.err = getMetrics(volumePath)
+			fssVolumeStatsInFlight.Delete(volumePath)
+			close(call.done)
+		}()
+	}
+
+	timer := time.NewTimer(timeout - time.Since(call.start))
+	defer timer.Stop()
+	select {
+	case <-call.done:
+		return call.metrics, call.err
+	case <-timer.C:
+		return nil, errFssVolumeStatsTimeout
+	}
+}
+
+// getFssVolumeMetrics stats the volume path before calling statfs so that a stale NFS file handle
+// surfaces as the underlying *os.PathError.
+func getFssVolumeMetrics(volumePath string) (*volume.Metrics, error) {
+	if _, err := os.Stat(volumePath); err != nil {
+		return nil, err
+	}
+	return volume.NewMetricsStatFS(volumePath).GetMetrics()
+}
+
+func abnormalFssVolumeStats(message string) *csi.NodeGetVolumeStatsResponse {
+	return &csi.NodeGetVolumeStatsResponse{
+		VolumeCondition: &csi.VolumeCondition{
+			Abnormal: true,
+			Message:  message,
+		},
+	}
 }
 
 // NodeExpandVolume returns the expand of the volume
@@ -0,0 +1,174 @@
+// Copyright 2024 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/kubernetes/pkg/volume"
+	"k8s.io/mount-utils"
+)
+
+func Test_statFssVolume(t *testing.T) {
+	hung := make(chan struct{})
+	defer close(hung)
+
+	tests := []struct {
+		name       string
+		volumePath string
+		getMetrics func(string) (*volume.Metrics, error)
+		wantErr    func(error) bool
+	}{
+		{
+			name:       "Returns metrics of a healthy mount",
+			volumePath: "/healthy",
+			getMetrics: func(string) (*volume.Metrics, error) { return &volume.Metrics{}, nil },
+			wantErr:    func(err error) bool { return err == nil },
+		},
+		{
+			name:       "Returns the error of a stale mount",
+			volumePath: "/stale",
+			getMetrics: func(path string) (*volume.Metrics, error) {
+				return nil, &os.PathError{Op: "stat", Path: path, Err: syscall.ESTALE}
+			},
+			wantErr: mount.IsCorruptedMnt,
+		},
+		{
+			name:       "Times out on a hung mount",
+			volumePath: "/hung",
+			getMetrics: func(string) (*volume.Metrics, error) {
+				<-hung
+				return &volume.Metrics{}, nil
+			},
+			wantErr: func(err error) bool { return err == errFssVolumeStatsTimeout },
+		},
+		{
+			name:       "Does not stat a mount whose previous stat is still pending",
+			volumePath: "/hung",
+			getMetrics: func(string) (*volume.Metrics, error) {
+				t.Error("stat called on a mount with a pending stat")
+				return &volume.Metrics{}, nil
+			},
+			wantErr: func(err error) bool { return err == errFssVolumeStatsTimeout },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := statFssVolume(tt.volumePath, 100*time.Millisecond, tt.getMetrics)
+			if !tt.wantErr(err) {
+				t.Errorf("statFssVolume() got unexpected error %v", err)
+			}
+		})
+	}
+}
+
+func Test_statFssVolume_concurrent(t *testing.T) {
+	slow := make(chan struct{})
+	started := make(chan struct{})
+	getMetrics := func(string) (*volume.Metrics, error) {
+		close(started)
+		<-slow
+		return &volume.Metrics{}, nil
+	}
+
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := statFssVolume("/slow", time.Second, getMetrics)
+		firstErr <- err
+	}()
+	<-started
+
+	secondErr := make(chan error, 1)
+	go func() {
+		_, err := statFssVolume("/slow", time.Second, func(string) (*volume.Metrics, error) {
+			t.Error("stat called on a mount with a pending stat")
+			return &volume.Metrics{}, nil
+		})
+		secondErr <- err
+	}()
+
+	// the pending stat returns well within the timeout, so neither request is abnormal
+	time.Sleep(50 * time.Millisecond)
+	close(slow)
+	if err := <-firstErr; err != nil {
+		t.Errorf("statFssVolume() got unexpected error %v", err)
+	}
+	if err := <-secondErr; err != nil {
+		t.Errorf("statFssVolume() on a pending stat got unexpected error %v", err)
+	}
+}
+
+func TestFSSNodeDriver_NodeGetVolumeStats(t *testing.T) {
+	volumePath := t.TempDir()
+	d := FSSNodeDriver{NodeDriver{logger: zap.S()}}
+
+	tests := []struct {
+		name         string
+		req          *csi.NodeGetVolumeStatsRequest
+		wantCode     codes.Code
+		wantAbnormal bool
+	}{
+		{
+			name:     "Error for empty volume ID",
+			req:      &csi.NodeGetVolumeStatsRequest{VolumePath: volumePath},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Error for empty volume path",
+			req:      &csi.NodeGetVolumeStatsRequest{VolumeId: "oc1.filesystem.xxxx:10.0.10.207:/export-path"},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Error for volume path that does not exist",
+			req:      &csi.NodeGetVolumeStatsRequest{VolumeId: "oc1.filesystem.xxxx:10.0.10.207:/export-path", VolumePath: filepath.Join(volumePath, "missing")},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "Stats of a mounted volume",
+			req:      &csi.NodeGetVolumeStatsRequest{VolumeId: "oc1.filesystem.xxxx:10.0.10.207:/export-path", VolumePath: volumePath},
+			wantCode: codes.OK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := d.NodeGetVolumeStats(context.Background(), tt.req)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("NodeGetVolumeStats() got error %v, want code %v", err, tt.wantCode)
+			}
+			if err != nil {
+				return
+			}
+			if got.GetVolumeCondition().GetAbnormal() != tt.wantAbnormal {
+				t.Errorf("NodeGetVolumeStats() got volume condition %v", got.GetVolumeCondition())
+			}
+			if len(got.GetUsage()) != 2 || got.GetUsage()[0].GetTotal() <= 0 {
+				t.Errorf("NodeGetVolumeStats() got usage %v", got.GetUsage())
+			}
+			if !strings.Contains(got.GetVolumeCondition().GetMessage(), "healthy") {
+				t.Errorf("NodeGetVolumeStats() got volume condition message %q", got.GetVolumeCondition().GetMessage())
+			}
+		})
+	}
+}
@@ -48,6 +48,8 @@ const (
 	PVExpand = "PV_EXPAND"
 	// PVClone is the OCI metric for PV Clone
 	PVClone = "PV_CLONE"
+	// PVModify is the OCI metric suffix for PV Modify
+	PVModify = "PV_MODIFY"
 
 	// FSSProvision is the OCI metric suffix for FSS provision
 	FSSProvision = "FSS_PROVISION"
@@ -70,6 +72,20 @@ const (
 	BlockSnapshotDelete = "BSNAP_DELETE"
 	// BlockSnapshotRestore is the OCI metric suffix for Block Volume Snapshot Restore
 	BlockSnapshotRestore = "BSNAP_RESTORE"
+	// BlockGroupSnapshotProvision is the OCI metric suffix for Block Volume Group Snapshot Provision
+	BlockGroupSnapshotProvision = "BGSNAP_PROVISION"
+	// BlockGroupSnapshotDelete is the OCI metric suffix for Block Volume Group Snapshot Delete
+	BlockGroupSnapshotDelete = "BGSNAP_DELETE"
+
+	// FSSSnapshotProvision is the OCI metric suffix for File System Snapshot Provision
+	FSSSnapshotProvision = "FSNAP_PROVISION"
+	// FSSSnapshotDelete is the OCI metric suffix for File System Snapshot Delete
+	FSSSnapshotDelete = "FSNAP_DELETE"
+	// FSSSnapshotRestore is the OCI metric suffix for File System Snapshot Restore
+	FSSSnapshotRestore = "FSNAP_RESTORE"
+
+	// FSSExpand is the OCI metric suffix for File System quota expansion
+	FSSExpand = "FSS_EXPAND"
 
 	// FssAllProvision is the OCI metric suffix for FSS end to end provision
 	FssAllProvision = "FSS_ALL_PROVISION"
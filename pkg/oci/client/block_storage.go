@@ -41,6 +41,7 @@ const (
 	volumePollInterval       = 5 * time.Second
 	volumeBackupPollInterval = 5 * time.Second
 	volumeClonePollInterval  = 10 * time.Second
+	volumeGroupPollInterval  = 5 * time.Second
 	// OCIVolumeID is the name of the oci volume id.
 	OCIVolumeID = "ociVolumeID"
 	// OCIVolumeBackupID is the name of the oci volume backup id annotation.
@@ -65,7 +66,29 @@ type BlockStorageInterface interface {
 	CreateVolumeBackup(ctx context.Context, details core.CreateVolumeBackupDetails) (*core.VolumeBackup, error)
 	DeleteVolumeBackup(ctx context.Context, id string) error
 	GetVolumeBackup(ctx context.Context, id string) (*core.VolumeBackup, error)
+	GetVolumeBackupInRegion(ctx context.Context, id, region string) (*core.VolumeBackup, error)
 	GetVolumeBackupsByName(ctx context.Context, snapshotName, compartmentID string) ([]core.VolumeBackup, error)
+	ListVolumeBackups(ctx context.Context, compartmentID, volumeID string, limit int, page string) ([]core.VolumeBackup, string, error)
+	CopyVolumeBackup(ctx context.Context, id, destinationRegion, displayName string) (*core.VolumeBackup, error)
+	GetVolumeBackupCopy(ctx context.Context, sourceVolumeBackup *core.VolumeBackup, region string) (*core.VolumeBackup, error)
+
+	GetVolumeBackupPolicy(ctx context.Context, id string) (*core.VolumeBackupPolicy, error)
+	GetVolumeBackupPoliciesByName(ctx context.Context, policyName, compartmentID string) ([]core.VolumeBackupPolicy, error)
+	CreateVolumeBackupPolicyAssignment(ctx context.Context, volumeID, policyID string) (*core.VolumeBackupPolicyAssignment, error)
+	DeleteVolumeBackupPolicyAssignment(ctx context.Context, id string) error
+	GetVolumeBackupPolicyAssignments(ctx context.Context, volumeID string) ([]core.VolumeBackupPolicyAssignment, error)
+
+	AwaitVolumeGroupAvailableOrTimeout(ctx context.Context, id string) (*core.VolumeGroup, error)
+	CreateVolumeGroup(ctx context.Context, details core.CreateVolumeGroupDetails) (*core.VolumeGroup, error)
+	DeleteVolumeGroup(ctx context.Context, id string) error
+	GetVolumeGroup(ctx context.Context, id string) (*core.VolumeGroup, error)
+	GetVolumeGroupsByName(ctx context.Context, volumeGroupName, compartmentID string) ([]core.VolumeGroup, error)
+
+	AwaitVolumeGroupBackupAvailableOrTimeout(ctx context.Context, id string) (*core.VolumeGroupBackup, error)
+	CreateVolumeGroupBackup(ctx context.Context, details core.CreateVolumeGroupBackupDetails) (*core.VolumeGroupBackup, error)
+	DeleteVolumeGroupBackup(ctx context.Context, id string) error
+	GetVolumeGroupBackup(ctx context.Context, id string) (*core.VolumeGroupBackup, error)
+	GetVolumeGroupBackupsByName(ctx context.Context, groupSnapshotName, compartmentID string) ([]core.VolumeGroupBackup, error)
 }
 
 func (c *client) GetVolume(ctx context.Context, id string) (*core.Volume, error) {
@@ -93,18 +116,24 @@ func (c *client) GetVolume(ctx context.Context, id string) (*core.Volume, error)
 }
 
 func (c *client) GetVolumeBackup(ctx context.Context, id string) (*core.VolumeBackup, error) {
+	return c.GetVolumeBackupInRegion(ctx, id, "")
+}
+
+// GetVolumeBackupInRegion returns a volume backup in the given region, or in
+// the region of the client when region is empty.
+func (c *client) GetVolumeBackupInRegion(ctx context.Context, id, region string) (*core.VolumeBackup, error) {
 	if !c.rateLimiter.Reader.TryAccept() {
 		return nil, RateLimitError(false, "GetVolumeBackup")
 	}
 
-	resp, err := c.bs.GetVolumeBackup(ctx, core.GetVolumeBackupRequest{
+	resp, err := c.blockStorageInRegion(region).GetVolumeBackup(ctx, core.GetVolumeBackupRequest{
 		VolumeBackupId:  &id,
 		RequestMetadata: c.requestMetadata})
 	incRequestCounter(err, getVerb, volumeBackupResource)
 
 	if resp.OpcRequestId != nil {
 		c.logger.With("service", "blockstorage", "verb", getVerb, "resource", volumeBackupResource).
-			With("volumeBackupId", id, "OpcRequestId", *(resp.OpcRequestId)).With("statusCode", util.GetHttpStatusCode(err)).
+			With("volumeBackupId", id, "region", region, "OpcRequestId", *(resp.OpcRequestId)).With("statusCode", util.GetHttpStatusCode(err)).
 			Info("OPC Request ID recorded for GetVolumeBackup call.")
 	}
 
@@ -412,3 +441,579 @@ func (c *client) GetVolumeBackupsByName(ctx context.Context, snapshotName, compa
 
 	return volumeBackupList, nil
 }
+
+// ListVolumeBackups returns a single page of volume backups in the compartment,
+// optionally restricted to the backups of volumeID. A limit of zero lets the
+// service pick the page size. The returned page token is empty on the last page.
+func (c *client) ListVolumeBackups(ctx context.Context, compartmentID, volumeID string, limit int, page string) ([]core.VolumeBackup, string, error) {
+	if !c.rateLimiter.Reader.TryAccept() {
+		return nil, "", RateLimitError(false, "ListVolumeBackups")
+	}
+
+	request := core.ListVolumeBackupsRequest{
+		CompartmentId:   &compartmentID,
+		RequestMetadata: c.requestMetadata,
+	}
+	if volumeID != "" {
+		request.VolumeId = &volumeID
+	}
+	if limit > 0 {
+		request.Limit = &limit
+	}
+	if page != "" {
+		request.Page = &page
+	}
+
+	resp, err := c.bs.ListVolumeBackups(ctx, request)
+	incRequestCounter(err, listVerb, volumeBackupResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", listVerb, "resource", volumeBackupResource).
+			With("volumeId", volumeID, "CompartmentID", compartmentID, "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for ListVolumeBackups call.")
+	}
+
+	if err != nil {
+		return nil, "", errors.WithStack(err)
+	}
+
+	nextPage := ""
+	if resp.OpcNextPage != nil {
+		nextPage = *resp.OpcNextPage
+	}
+	return resp.Items, nextPage, nil
+}
+
+// CopyVolumeBackup starts copying a volume backup to the destination region
+// and returns the copy, which is created in the compartment of the backup.
+func (c *client) CopyVolumeBackup(ctx context.Context, id, destinationRegion, displayName string) (*core.VolumeBackup, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return nil, RateLimitError(true, "CopyVolumeBackup")
+	}
+
+	resp, err := c.bs.CopyVolumeBackup(ctx, core.CopyVolumeBackupRequest{
+		VolumeBackupId: &id,
+		CopyVolumeBackupDetails: core.CopyVolumeBackupDetails{
+			DestinationRegion: &destinationRegion,
+			DisplayName:       &displayName,
+		},
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, createVerb, volumeBackupResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", createVerb, "resource", volumeBackupResource).
+			With("volumeBackupId", id, "destinationRegion", destinationRegion, "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).Info("OPC Request ID recorded for CopyVolumeBackup call.")
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &resp.VolumeBackup, nil
+}
+
+// GetVolumeBackupCopy returns the copy of a volume backup in the given region,
+// or in the region of the client when region is empty. It returns nil when the
+// backup has not been copied to the region. Copies keep the compartment of the
+// source backup, so that is where they are listed. A faulty copy is only
+// returned when there is no other copy, e.g. one retrying the copy.
+func (c *client) GetVolumeBackupCopy(ctx context.Context, sourceVolumeBackup *core.VolumeBackup, region string) (*core.VolumeBackup, error) {
+	bs := c.blockStorageInRegion(region)
+	sourceVolumeBackupID := *sourceVolumeBackup.Id
+	var page *string
+	var faulty *core.VolumeBackup
+	for {
+		if !c.rateLimiter.Reader.TryAccept() {
+			return nil, RateLimitError(false, "ListVolumeBackups")
+		}
+
+		resp, err := bs.ListVolumeBackups(ctx, core.ListVolumeBackupsRequest{
+			CompartmentId:        sourceVolumeBackup.CompartmentId,
+			SourceVolumeBackupId: &sourceVolumeBackupID,
+			Page:                 page,
+			RequestMetadata:      c.requestMetadata,
+		})
+		incRequestCounter(err, listVerb, volumeBackupResource)
+
+		if resp.OpcRequestId != nil {
+			c.logger.With("service", "blockstorage", "verb", listVerb, "resource", volumeBackupResource).
+				With("sourceVolumeBackupId", sourceVolumeBackupID, "region", region, "OpcRequestId", *(resp.OpcRequestId)).
+				With("statusCode", util.GetHttpStatusCode(err)).
+				Info("OPC Request ID recorded while fetching volume backup copies.")
+		}
+
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		for _, volumeBackup := range resp.Items {
+			switch volumeBackup.LifecycleState {
+			case core.VolumeBackupLifecycleStateTerminating, core.VolumeBackupLifecycleStateTerminated:
+			case core.VolumeBackupLifecycleStateFaulty:
+				if faulty == nil {
+					backupCopy := volumeBackup
+					faulty = &backupCopy
+				}
+			default:
+				return &volumeBackup, nil
+			}
+		}
+
+		if page = resp.OpcNextPage; page == nil {
+			return faulty, nil
+		}
+	}
+}
+
+func (c *client) GetVolumeBackupPolicy(ctx context.Context, id string) (*core.VolumeBackupPolicy, error) {
+	if !c.rateLimiter.Reader.TryAccept() {
+		return nil, RateLimitError(false, "GetVolumeBackupPolicy")
+	}
+
+	resp, err := c.bs.GetVolumeBackupPolicy(ctx, core.GetVolumeBackupPolicyRequest{
+		PolicyId:        &id,
+		RequestMetadata: c.requestMetadata})
+	incRequestCounter(err, getVerb, volumeBackupPolicyResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", getVerb, "resource", volumeBackupPolicyResource).
+			With("policyId", id, "OpcRequestId", *(resp.OpcRequestId)).With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for GetVolumeBackupPolicy call.")
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &resp.VolumeBackupPolicy, nil
+}
+
+// GetVolumeBackupPoliciesByName returns the volume backup policies with the
+// given display name, looking at both the Oracle defined policies and the
+// user defined policies of the compartment.
+func (c *client) GetVolumeBackupPoliciesByName(ctx context.Context, policyName, compartmentID string) ([]core.VolumeBackupPolicy, error) {
+	policyList := make([]core.VolumeBackupPolicy, 0)
+
+	// Oracle defined policies are listed when no compartment is given
+	for _, compartment := range []string{"", compartmentID} {
+		var page *string
+		for {
+			if !c.rateLimiter.Reader.TryAccept() {
+				return nil, RateLimitError(false, "ListVolumeBackupPolicies")
+			}
+
+			request := core.ListVolumeBackupPoliciesRequest{
+				Page:            page,
+				RequestMetadata: c.requestMetadata,
+			}
+			if compartment != "" {
+				request.CompartmentId = &compartment
+			}
+
+			resp, err := c.bs.ListVolumeBackupPolicies(ctx, request)
+			incRequestCounter(err, listVerb, volumeBackupPolicyResource)
+
+			if resp.OpcRequestId != nil {
+				c.logger.With("service", "blockstorage", "verb", listVerb, "resource", volumeBackupPolicyResource).
+					With("policyName", policyName, "CompartmentID", compartment, "OpcRequestId", *(resp.OpcRequestId)).
+					With("statusCode", util.GetHttpStatusCode(err)).
+					Info("OPC Request ID recorded while fetching volume backup policies by name.")
+			}
+
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+
+			for _, policy := range resp.Items {
+				if policy.DisplayName != nil && *policy.DisplayName == policyName {
+					policyList = append(policyList, policy)
+				}
+			}
+
+			if page = resp.OpcNextPage; page == nil {
+				break
+			}
+		}
+	}
+
+	return policyList, nil
+}
+
+func (c *client) CreateVolumeBackupPolicyAssignment(ctx context.Context, volumeID, policyID string) (*core.VolumeBackupPolicyAssignment, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return nil, RateLimitError(true, "CreateVolumeBackupPolicyAssignment")
+	}
+
+	resp, err := c.bs.CreateVolumeBackupPolicyAssignment(ctx, core.CreateVolumeBackupPolicyAssignmentRequest{
+		CreateVolumeBackupPolicyAssignmentDetails: core.CreateVolumeBackupPolicyAssignmentDetails{
+			AssetId:  &volumeID,
+			PolicyId: &policyID,
+		},
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, createVerb, policyAssignmentResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", createVerb, "resource", policyAssignmentResource).
+			With("volumeId", volumeID, "policyId", policyID, "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for CreateVolumeBackupPolicyAssignment call.")
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &resp.VolumeBackupPolicyAssignment, nil
+}
+
+func (c *client) DeleteVolumeBackupPolicyAssignment(ctx context.Context, id string) error {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return RateLimitError(true, "DeleteVolumeBackupPolicyAssignment")
+	}
+
+	resp, err := c.bs.DeleteVolumeBackupPolicyAssignment(ctx, core.DeleteVolumeBackupPolicyAssignmentRequest{
+		PolicyAssignmentId: &id,
+		RequestMetadata:    c.requestMetadata})
+	incRequestCounter(err, deleteVerb, policyAssignmentResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", deleteVerb, "resource", policyAssignmentResource).
+			With("policyAssignmentId", id, "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for DeleteVolumeBackupPolicyAssignment call.")
+	}
+
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// GetVolumeBackupPolicyAssignments returns the backup policy assignments of a
+// volume. A volume has at most one assignment.
+func (c *client) GetVolumeBackupPolicyAssignments(ctx context.Context, volumeID string) ([]core.VolumeBackupPolicyAssignment, error) {
+	if !c.rateLimiter.Reader.TryAccept() {
+		return nil, RateLimitError(false, "GetVolumeBackupPolicyAssetAssignment")
+	}
+
+	resp, err := c.bs.GetVolumeBackupPolicyAssetAssignment(ctx, core.GetVolumeBackupPolicyAssetAssignmentRequest{
+		AssetId:         &volumeID,
+		RequestMetadata: c.requestMetadata})
+	incRequestCounter(err, getVerb, policyAssignmentResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", getVerb, "resource", policyAssignmentResource).
+			With("volumeId", volumeID, "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for GetVolumeBackupPolicyAssetAssignment call.")
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return resp.Items, nil
+}
+
+// blockStorageInRegion returns a block storage client for the given region,
+// sharing the configuration of the client of the cluster region.
+func (c *client) blockStorageInRegion(region string) blockstorageClient {
+	bs, ok := c.bs.(*core.BlockstorageClient)
+	if !ok || region == "" {
+		return c.bs
+	}
+	regional := *bs
+	regional.SetRegion(region)
+	return &regional
+}
+
+func (c *client) GetVolumeGroup(ctx context.Context, id string) (*core.VolumeGroup, error) {
+	if !c.rateLimiter.Reader.TryAccept() {
+		return nil, RateLimitError(false, "GetVolumeGroup")
+	}
+
+	resp, err := c.bs.GetVolumeGroup(ctx, core.GetVolumeGroupRequest{
+		VolumeGroupId:   &id,
+		RequestMetadata: c.requestMetadata})
+	incRequestCounter(err, getVerb, volumeGroupResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", getVerb, "resource", volumeGroupResource).
+			With("volumeGroupId", id, "OpcRequestId", *(resp.OpcRequestId)).With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for GetVolumeGroup call.")
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &resp.VolumeGroup, nil
+}
+
+func (c *client) CreateVolumeGroup(ctx context.Context, details core.CreateVolumeGroupDetails) (*core.VolumeGroup, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return nil, RateLimitError(true, "CreateVolumeGroup")
+	}
+
+	resp, err := c.bs.CreateVolumeGroup(ctx, core.CreateVolumeGroupRequest{CreateVolumeGroupDetails: details,
+		RequestMetadata: c.requestMetadata})
+	incRequestCounter(err, createVerb, volumeGroupResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", createVerb, "resource", volumeGroupResource).
+			With("volumeGroupName", *(details.DisplayName), "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).Info("OPC Request ID recorded for CreateVolumeGroup call.")
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &resp.VolumeGroup, nil
+}
+
+func (c *client) DeleteVolumeGroup(ctx context.Context, id string) error {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return RateLimitError(true, "DeleteVolumeGroup")
+	}
+
+	resp, err := c.bs.DeleteVolumeGroup(ctx, core.DeleteVolumeGroupRequest{
+		VolumeGroupId:   &id,
+		RequestMetadata: c.requestMetadata})
+	incRequestCounter(err, deleteVerb, volumeGroupResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", deleteVerb, "resource", volumeGroupResource).
+			With("volumeGroupId", id, "OpcRequestId", *(resp.OpcRequestId)).With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for DeleteVolumeGroup call.")
+	}
+
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (c *client) GetVolumeGroupsByName(ctx context.Context, volumeGroupName, compartmentID string) ([]core.VolumeGroup, error) {
+	var page *string
+	volumeGroupList := make([]core.VolumeGroup, 0)
+
+	for {
+		if !c.rateLimiter.Reader.TryAccept() {
+			return nil, RateLimitError(false, "ListVolumeGroups")
+		}
+
+		listVolumeGroupsResponse, err := c.bs.ListVolumeGroups(ctx,
+			core.ListVolumeGroupsRequest{
+				CompartmentId:   &compartmentID,
+				Page:            page,
+				DisplayName:     &volumeGroupName,
+				RequestMetadata: c.requestMetadata,
+			})
+		incRequestCounter(err, listVerb, volumeGroupResource)
+
+		if listVolumeGroupsResponse.OpcRequestId != nil {
+			c.logger.With("service", "blockstorage", "verb", listVerb, "resource", volumeGroupResource).
+				With("volumeGroupName", volumeGroupName, "CompartmentID", compartmentID, "OpcRequestId", *(listVolumeGroupsResponse.OpcRequestId)).
+				With("statusCode", util.GetHttpStatusCode(err)).
+				Info("OPC Request ID recorded while fetching volume groups by name.")
+		}
+
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		for _, volumeGroup := range listVolumeGroupsResponse.Items {
+			volumeGroupState := volumeGroup.LifecycleState
+			if volumeGroupState == core.VolumeGroupLifecycleStateAvailable ||
+				volumeGroupState == core.VolumeGroupLifecycleStateProvisioning {
+				volumeGroupList = append(volumeGroupList, volumeGroup)
+			}
+		}
+
+		if page = listVolumeGroupsResponse.OpcNextPage; page == nil {
+			break
+		}
+	}
+
+	return volumeGroupList, nil
+}
+
+// AwaitVolumeGroupAvailableOrTimeout takes context as timeout
+func (c *client) AwaitVolumeGroupAvailableOrTimeout(ctx context.Context, id string) (*core.VolumeGroup, error) {
+	var volumeGroup *core.VolumeGroup
+	if err := wait.PollImmediateUntil(volumeGroupPollInterval, func() (bool, error) {
+		var err error
+		volumeGroup, err = c.GetVolumeGroup(ctx, id)
+		if err != nil {
+			if !IsRetryable(err) {
+				return false, err
+			}
+			return false, nil
+		}
+
+		switch state := volumeGroup.LifecycleState; state {
+		case core.VolumeGroupLifecycleStateAvailable:
+			return true, nil
+		case core.VolumeGroupLifecycleStateFaulty,
+			core.VolumeGroupLifecycleStateTerminated,
+			core.VolumeGroupLifecycleStateTerminating:
+			return false, errors.Errorf("volume group did not become available (lifecycleState=%q)", state)
+		}
+		return false, nil
+	}, ctx.Done()); err != nil {
+		return nil, err
+	}
+
+	return volumeGroup, nil
+}
+
+func (c *client) GetVolumeGroupBackup(ctx context.Context, id string) (*core.VolumeGroupBackup, error) {
+	if !c.rateLimiter.Reader.TryAccept() {
+		return nil, RateLimitError(false, "GetVolumeGroupBackup")
+	}
+
+	resp, err := c.bs.GetVolumeGroupBackup(ctx, core.GetVolumeGroupBackupRequest{
+		VolumeGroupBackupId: &id,
+		RequestMetadata:     c.requestMetadata})
+	incRequestCounter(err, getVerb, volumeGroupBackupResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", getVerb, "resource", volumeGroupBackupResource).
+			With("volumeGroupBackupId", id, "OpcRequestId", *(resp.OpcRequestId)).With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for GetVolumeGroupBackup call.")
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &resp.VolumeGroupBackup, nil
+}
+
+func (c *client) CreateVolumeGroupBackup(ctx context.Context, details core.CreateVolumeGroupBackupDetails) (*core.VolumeGroupBackup, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return nil, RateLimitError(true, "CreateVolumeGroupBackup")
+	}
+
+	resp, err := c.bs.CreateVolumeGroupBackup(ctx, core.CreateVolumeGroupBackupRequest{CreateVolumeGroupBackupDetails: details,
+		RequestMetadata: c.requestMetadata})
+	incRequestCounter(err, createVerb, volumeGroupBackupResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", createVerb, "resource", volumeGroupBackupResource).
+			With("volumeGroupBackupName", *(details.DisplayName), "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).Info("OPC Request ID recorded for CreateVolumeGroupBackup call.")
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &resp.VolumeGroupBackup, nil
+}
+
+func (c *client) DeleteVolumeGroupBackup(ctx context.Context, id string) error {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return RateLimitError(true, "DeleteVolumeGroupBackup")
+	}
+
+	resp, err := c.bs.DeleteVolumeGroupBackup(ctx, core.DeleteVolumeGroupBackupRequest{
+		VolumeGroupBackupId: &id,
+		RequestMetadata:     c.requestMetadata})
+	incRequestCounter(err, deleteVerb, volumeGroupBackupResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", deleteVerb, "resource", volumeGroupBackupResource).
+			With("volumeGroupBackupId", id, "OpcRequestId", *(resp.OpcRequestId)).With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for DeleteVolumeGroupBackup call.")
+	}
+
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (c *client) GetVolumeGroupBackupsByName(ctx context.Context, groupSnapshotName, compartmentID string) ([]core.VolumeGroupBackup, error) {
+	var page *string
+	volumeGroupBackupList := make([]core.VolumeGroupBackup, 0)
+
+	for {
+		if !c.rateLimiter.Reader.TryAccept() {
+			return nil, RateLimitError(false, "ListVolumeGroupBackups")
+		}
+
+		listVolumeGroupBackupsResponse, err := c.bs.ListVolumeGroupBackups(ctx,
+			core.ListVolumeGroupBackupsRequest{
+				CompartmentId:   &compartmentID,
+				Page:            page,
+				DisplayName:     &groupSnapshotName,
+				RequestMetadata: c.requestMetadata,
+			})
+		incRequestCounter(err, listVerb, volumeGroupBackupResource)
+
+		if listVolumeGroupBackupsResponse.OpcRequestId != nil {
+			c.logger.With("service", "blockstorage", "verb", listVerb, "resource", volumeGroupBackupResource).
+				With("groupSnapshotName", groupSnapshotName, "CompartmentID", compartmentID, "OpcRequestId", *(listVolumeGroupBackupsResponse.OpcRequestId)).
+				With("statusCode", util.GetHttpStatusCode(err)).
+				Info("OPC Request ID recorded while fetching volume group backups by name.")
+		}
+
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		for _, volumeGroupBackup := range listVolumeGroupBackupsResponse.Items {
+			switch volumeGroupBackup.LifecycleState {
+			case core.VolumeGroupBackupLifecycleStateRequestReceived,
+				core.VolumeGroupBackupLifecycleStateCreating,
+				core.VolumeGroupBackupLifecycleStateCommitted,
+				core.VolumeGroupBackupLifecycleStateAvailable:
+				volumeGroupBackupList = append(volumeGroupBackupList, volumeGroupBackup)
+			}
+		}
+
+		if page = listVolumeGroupBackupsResponse.OpcNextPage; page == nil {
+			break
+		}
+	}
+
+	return volumeGroupBackupList, nil
+}
+
+// AwaitVolumeGroupBackupAvailableOrTimeout takes context as timeout
+func (c *client) AwaitVolumeGroupBackupAvailableOrTimeout(ctx context.Context, id string) (*core.VolumeGroupBackup, error) {
+	var volumeGroupBackup *core.VolumeGroupBackup
+	if err := wait.PollImmediateUntil(volumeBackupPollInterval, func() (bool, error) {
+		var err error
+		volumeGroupBackup, err = c.GetVolumeGroupBackup(ctx, id)
+		if err != nil {
+			if !IsRetryable(err) {
+				return false, err
+			}
+			return false, nil
+		}
+
+		switch state := volumeGroupBackup.LifecycleState; state {
+		case core.VolumeGroupBackupLifecycleStateAvailable:
+			return true, nil
+		case core.VolumeGroupBackupLifecycleStateFaulty,
+			core.VolumeGroupBackupLifecycleStateTerminated,
+			core.VolumeGroupBackupLifecycleStateTerminating:
+			return false, errors.Errorf("group snapshot did not become available (lifecycleState=%q)", state)
+		}
+		return false, nil
+	}, ctx.Done()); err != nil {
+		return nil, err
+	}
+
+	return volumeGroupBackup, nil
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/identity"
 	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
 	"github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
+	"github.com/oracle/oci-go-sdk/v65/secrets"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 	authv1 "k8s.io/api/authentication/v1"
@@ -73,6 +74,7 @@ type virtualNetworkClient interface {
 	UpdateSecurityList(ctx context.Context, request core.UpdateSecurityListRequest) (response core.UpdateSecurityListResponse, err error)
 
 	GetPrivateIp(ctx context.Context, request core.GetPrivateIpRequest) (response core.GetPrivateIpResponse, err error)
+	ListIpv6s(ctx context.Context, request core.ListIpv6sRequest) (response core.ListIpv6sResponse, err error)
 	GetPublicIpByIpAddress(ctx context.Context, request core.GetPublicIpByIpAddressRequest) (response core.GetPublicIpByIpAddressResponse, err error)
 
 	CreateNetworkSecurityGroup(ctx context.Context, request core.CreateNetworkSecurityGroupRequest) (response core.CreateNetworkSecurityGroupResponse, err error)
@@ -94,6 +96,16 @@ type loadBalancerClient interface {
 	DeleteLoadBalancer(ctx context.Context, request loadbalancer.DeleteLoadBalancerRequest) (response loadbalancer.DeleteLoadBalancerResponse, err error)
 	ListCertificates(ctx context.Context, request loadbalancer.ListCertificatesRequest) (response loadbalancer.ListCertificatesResponse, err error)
 	CreateCertificate(ctx context.Context, request loadbalancer.CreateCertificateRequest) (response loadbalancer.CreateCertificateResponse, err error)
+	DeleteCertificate(ctx context.Context, request loadbalancer.DeleteCertificateRequest) (response loadbalancer.DeleteCertificateResponse, err error)
+	CreateSSLCipherSuite(ctx context.Context, request loadbalancer.CreateSSLCipherSuiteRequest) (response loadbalancer.CreateSSLCipherSuiteResponse, err error)
+	UpdateSSLCipherSuite(ctx context.Context, request loadbalancer.UpdateSSLCipherSuiteRequest) (response loadbalancer.UpdateSSLCipherSuiteResponse, err error)
+	DeleteSSLCipherSuite(ctx context.Context, request loadbalancer.DeleteSSLCipherSuiteRequest) (response loadbalancer.DeleteSSLCipherSuiteResponse, err error)
+	CreateRuleSet(ctx context.Context, request loadbalancer.CreateRuleSetRequest) (response loadbalancer.CreateRuleSetResponse, err error)
+	UpdateRuleSet(ctx context.Context, request loadbalancer.UpdateRuleSetRequest) (response loadbalancer.UpdateRuleSetResponse, err error)
+	DeleteRuleSet(ctx context.Context, request loadbalancer.DeleteRuleSetRequest) (response loadbalancer.DeleteRuleSetResponse, err error)
+	CreateRoutingPolicy(ctx context.Context, request loadbalancer.CreateRoutingPolicyRequest) (response loadbalancer.CreateRoutingPolicyResponse, err error)
+	UpdateRoutingPolicy(ctx context.Context, request loadbalancer.UpdateRoutingPolicyRequest) (response loadbalancer.UpdateRoutingPolicyResponse, err error)
+	DeleteRoutingPolicy(ctx context.Context, request loadbalancer.DeleteRoutingPolicyRequest) (response loadbalancer.DeleteRoutingPolicyResponse, err error)
 	GetWorkRequest(ctx context.Context, request loadbalancer.GetWorkRequestRequest) (response loadbalancer.GetWorkRequestResponse, err error)
 	ListWorkRequests(ctx context.Context, request loadbalancer.ListWorkRequestsRequest) (response loadbalancer.ListWorkRequestsResponse, err error)
 	CreateBackendSet(ctx context.Context, request loadbalancer.CreateBackendSetRequest) (response loadbalancer.CreateBackendSetResponse, err error)
@@ -129,6 +141,11 @@ type filestorageClient interface {
 	GetFileSystem(ctx context.Context, request filestorage.GetFileSystemRequest) (response filestorage.GetFileSystemResponse, err error)
 	ListFileSystems(ctx context.Context, request filestorage.ListFileSystemsRequest) (response filestorage.ListFileSystemsResponse, err error)
 	DeleteFileSystem(ctx context.Context, request filestorage.DeleteFileSystemRequest) (response filestorage.DeleteFileSystemResponse, err error)
+	ToggleQuotaRules(ctx context.Context, request filestorage.ToggleQuotaRulesRequest) (response filestorage.ToggleQuotaRulesResponse, err error)
+
+	CreateQuotaRule(ctx context.Context, request filestorage.CreateQuotaRuleRequest) (response filestorage.CreateQuotaRuleResponse, err error)
+	ListQuotaRules(ctx context.Context, request filestorage.ListQuotaRulesRequest) (response filestorage.ListQuotaRulesResponse, err error)
+	UpdateQuotaRule(ctx context.Context, request filestorage.UpdateQuotaRuleRequest) (response filestorage.UpdateQuotaRuleResponse, err error)
 
 	CreateExport(ctx context.Context, request filestorage.CreateExportRequest) (response filestorage.CreateExportResponse, err error)
 	ListExports(ctx context.Context, request filestorage.ListExportsRequest) (response filestorage.ListExportsResponse, err error)
@@ -139,6 +156,11 @@ type filestorageClient interface {
 	CreateMountTarget(ctx context.Context, request filestorage.CreateMountTargetRequest) (response filestorage.CreateMountTargetResponse, err error)
 	DeleteMountTarget(ctx context.Context, request filestorage.DeleteMountTargetRequest) (response filestorage.DeleteMountTargetResponse, err error)
 	ListMountTargets(ctx context.Context, request filestorage.ListMountTargetsRequest) (response filestorage.ListMountTargetsResponse, err error)
+
+	CreateSnapshot(ctx context.Context, request filestorage.CreateSnapshotRequest) (response filestorage.CreateSnapshotResponse, err error)
+	GetSnapshot(ctx context.Context, request filestorage.GetSnapshotRequest) (response filestorage.GetSnapshotResponse, err error)
+	ListSnapshots(ctx context.Context, request filestorage.ListSnapshotsRequest) (response filestorage.ListSnapshotsResponse, err error)
+	DeleteSnapshot(ctx context.Context, request filestorage.DeleteSnapshotRequest) (response filestorage.DeleteSnapshotResponse, err error)
 }
 
 type blockstorageClient interface {
@@ -152,12 +174,33 @@ type blockstorageClient interface {
 	CreateVolumeBackup(ctx context.Context, request core.CreateVolumeBackupRequest) (response core.CreateVolumeBackupResponse, err error)
 	DeleteVolumeBackup(ctx context.Context, request core.DeleteVolumeBackupRequest) (response core.DeleteVolumeBackupResponse, err error)
 	ListVolumeBackups(ctx context.Context, request core.ListVolumeBackupsRequest) (response core.ListVolumeBackupsResponse, err error)
+	CopyVolumeBackup(ctx context.Context, request core.CopyVolumeBackupRequest) (response core.CopyVolumeBackupResponse, err error)
+
+	GetVolumeBackupPolicy(ctx context.Context, request core.GetVolumeBackupPolicyRequest) (response core.GetVolumeBackupPolicyResponse, err error)
+	ListVolumeBackupPolicies(ctx context.Context, request core.ListVolumeBackupPoliciesRequest) (response core.ListVolumeBackupPoliciesResponse, err error)
+	CreateVolumeBackupPolicyAssignment(ctx context.Context, request core.CreateVolumeBackupPolicyAssignmentRequest) (response core.CreateVolumeBackupPolicyAssignmentResponse, err error)
+	DeleteVolumeBackupPolicyAssignment(ctx context.Context, request core.DeleteVolumeBackupPolicyAssignmentRequest) (response core.DeleteVolumeBackupPolicyAssignmentResponse, err error)
+	GetVolumeBackupPolicyAssetAssignment(ctx context.Context, request core.GetVolumeBackupPolicyAssetAssignmentRequest) (response core.GetVolumeBackupPolicyAssetAssignmentResponse, err error)
+
+	GetVolumeGroup(ctx context.Context, request core.GetVolumeGroupRequest) (response core.GetVolumeGroupResponse, err error)
+	CreateVolumeGroup(ctx context.Context, request core.CreateVolumeGroupRequest) (response core.CreateVolumeGroupResponse, err error)
+	DeleteVolumeGroup(ctx context.Context, request core.DeleteVolumeGroupRequest) (response core.DeleteVolumeGroupResponse, err error)
+	ListVolumeGroups(ctx context.Context, request core.ListVolumeGroupsRequest) (response core.ListVolumeGroupsResponse, err error)
+
+	GetVolumeGroupBackup(ctx context.Context, request core.GetVolumeGroupBackupRequest) (response core.GetVolumeGroupBackupResponse, err error)
+	CreateVolumeGroupBackup(ctx context.Context, request core.CreateVolumeGroupBackupRequest) (response core.CreateVolumeGroupBackupResponse, err error)
+	DeleteVolumeGroupBackup(ctx context.Context, request core.DeleteVolumeGroupBackupRequest) (response core.DeleteVolumeGroupBackupResponse, err error)
+	ListVolumeGroupBackups(ctx context.Context, request core.ListVolumeGroupBackupsRequest) (response core.ListVolumeGroupBackupsResponse, err error)
 }
 
 type identityClient interface {
 	ListAvailabilityDomains(ctx context.Context, request identity.ListAvailabilityDomainsRequest) (identity.ListAvailabilityDomainsResponse, error)
 }
 
+type secretsClient interface {
+	GetSecretBundle(ctx context.Context, request secrets.GetSecretBundleRequest) (response secrets.GetSecretBundleResponse, err error)
+}
+
 type client struct {
 	compute             computeClient
 	network             virtualNetworkClient
@@ -217,6 +217,10 @@ func (c *mockVirtualNetworkClient) GetPrivateIp(ctx context.Context, request cor
 	return core.GetPrivateIpResponse{}, nil
 }
 
+func (c *mockVirtualNetworkClient) ListIpv6s(ctx context.Context, request core.ListIpv6sRequest) (response core.ListIpv6sResponse, err error) {
+	return core.ListIpv6sResponse{}, nil
+}
+
 func (c *mockVirtualNetworkClient) GetPublicIpByIpAddress(ctx context.Context, request core.GetPublicIpByIpAddressRequest) (response core.GetPublicIpByIpAddressResponse, err error) {
 	return core.GetPublicIpByIpAddressResponse{}, nil
 }
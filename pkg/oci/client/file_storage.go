@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"github.com/oracle/oci-cloud-controller-manager/pkg/util"
+	"github.com/oracle/oci-go-sdk/v65/common"
 	fss "github.com/oracle/oci-go-sdk/v65/filestorage"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
@@ -41,6 +42,11 @@ type FileStorageInterface interface {
 	AwaitFileSystemActive(ctx context.Context, logger *zap.SugaredLogger, id string) (*fss.FileSystem, error)
 	CreateFileSystem(ctx context.Context, details fss.CreateFileSystemDetails) (*fss.FileSystem, error)
 	DeleteFileSystem(ctx context.Context, id string) error
+	EnableQuotaRules(ctx context.Context, fileSystemID string) error
+
+	CreateFileSystemQuotaRule(ctx context.Context, fileSystemID string, limitInGigabytes int) (*fss.QuotaRule, error)
+	GetFileSystemQuotaRule(ctx context.Context, fileSystemID string) (*fss.QuotaRuleSummary, error)
+	UpdateQuotaRule(ctx context.Context, fileSystemID, quotaRuleID string, limitInGigabytes int) (*fss.QuotaRule, error)
 
 	CreateExport(ctx context.Context, details fss.CreateExportDetails) (*fss.Export, error)
 	FindExport(ctx context.Context, fsID, path, exportSetID string) (*fss.ExportSummary, error)
@@ -51,6 +57,12 @@ type FileStorageInterface interface {
 	CreateMountTarget(ctx context.Context, details fss.CreateMountTargetDetails) (*fss.MountTarget, error)
 	DeleteMountTarget(ctx context.Context, id string) error
 	GetMountTargetSummaryByDisplayName(ctx context.Context, compartmentID, ad, mountTargetName string) (bool, []fss.MountTargetSummary, error)
+
+	CreateSnapshot(ctx context.Context, details fss.CreateSnapshotDetails) (*fss.Snapshot, error)
+	GetSnapshot(ctx context.Context, id string) (*fss.Snapshot, error)
+	GetSnapshotsByName(ctx context.Context, fileSystemID, name string) ([]fss.SnapshotSummary, error)
+	ListSnapshots(ctx context.Context, fileSystemID string, limit int, page string) ([]fss.SnapshotSummary, string, error)
+	DeleteSnapshot(ctx context.Context, id string) error
 }
 
 func (c *client) CreateFileSystem(ctx context.Context, details fss.CreateFileSystemDetails) (*fss.FileSystem, error) {
@@ -102,6 +114,122 @@ func (c *client) GetFileSystem(ctx context.Context, id string) (*fss.FileSystem,
 	return &resp.FileSystem, nil
 }
 
+// EnableQuotaRules turns on the enforcement of the quota rules of a file system.
+func (c *client) EnableQuotaRules(ctx context.Context, fileSystemID string) error {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return RateLimitError(true, "ToggleQuotaRules")
+	}
+
+	resp, err := c.filestorage.ToggleQuotaRules(ctx, fss.ToggleQuotaRulesRequest{
+		FileSystemId: &fileSystemID,
+		ToggleQuotaRulesDetails: fss.ToggleQuotaRulesDetails{
+			AreQuotaRulesEnabled: common.Bool(true),
+		},
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, updateVerb, fileSystemResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "fss", "verb", updateVerb, "resource", fileSystemResource).
+			With("fssID", fileSystemID, "OpcRequestId", *(resp.OpcRequestId)).With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for ToggleQuotaRules call.")
+	}
+
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// CreateFileSystemQuotaRule creates a hard quota on the total usage of a file system.
+func (c *client) CreateFileSystemQuotaRule(ctx context.Context, fileSystemID string, limitInGigabytes int) (*fss.QuotaRule, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return nil, RateLimitError(true, "CreateQuotaRule")
+	}
+
+	resp, err := c.filestorage.CreateQuotaRule(ctx, fss.CreateQuotaRuleRequest{
+		FileSystemId: &fileSystemID,
+		CreateQuotaRuleDetails: fss.CreateQuotaRuleDetails{
+			PrincipalType:         fss.CreateQuotaRuleDetailsPrincipalTypeFileSystemLevel,
+			IsHardQuota:           common.Bool(true),
+			QuotaLimitInGigabytes: &limitInGigabytes,
+		},
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, createVerb, fssQuotaRuleResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "fss", "verb", createVerb, "resource", fssQuotaRuleResource).
+			With("fssID", fileSystemID, "OpcRequestId", *(resp.OpcRequestId)).With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for CreateQuotaRule call.")
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &resp.QuotaRule, nil
+}
+
+// GetFileSystemQuotaRule returns the quota rule on the total usage of a file
+// system, nil if the file system has none.
+func (c *client) GetFileSystemQuotaRule(ctx context.Context, fileSystemID string) (*fss.QuotaRuleSummary, error) {
+	if !c.rateLimiter.Reader.TryAccept() {
+		return nil, RateLimitError(false, "ListQuotaRules")
+	}
+
+	resp, err := c.filestorage.ListQuotaRules(ctx, fss.ListQuotaRulesRequest{
+		FileSystemId:    &fileSystemID,
+		PrincipalType:   fss.ListQuotaRulesPrincipalTypeFileSystemLevel,
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, listVerb, fssQuotaRuleResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "fss", "verb", listVerb, "resource", fssQuotaRuleResource).
+			With("fssID", fileSystemID, "OpcRequestId", *(resp.OpcRequestId)).With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for ListQuotaRules call.")
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if len(resp.Items) == 0 {
+		return nil, nil
+	}
+	return &resp.Items[0], nil
+}
+
+func (c *client) UpdateQuotaRule(ctx context.Context, fileSystemID, quotaRuleID string, limitInGigabytes int) (*fss.QuotaRule, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return nil, RateLimitError(true, "UpdateQuotaRule")
+	}
+
+	resp, err := c.filestorage.UpdateQuotaRule(ctx, fss.UpdateQuotaRuleRequest{
+		FileSystemId: &fileSystemID,
+		QuotaRuleId:  &quotaRuleID,
+		UpdateQuotaRuleDetails: fss.UpdateQuotaRuleDetails{
+			QuotaLimitInGigabytes: &limitInGigabytes,
+		},
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, updateVerb, fssQuotaRuleResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "fss", "verb", updateVerb, "resource", fssQuotaRuleResource).
+			With("fssID", fileSystemID, "quotaRuleID", quotaRuleID, "OpcRequestId", *(resp.OpcRequestId)).With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for UpdateQuotaRule call.")
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &resp.QuotaRule, nil
+}
+
 func (c *client) AwaitFileSystemActive(ctx context.Context, logger *zap.SugaredLogger, id string) (*fss.FileSystem, error) {
 	logger.Infof("Waiting for FileSystem to be in lifecycle state %q", fss.FileSystemLifecycleStateActive)
 
@@ -517,3 +645,163 @@ func (c *client) GetMountTargetSummaryByDisplayName(ctx context.Context, compart
 
 	return foundConflicting, mountTargetSummaries, nil
 }
+
+func (c *client) CreateSnapshot(ctx context.Context, details fss.CreateSnapshotDetails) (*fss.Snapshot, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return nil, RateLimitError(true, "CreateSnapshot")
+	}
+
+	resp, err := c.filestorage.CreateSnapshot(ctx, fss.CreateSnapshotRequest{
+		CreateSnapshotDetails: details,
+		RequestMetadata:       c.requestMetadata,
+	})
+	incRequestCounter(err, createVerb, fssSnapshotResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "fss", "verb", createVerb, "resource", fssSnapshotResource).
+			With("fssID", *(details.FileSystemId), "snapshotName", *(details.Name), "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for CreateSnapshot call.")
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &resp.Snapshot, nil
+}
+
+func (c *client) GetSnapshot(ctx context.Context, id string) (*fss.Snapshot, error) {
+	if !c.rateLimiter.Reader.TryAccept() {
+		return nil, RateLimitError(false, "GetSnapshot")
+	}
+
+	resp, err := c.filestorage.GetSnapshot(ctx, fss.GetSnapshotRequest{
+		SnapshotId:      &id,
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, getVerb, fssSnapshotResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "fss", "verb", getVerb, "resource", fssSnapshotResource).
+			With("snapshotID", id, "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for GetSnapshot call.")
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &resp.Snapshot, nil
+}
+
+// GetSnapshotsByName returns the snapshots of the file system with the given
+// name that are either CREATING or ACTIVE.
+func (c *client) GetSnapshotsByName(ctx context.Context, fileSystemID, name string) ([]fss.SnapshotSummary, error) {
+	var page *string
+	snapshots := make([]fss.SnapshotSummary, 0)
+	for {
+		if !c.rateLimiter.Reader.TryAccept() {
+			return nil, RateLimitError(false, "ListSnapshots")
+		}
+
+		resp, err := c.filestorage.ListSnapshots(ctx, fss.ListSnapshotsRequest{
+			FileSystemId:    &fileSystemID,
+			Page:            page,
+			RequestMetadata: c.requestMetadata,
+		})
+		incRequestCounter(err, listVerb, fssSnapshotResource)
+
+		if resp.OpcRequestId != nil {
+			c.logger.With("service", "fss", "verb", listVerb, "resource", fssSnapshotResource).
+				With("fssID", fileSystemID, "snapshotName", name, "OpcRequestId", *(resp.OpcRequestId)).
+				With("statusCode", util.GetHttpStatusCode(err)).
+				Info("OPC Request ID recorded for ListSnapshots call.")
+		}
+
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		for _, snapshot := range resp.Items {
+			if snapshot.Name == nil || *snapshot.Name != name {
+				continue
+			}
+			if snapshot.LifecycleState == fss.SnapshotSummaryLifecycleStateCreating ||
+				snapshot.LifecycleState == fss.SnapshotSummaryLifecycleStateActive {
+				snapshots = append(snapshots, snapshot)
+			}
+		}
+
+		if page = resp.OpcNextPage; page == nil {
+			break
+		}
+	}
+
+	return snapshots, nil
+}
+
+// ListSnapshots returns a single page of the snapshots of the given file
+// system. The returned page token is empty on the last page.
+func (c *client) ListSnapshots(ctx context.Context, fileSystemID string, limit int, page string) ([]fss.SnapshotSummary, string, error) {
+	if !c.rateLimiter.Reader.TryAccept() {
+		return nil, "", RateLimitError(false, "ListSnapshots")
+	}
+
+	request := fss.ListSnapshotsRequest{
+		FileSystemId:    &fileSystemID,
+		RequestMetadata: c.requestMetadata,
+	}
+	if limit > 0 {
+		request.Limit = &limit
+	}
+	if page != "" {
+		request.Page = &page
+	}
+
+	resp, err := c.filestorage.ListSnapshots(ctx, request)
+	incRequestCounter(err, listVerb, fssSnapshotResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "fss", "verb", listVerb, "resource", fssSnapshotResource).
+			With("fssID", fileSystemID, "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for ListSnapshots call.")
+	}
+
+	if err != nil {
+		return nil, "", errors.WithStack(err)
+	}
+
+	nextPage := ""
+	if resp.OpcNextPage != nil {
+		nextPage = *resp.OpcNextPage
+	}
+	return resp.Items, nextPage, nil
+}
+
+func (c *client) DeleteSnapshot(ctx context.Context, id string) error {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return RateLimitError(true, "DeleteSnapshot")
+	}
+
+	resp, err := c.filestorage.DeleteSnapshot(ctx, fss.DeleteSnapshotRequest{
+		SnapshotId:      &id,
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, deleteVerb, fssSnapshotResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "fss", "verb", deleteVerb, "resource", fssSnapshotResource).
+			With("snapshotID", id, "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for DeleteSnapshot call.")
+	}
+
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
@@ -21,7 +21,8 @@ type GenericBackendSetDetails struct {
 	Backends                        []GenericBackend
 	SessionPersistenceConfiguration *GenericSessionPersistenceConfiguration
 	// Only needed for LB
-	SslConfiguration *GenericSslConfigurationDetails
+	LbCookieSessionPersistenceConfiguration *GenericLbCookieSessionPersistenceConfiguration
+	SslConfiguration                        *GenericSslConfigurationDetails
 	// Only needed for NLB
 	IsPreserveSource *bool
 }
@@ -31,6 +32,16 @@ type GenericSessionPersistenceConfiguration struct {
 	DisableFallback *bool
 }
 
+type GenericLbCookieSessionPersistenceConfiguration struct {
+	CookieName      *string
+	DisableFallback *bool
+	Domain          *string
+	Path            *string
+	MaxAgeInSeconds *int
+	IsSecure        *bool
+	IsHttpOnly      *bool
+}
+
 type GenericHealthChecker struct {
 	Protocol          string
 	IsForcePlainText  *bool
@@ -50,6 +61,8 @@ type GenericBackend struct {
 	IpAddress *string
 	TargetId  *string
 	Weight    *int
+	Drain     *bool
+	Offline   *bool
 }
 
 type GenericSslConfigurationDetails struct {
@@ -91,6 +104,7 @@ type GenericCreateLoadBalancerDetails struct {
 	IsPrivate                   *bool
 	IsPreserveSourceDestination *bool
 	ReservedIps                 []GenericReservedIp
+	AssignedPrivateIpv4         *string
 	Listeners                   map[string]GenericListener
 	BackendSets                 map[string]GenericBackendSetDetails
 	NetworkSecurityGroupIds     []string
@@ -98,7 +112,9 @@ type GenericCreateLoadBalancerDetails struct {
 	DefinedTags                 map[string]map[string]interface{}
 
 	// Only needed for LB
-	Certificates map[string]GenericCertificate
+	Certificates    map[string]GenericCertificate
+	SslCipherSuites map[string]GenericSslCipherSuite
+	RuleSets        map[string]GenericRuleSet
 }
 
 type GenericShapeDetails struct {
@@ -114,6 +130,50 @@ type GenericCertificate struct {
 	CaCertificate     *string
 }
 
+type GenericSslCipherSuite struct {
+	Name    *string
+	Ciphers []string
+}
+
+type GenericRuleSet struct {
+	Name  *string
+	Items []GenericRule
+}
+
+// GenericRule is a load balancer rule. Action is one of the OCI rule actions,
+// e.g. ADD_HTTP_REQUEST_HEADER or REDIRECT, and selects the fields that apply.
+type GenericRule struct {
+	Action string
+	Header *string
+	Value  *string
+
+	// Only needed for REDIRECT rules, which match the paths with this prefix.
+	PathPrefix   *string
+	ResponseCode *int
+	RedirectUri  *GenericRedirectUri
+}
+
+type GenericRedirectUri struct {
+	Protocol *string
+	Host     *string
+	Port     *int
+	Path     *string
+	Query    *string
+}
+
+// GenericRoutingPolicy is an ordered list of routing rules, the first rule
+// whose condition matches a request forwards it to its backend set.
+type GenericRoutingPolicy struct {
+	Name  *string
+	Rules []GenericRoutingRule
+}
+
+type GenericRoutingRule struct {
+	Name           *string
+	Condition      *string
+	BackendSetName *string
+}
+
 type GenericReservedIp struct {
 	Id *string
 }
@@ -142,6 +202,9 @@ type GenericLoadBalancer struct {
 	NetworkSecurityGroupIds []string
 	Listeners               map[string]GenericListener
 	Certificates            map[string]GenericCertificate
+	SslCipherSuites         map[string]GenericSslCipherSuite
+	RuleSets                map[string]GenericRuleSet
+	RoutingPolicies         map[string]GenericRoutingPolicy
 	BackendSets             map[string]GenericBackendSetDetails
 
 	FreeformTags map[string]string
@@ -16,6 +16,7 @@ package client
 
 import (
 	"context"
+	"fmt"
 	"go.uber.org/zap"
 	"time"
 
@@ -47,6 +48,19 @@ type GenericLoadBalancerInterface interface {
 
 	GetCertificateByName(ctx context.Context, lbID, name string) (*GenericCertificate, error)
 	CreateCertificate(ctx context.Context, lbID string, cert *GenericCertificate) (string, error)
+	DeleteCertificate(ctx context.Context, lbID, name string) (string, error)
+
+	CreateSSLCipherSuite(ctx context.Context, lbID string, suite *GenericSslCipherSuite) (string, error)
+	UpdateSSLCipherSuite(ctx context.Context, lbID string, suite *GenericSslCipherSuite) (string, error)
+	DeleteSSLCipherSuite(ctx context.Context, lbID, name string) (string, error)
+
+	CreateRuleSet(ctx context.Context, lbID string, ruleSet *GenericRuleSet) (string, error)
+	UpdateRuleSet(ctx context.Context, lbID string, ruleSet *GenericRuleSet) (string, error)
+	DeleteRuleSet(ctx context.Context, lbID, name string) (string, error)
+
+	CreateRoutingPolicy(ctx context.Context, lbID string, policy *GenericRoutingPolicy) (string, error)
+	UpdateRoutingPolicy(ctx context.Context, lbID string, policy *GenericRoutingPolicy) (string, error)
+	DeleteRoutingPolicy(ctx context.Context, lbID, name string) (string, error)
 
 	CreateBackendSet(ctx context.Context, lbID, name string, details *GenericBackendSetDetails) (string, error)
 	UpdateBackendSet(ctx context.Context, lbID, name string, details *GenericBackendSetDetails) (string, error)
@@ -125,6 +139,8 @@ func (c *loadbalancerClientStruct) CreateLoadBalancer(ctx context.Context, detai
 			ShapeDetails:            c.genericShapeDetailsToShapeDetails(details.ShapeDetails),
 			ReservedIps:             c.genericReservedIpToReservedIps(details.ReservedIps),
 			Certificates:            c.genericCertificatesToCertificates(details.Certificates),
+			SslCipherSuites:         genericSslCipherSuitesToSslCipherSuites(details.SslCipherSuites),
+			RuleSets:                genericRuleSetsToRuleSets(details.RuleSets),
 			IsPrivate:               details.IsPrivate,
 			NetworkSecurityGroupIds: details.NetworkSecurityGroupIds,
 			Listeners:               c.genericListenerDetailsToListenerDetails(details.Listeners),
@@ -210,6 +226,216 @@ func (c *loadbalancerClientStruct) CreateCertificate(ctx context.Context, lbID s
 	return *resp.OpcWorkRequestId, nil
 }
 
+func (c *loadbalancerClientStruct) DeleteCertificate(ctx context.Context, lbID, name string) (string, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return "", RateLimitError(true, "DeleteCertificate")
+	}
+
+	resp, err := c.loadbalancer.DeleteCertificate(ctx, loadbalancer.DeleteCertificateRequest{
+		LoadBalancerId:  &lbID,
+		CertificateName: &name,
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, deleteVerb, certificateResource)
+
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return *resp.OpcWorkRequestId, nil
+}
+
+func (c *loadbalancerClientStruct) CreateSSLCipherSuite(ctx context.Context, lbID string, suite *GenericSslCipherSuite) (string, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return "", RateLimitError(true, "CreateSSLCipherSuite")
+	}
+
+	resp, err := c.loadbalancer.CreateSSLCipherSuite(ctx, loadbalancer.CreateSSLCipherSuiteRequest{
+		LoadBalancerId: &lbID,
+		CreateSslCipherSuiteDetails: loadbalancer.CreateSslCipherSuiteDetails{
+			Name:    suite.Name,
+			Ciphers: suite.Ciphers,
+		},
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, createVerb, sslCipherSuiteResource)
+
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return *resp.OpcWorkRequestId, nil
+}
+
+func (c *loadbalancerClientStruct) UpdateSSLCipherSuite(ctx context.Context, lbID string, suite *GenericSslCipherSuite) (string, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return "", RateLimitError(true, "UpdateSSLCipherSuite")
+	}
+
+	resp, err := c.loadbalancer.UpdateSSLCipherSuite(ctx, loadbalancer.UpdateSSLCipherSuiteRequest{
+		LoadBalancerId: &lbID,
+		Name:           suite.Name,
+		UpdateSslCipherSuiteDetails: loadbalancer.UpdateSslCipherSuiteDetails{
+			Ciphers: suite.Ciphers,
+		},
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, updateVerb, sslCipherSuiteResource)
+
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return *resp.OpcWorkRequestId, nil
+}
+
+func (c *loadbalancerClientStruct) CreateRuleSet(ctx context.Context, lbID string, ruleSet *GenericRuleSet) (string, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return "", RateLimitError(true, "CreateRuleSet")
+	}
+
+	resp, err := c.loadbalancer.CreateRuleSet(ctx, loadbalancer.CreateRuleSetRequest{
+		LoadBalancerId: &lbID,
+		CreateRuleSetDetails: loadbalancer.CreateRuleSetDetails{
+			Name:  ruleSet.Name,
+			Items: genericRulesToRules(ruleSet.Items),
+		},
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, createVerb, ruleSetResource)
+
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return *resp.OpcWorkRequestId, nil
+}
+
+func (c *loadbalancerClientStruct) UpdateRuleSet(ctx context.Context, lbID string, ruleSet *GenericRuleSet) (string, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return "", RateLimitError(true, "UpdateRuleSet")
+	}
+
+	resp, err := c.loadbalancer.UpdateRuleSet(ctx, loadbalancer.UpdateRuleSetRequest{
+		LoadBalancerId: &lbID,
+		RuleSetName:    ruleSet.Name,
+		UpdateRuleSetDetails: loadbalancer.UpdateRuleSetDetails{
+			Items: genericRulesToRules(ruleSet.Items),
+		},
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, updateVerb, ruleSetResource)
+
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return *resp.OpcWorkRequestId, nil
+}
+
+func (c *loadbalancerClientStruct) DeleteRuleSet(ctx context.Context, lbID, name string) (string, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return "", RateLimitError(true, "DeleteRuleSet")
+	}
+
+	resp, err := c.loadbalancer.DeleteRuleSet(ctx, loadbalancer.DeleteRuleSetRequest{
+		LoadBalancerId:  &lbID,
+		RuleSetName:     &name,
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, deleteVerb, ruleSetResource)
+
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return *resp.OpcWorkRequestId, nil
+}
+
+func (c *loadbalancerClientStruct) CreateRoutingPolicy(ctx context.Context, lbID string, policy *GenericRoutingPolicy) (string, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return "", RateLimitError(true, "CreateRoutingPolicy")
+	}
+
+	resp, err := c.loadbalancer.CreateRoutingPolicy(ctx, loadbalancer.CreateRoutingPolicyRequest{
+		LoadBalancerId: &lbID,
+		CreateRoutingPolicyDetails: loadbalancer.CreateRoutingPolicyDetails{
+			Name:                     policy.Name,
+			ConditionLanguageVersion: loadbalancer.CreateRoutingPolicyDetailsConditionLanguageVersionV1,
+			Rules:                    genericRoutingRulesToRoutingRules(policy.Rules),
+		},
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, createVerb, routingPolicyResource)
+
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return *resp.OpcWorkRequestId, nil
+}
+
+func (c *loadbalancerClientStruct) UpdateRoutingPolicy(ctx context.Context, lbID string, policy *GenericRoutingPolicy) (string, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return "", RateLimitError(true, "UpdateRoutingPolicy")
+	}
+
+	resp, err := c.loadbalancer.UpdateRoutingPolicy(ctx, loadbalancer.UpdateRoutingPolicyRequest{
+		LoadBalancerId:    &lbID,
+		RoutingPolicyName: policy.Name,
+		UpdateRoutingPolicyDetails: loadbalancer.UpdateRoutingPolicyDetails{
+			ConditionLanguageVersion: loadbalancer.UpdateRoutingPolicyDetailsConditionLanguageVersionV1,
+			Rules:                    genericRoutingRulesToRoutingRules(policy.Rules),
+		},
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, updateVerb, routingPolicyResource)
+
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return *resp.OpcWorkRequestId, nil
+}
+
+func (c *loadbalancerClientStruct) DeleteRoutingPolicy(ctx context.Context, lbID, name string) (string, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return "", RateLimitError(true, "DeleteRoutingPolicy")
+	}
+
+	resp, err := c.loadbalancer.DeleteRoutingPolicy(ctx, loadbalancer.DeleteRoutingPolicyRequest{
+		LoadBalancerId:    &lbID,
+		RoutingPolicyName: &name,
+		RequestMetadata:   c.requestMetadata,
+	})
+	incRequestCounter(err, deleteVerb, routingPolicyResource)
+
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return *resp.OpcWorkRequestId, nil
+}
+
+func (c *loadbalancerClientStruct) DeleteSSLCipherSuite(ctx context.Context, lbID, name string) (string, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return "", RateLimitError(true, "DeleteSSLCipherSuite")
+	}
+
+	resp, err := c.loadbalancer.DeleteSSLCipherSuite(ctx, loadbalancer.DeleteSSLCipherSuiteRequest{
+		LoadBalancerId:  &lbID,
+		Name:            &name,
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, deleteVerb, sslCipherSuiteResource)
+
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return *resp.OpcWorkRequestId, nil
+}
+
 func (c *loadbalancerClientStruct) GetWorkRequest(ctx context.Context, id string) (*loadbalancer.WorkRequest, error) {
 	if !c.rateLimiter.Reader.TryAccept() {
 		return nil, RateLimitError(false, "GetWorkRequest")
@@ -268,17 +494,19 @@ func (c *loadbalancerClientStruct) CreateBackendSet(ctx context.Context, lbID st
 			Name:     &name,
 			Backends: c.genericBackendDetailsToBackendDetails(details.Backends),
 			HealthChecker: &loadbalancer.HealthCheckerDetails{
-				Protocol:         &details.HealthChecker.Protocol,
-				IsForcePlainText: details.HealthChecker.IsForcePlainText,
-				Port:             details.HealthChecker.Port,
-				UrlPath:          details.HealthChecker.UrlPath,
-				Retries:          details.HealthChecker.Retries,
-				ReturnCode:       details.HealthChecker.ReturnCode,
-				TimeoutInMillis:  details.HealthChecker.TimeoutInMillis,
-				IntervalInMillis: details.HealthChecker.IntervalInMillis,
+				Protocol:          &details.HealthChecker.Protocol,
+				IsForcePlainText:  details.HealthChecker.IsForcePlainText,
+				Port:              details.HealthChecker.Port,
+				UrlPath:           details.HealthChecker.UrlPath,
+				Retries:           details.HealthChecker.Retries,
+				ReturnCode:        details.HealthChecker.ReturnCode,
+				ResponseBodyRegex: details.HealthChecker.ResponseBodyRegex,
+				TimeoutInMillis:   details.HealthChecker.TimeoutInMillis,
+				IntervalInMillis:  details.HealthChecker.IntervalInMillis,
 			},
-			Policy:                          details.Policy,
-			SessionPersistenceConfiguration: getSessionPersistenceConfiguration(details.SessionPersistenceConfiguration),
+			Policy:                                  details.Policy,
+			SessionPersistenceConfiguration:         getSessionPersistenceConfiguration(details.SessionPersistenceConfiguration),
+			LbCookieSessionPersistenceConfiguration: getLbCookieSessionPersistenceConfiguration(details.LbCookieSessionPersistenceConfiguration),
 		},
 		RequestMetadata: c.requestMetadata,
 	}
@@ -307,17 +535,19 @@ func (c *loadbalancerClientStruct) UpdateBackendSet(ctx context.Context, lbID st
 		UpdateBackendSetDetails: loadbalancer.UpdateBackendSetDetails{
 			Backends: c.genericBackendDetailsToBackendDetails(details.Backends),
 			HealthChecker: &loadbalancer.HealthCheckerDetails{
-				Protocol:         &details.HealthChecker.Protocol,
-				IsForcePlainText: details.HealthChecker.IsForcePlainText,
-				Port:             details.HealthChecker.Port,
-				UrlPath:          details.HealthChecker.UrlPath,
-				Retries:          details.HealthChecker.Retries,
-				ReturnCode:       details.HealthChecker.ReturnCode,
-				TimeoutInMillis:  details.HealthChecker.TimeoutInMillis,
-				IntervalInMillis: details.HealthChecker.IntervalInMillis,
+				Protocol:          &details.HealthChecker.Protocol,
+				IsForcePlainText:  details.HealthChecker.IsForcePlainText,
+				Port:              details.HealthChecker.Port,
+				UrlPath:           details.HealthChecker.UrlPath,
+				Retries:           details.HealthChecker.Retries,
+				ReturnCode:        details.HealthChecker.ReturnCode,
+				ResponseBodyRegex: details.HealthChecker.ResponseBodyRegex,
+				TimeoutInMillis:   details.HealthChecker.TimeoutInMillis,
+				IntervalInMillis:  details.HealthChecker.IntervalInMillis,
 			},
-			Policy:                          details.Policy,
-			SessionPersistenceConfiguration: getSessionPersistenceConfiguration(details.SessionPersistenceConfiguration),
+			Policy:                                  details.Policy,
+			SessionPersistenceConfiguration:         getSessionPersistenceConfiguration(details.SessionPersistenceConfiguration),
+			LbCookieSessionPersistenceConfiguration: getLbCookieSessionPersistenceConfiguration(details.LbCookieSessionPersistenceConfiguration),
 		},
 		RequestMetadata: c.requestMetadata,
 	}
@@ -368,6 +598,8 @@ func (c *loadbalancerClientStruct) CreateListener(ctx context.Context, lbID stri
 			Port:                    details.Port,
 			Protocol:                details.Protocol,
 			ConnectionConfiguration: getListenerConnectionConfiguration(details.ConnectionConfiguration),
+			RuleSetNames:            details.RuleSetNames,
+			RoutingPolicyName:       details.RoutingPolicyName,
 		},
 		RequestMetadata: c.requestMetadata,
 	}
@@ -398,6 +630,8 @@ func (c *loadbalancerClientStruct) UpdateListener(ctx context.Context, lbID stri
 			DefaultBackendSetName: details.DefaultBackendSetName,
 			Port:                  details.Port,
 			Protocol:              details.Protocol,
+			RuleSetNames:          details.RuleSetNames,
+			RoutingPolicyName:     details.RoutingPolicyName,
 		},
 		RequestMetadata: c.requestMetadata,
 	}
@@ -553,6 +787,9 @@ func (c *loadbalancerClientStruct) loadbalancerToGenericLoadbalancer(lb *loadbal
 		NetworkSecurityGroupIds: lb.NetworkSecurityGroupIds,
 		Listeners:               c.listenersToGenericListenerDetails(lb.Listeners),
 		Certificates:            c.certificateToGenericCertificateDetails(lb.Certificates),
+		SslCipherSuites:         sslCipherSuitesToGenericSslCipherSuites(lb.SslCipherSuites),
+		RuleSets:                ruleSetsToGenericRuleSets(lb.RuleSets),
+		RoutingPolicies:         routingPoliciesToGenericRoutingPolicies(lb.RoutingPolicies),
 		BackendSets:             c.backendSetsToGenericBackendSetDetails(lb.BackendSets),
 		FreeformTags:            lb.FreeformTags,
 		DefinedTags:             lb.DefinedTags,
@@ -674,14 +911,15 @@ func (c *loadbalancerClientStruct) backendSetsToGenericBackendSetDetails(backend
 	for k, v := range backendSets {
 		backendDetailsStruct := GenericBackendSetDetails{
 			HealthChecker: &GenericHealthChecker{
-				Protocol:         *v.HealthChecker.Protocol,
-				IsForcePlainText: v.HealthChecker.IsForcePlainText,
-				Port:             v.HealthChecker.Port,
-				UrlPath:          v.HealthChecker.UrlPath,
-				Retries:          v.HealthChecker.Retries,
-				ReturnCode:       v.HealthChecker.ReturnCode,
-				TimeoutInMillis:  v.HealthChecker.TimeoutInMillis,
-				IntervalInMillis: v.HealthChecker.IntervalInMillis,
+				Protocol:          *v.HealthChecker.Protocol,
+				IsForcePlainText:  v.HealthChecker.IsForcePlainText,
+				Port:              v.HealthChecker.Port,
+				UrlPath:           v.HealthChecker.UrlPath,
+				Retries:           v.HealthChecker.Retries,
+				ReturnCode:        v.HealthChecker.ReturnCode,
+				ResponseBodyRegex: v.HealthChecker.ResponseBodyRegex,
+				TimeoutInMillis:   v.HealthChecker.TimeoutInMillis,
+				IntervalInMillis:  v.HealthChecker.IntervalInMillis,
 			},
 			Policy:   v.Policy,
 			Name:     v.Name,
@@ -695,6 +933,10 @@ func (c *loadbalancerClientStruct) backendSetsToGenericBackendSetDetails(backend
 		if v.SessionPersistenceConfiguration != nil {
 			backendDetailsStruct.SessionPersistenceConfiguration = getGenericSessionPersistenceConfiguration(v.SessionPersistenceConfiguration)
 		}
+
+		if v.LbCookieSessionPersistenceConfiguration != nil {
+			backendDetailsStruct.LbCookieSessionPersistenceConfiguration = getGenericLbCookieSessionPersistenceConfiguration(v.LbCookieSessionPersistenceConfiguration)
+		}
 		genericBackendSetDetails[k] = backendDetailsStruct
 	}
 
@@ -707,14 +949,15 @@ func (c *loadbalancerClientStruct) genericBackendSetDetailsToBackendSets(backend
 	for k, v := range backendSets {
 		backendSetDetailsStruct := loadbalancer.BackendSetDetails{
 			HealthChecker: &loadbalancer.HealthCheckerDetails{
-				Protocol:         &v.HealthChecker.Protocol,
-				IsForcePlainText: v.HealthChecker.IsForcePlainText,
-				Port:             v.HealthChecker.Port,
-				UrlPath:          v.HealthChecker.UrlPath,
-				Retries:          v.HealthChecker.Retries,
-				ReturnCode:       v.HealthChecker.ReturnCode,
-				TimeoutInMillis:  v.HealthChecker.TimeoutInMillis,
-				IntervalInMillis: v.HealthChecker.IntervalInMillis,
+				Protocol:          &v.HealthChecker.Protocol,
+				IsForcePlainText:  v.HealthChecker.IsForcePlainText,
+				Port:              v.HealthChecker.Port,
+				UrlPath:           v.HealthChecker.UrlPath,
+				Retries:           v.HealthChecker.Retries,
+				ReturnCode:        v.HealthChecker.ReturnCode,
+				ResponseBodyRegex: v.HealthChecker.ResponseBodyRegex,
+				TimeoutInMillis:   v.HealthChecker.TimeoutInMillis,
+				IntervalInMillis:  v.HealthChecker.IntervalInMillis,
 			},
 			Policy:   v.Policy,
 			Backends: c.genericBackendDetailsToBackendDetails(v.Backends),
@@ -727,6 +970,10 @@ func (c *loadbalancerClientStruct) genericBackendSetDetailsToBackendSets(backend
 		if v.SessionPersistenceConfiguration != nil {
 			backendSetDetailsStruct.SessionPersistenceConfiguration = getSessionPersistenceConfiguration(v.SessionPersistenceConfiguration)
 		}
+
+		if v.LbCookieSessionPersistenceConfiguration != nil {
+			backendSetDetailsStruct.LbCookieSessionPersistenceConfiguration = getLbCookieSessionPersistenceConfiguration(v.LbCookieSessionPersistenceConfiguration)
+		}
 		backendSetDetails[k] = backendSetDetailsStruct
 	}
 	return backendSetDetails
@@ -805,6 +1052,8 @@ func (c *loadbalancerClientStruct) genericBackendDetailsToBackendDetails(details
 			IpAddress: backends.IpAddress,
 			Port:      backends.Port,
 			Weight:    backends.Weight,
+			Drain:     backends.Drain,
+			Offline:   backends.Offline,
 		})
 	}
 	return backendDetails
@@ -818,11 +1067,182 @@ func backendDetailsToGenericBackendDetails(details []loadbalancer.Backend) []Gen
 			IpAddress: backends.IpAddress,
 			Port:      backends.Port,
 			Weight:    backends.Weight,
+			Drain:     backends.Drain,
+			Offline:   backends.Offline,
 		})
 	}
 	return genericBackendDetails
 }
 
+func genericSslCipherSuitesToSslCipherSuites(suites map[string]GenericSslCipherSuite) map[string]loadbalancer.SslCipherSuiteDetails {
+	if suites == nil {
+		return nil
+	}
+	sslCipherSuites := make(map[string]loadbalancer.SslCipherSuiteDetails, len(suites))
+	for k, suite := range suites {
+		sslCipherSuites[k] = loadbalancer.SslCipherSuiteDetails{
+			Name:    suite.Name,
+			Ciphers: suite.Ciphers,
+		}
+	}
+	return sslCipherSuites
+}
+
+func sslCipherSuitesToGenericSslCipherSuites(suites map[string]loadbalancer.SslCipherSuite) map[string]GenericSslCipherSuite {
+	genericSslCipherSuites := make(map[string]GenericSslCipherSuite, len(suites))
+	for k, suite := range suites {
+		genericSslCipherSuites[k] = GenericSslCipherSuite{
+			Name:    suite.Name,
+			Ciphers: suite.Ciphers,
+		}
+	}
+	return genericSslCipherSuites
+}
+
+func genericRuleSetsToRuleSets(ruleSets map[string]GenericRuleSet) map[string]loadbalancer.RuleSetDetails {
+	if ruleSets == nil {
+		return nil
+	}
+	ruleSetDetails := make(map[string]loadbalancer.RuleSetDetails, len(ruleSets))
+	for k, ruleSet := range ruleSets {
+		ruleSetDetails[k] = loadbalancer.RuleSetDetails{
+			Items: genericRulesToRules(ruleSet.Items),
+		}
+	}
+	return ruleSetDetails
+}
+
+func ruleSetsToGenericRuleSets(ruleSets map[string]loadbalancer.RuleSet) map[string]GenericRuleSet {
+	genericRuleSets := make(map[string]GenericRuleSet, len(ruleSets))
+	for k, ruleSet := range ruleSets {
+		genericRuleSets[k] = GenericRuleSet{
+			Name:  ruleSet.Name,
+			Items: rulesToGenericRules(ruleSet.Items),
+		}
+	}
+	return genericRuleSets
+}
+
+func genericRulesToRules(genericRules []GenericRule) []loadbalancer.Rule {
+	rules := make([]loadbalancer.Rule, 0, len(genericRules))
+	for _, rule := range genericRules {
+		switch rule.Action {
+		case string(loadbalancer.RuleActionAddHttpRequestHeader):
+			rules = append(rules, loadbalancer.AddHttpRequestHeaderRule{Header: rule.Header, Value: rule.Value})
+		case string(loadbalancer.RuleActionRemoveHttpRequestHeader):
+			rules = append(rules, loadbalancer.RemoveHttpRequestHeaderRule{Header: rule.Header})
+		case string(loadbalancer.RuleActionAddHttpResponseHeader):
+			rules = append(rules, loadbalancer.AddHttpResponseHeaderRule{Header: rule.Header, Value: rule.Value})
+		case string(loadbalancer.RuleActionRemoveHttpResponseHeader):
+			rules = append(rules, loadbalancer.RemoveHttpResponseHeaderRule{Header: rule.Header})
+		case string(loadbalancer.RuleActionRedirect):
+			redirectRule := loadbalancer.RedirectRule{
+				Conditions: []loadbalancer.RuleCondition{
+					loadbalancer.PathMatchCondition{
+						AttributeValue: rule.PathPrefix,
+						Operator:       loadbalancer.PathMatchConditionOperatorForceLongestPrefixMatch,
+					},
+				},
+				ResponseCode: rule.ResponseCode,
+			}
+			if rule.RedirectUri != nil {
+				redirectRule.RedirectUri = &loadbalancer.RedirectUri{
+					Protocol: rule.RedirectUri.Protocol,
+					Host:     rule.RedirectUri.Host,
+					Port:     rule.RedirectUri.Port,
+					Path:     rule.RedirectUri.Path,
+					Query:    rule.RedirectUri.Query,
+				}
+			}
+			rules = append(rules, redirectRule)
+		}
+	}
+	return rules
+}
+
+func rulesToGenericRules(rules []loadbalancer.Rule) []GenericRule {
+	genericRules := make([]GenericRule, 0, len(rules))
+	for _, rule := range rules {
+		switch r := rule.(type) {
+		case loadbalancer.AddHttpRequestHeaderRule:
+			genericRules = append(genericRules, GenericRule{Action: string(loadbalancer.RuleActionAddHttpRequestHeader), Header: r.Header, Value: r.Value})
+		case loadbalancer.RemoveHttpRequestHeaderRule:
+			genericRules = append(genericRules, GenericRule{Action: string(loadbalancer.RuleActionRemoveHttpRequestHeader), Header: r.Header})
+		case loadbalancer.AddHttpResponseHeaderRule:
+			genericRules = append(genericRules, GenericRule{Action: string(loadbalancer.RuleActionAddHttpResponseHeader), Header: r.Header, Value: r.Value})
+		case loadbalancer.RemoveHttpResponseHeaderRule:
+			genericRules = append(genericRules, GenericRule{Action: string(loadbalancer.RuleActionRemoveHttpResponseHeader), Header: r.Header})
+		case loadbalancer.RedirectRule:
+			genericRule := GenericRule{Action: string(loadbalancer.RuleActionRedirect), ResponseCode: r.ResponseCode}
+			for _, condition := range r.Conditions {
+				if pathMatch, ok := condition.(loadbalancer.PathMatchCondition); ok {
+					genericRule.PathPrefix = pathMatch.AttributeValue
+				}
+			}
+			if r.RedirectUri != nil {
+				genericRule.RedirectUri = &GenericRedirectUri{
+					Protocol: r.RedirectUri.Protocol,
+					Host:     r.RedirectUri.Host,
+					Port:     r.RedirectUri.Port,
+					Path:     r.RedirectUri.Path,
+					Query:    r.RedirectUri.Query,
+				}
+			}
+			genericRules = append(genericRules, genericRule)
+		default:
+			// Rules the CCM does not manage are kept as their action only,
+			// so that they show up as a change.
+			genericRules = append(genericRules, GenericRule{Action: fmt.Sprintf("%T", rule)})
+		}
+	}
+	return genericRules
+}
+
+func routingPoliciesToGenericRoutingPolicies(policies map[string]loadbalancer.RoutingPolicy) map[string]GenericRoutingPolicy {
+	genericRoutingPolicies := make(map[string]GenericRoutingPolicy, len(policies))
+	for k, policy := range policies {
+		genericRoutingPolicies[k] = GenericRoutingPolicy{
+			Name:  policy.Name,
+			Rules: routingRulesToGenericRoutingRules(policy.Rules),
+		}
+	}
+	return genericRoutingPolicies
+}
+
+func genericRoutingRulesToRoutingRules(genericRules []GenericRoutingRule) []loadbalancer.RoutingRule {
+	rules := make([]loadbalancer.RoutingRule, 0, len(genericRules))
+	for _, rule := range genericRules {
+		rules = append(rules, loadbalancer.RoutingRule{
+			Name:      rule.Name,
+			Condition: rule.Condition,
+			Actions: []loadbalancer.Action{
+				loadbalancer.ForwardToBackendSet{BackendSetName: rule.BackendSetName},
+			},
+		})
+	}
+	return rules
+}
+
+// routingRulesToGenericRoutingRules only keeps the backend set of the first
+// forward action of a rule, which is the only action the API supports.
+func routingRulesToGenericRoutingRules(rules []loadbalancer.RoutingRule) []GenericRoutingRule {
+	genericRules := make([]GenericRoutingRule, 0, len(rules))
+	for _, rule := range rules {
+		genericRule := GenericRoutingRule{
+			Name:      rule.Name,
+			Condition: rule.Condition,
+		}
+		for _, action := range rule.Actions {
+			if forward, ok := action.(loadbalancer.ForwardToBackendSet); ok {
+				genericRule.BackendSetName = forward.BackendSetName
+				break
+			}
+		}
+		genericRules = append(genericRules, genericRule)
+	}
+	return genericRules
+}
+
 func genericSslConfigurationToSslConfiguration(details *GenericSslConfigurationDetails) *loadbalancer.SslConfigurationDetails {
 	if details == nil {
 		return nil
@@ -876,6 +1296,37 @@ func getGenericSessionPersistenceConfiguration(details *loadbalancer.SessionPers
 	}
 }
 
+func getLbCookieSessionPersistenceConfiguration(details *GenericLbCookieSessionPersistenceConfiguration) *loadbalancer.LbCookieSessionPersistenceConfigurationDetails {
+	if details == nil {
+		return nil
+	}
+	return &loadbalancer.LbCookieSessionPersistenceConfigurationDetails{
+		CookieName:      details.CookieName,
+		DisableFallback: details.DisableFallback,
+		Domain:          details.Domain,
+		Path:            details.Path,
+		MaxAgeInSeconds: details.MaxAgeInSeconds,
+		IsSecure:        details.IsSecure,
+		IsHttpOnly:      details.IsHttpOnly,
+	}
+}
+
+func getGenericLbCookieSessionPersistenceConfiguration(details *loadbalancer.LbCookieSessionPersistenceConfigurationDetails) *GenericLbCookieSessionPersistenceConfiguration {
+	if details == nil {
+		return nil
+	}
+
+	return &GenericLbCookieSessionPersistenceConfiguration{
+		CookieName:      details.CookieName,
+		DisableFallback: details.DisableFallback,
+		Domain:          details.Domain,
+		Path:            details.Path,
+		MaxAgeInSeconds: details.MaxAgeInSeconds,
+		IsSecure:        details.IsSecure,
+		IsHttpOnly:      details.IsHttpOnly,
+	}
+}
+
 func getListenerConnectionConfiguration(details *GenericConnectionConfiguration) *loadbalancer.ConnectionConfiguration {
 	var connectionConfiguration *loadbalancer.ConnectionConfiguration
 
@@ -196,6 +196,36 @@ func (c *MockLoadBalancerClient) ListCertificates(ctx context.Context, request l
 func (c *MockLoadBalancerClient) CreateCertificate(ctx context.Context, request loadbalancer.CreateCertificateRequest) (response loadbalancer.CreateCertificateResponse, err error) {
 	return
 }
+func (c *MockLoadBalancerClient) DeleteCertificate(ctx context.Context, request loadbalancer.DeleteCertificateRequest) (response loadbalancer.DeleteCertificateResponse, err error) {
+	return
+}
+func (c *MockLoadBalancerClient) CreateSSLCipherSuite(ctx context.Context, request loadbalancer.CreateSSLCipherSuiteRequest) (response loadbalancer.CreateSSLCipherSuiteResponse, err error) {
+	return
+}
+func (c *MockLoadBalancerClient) UpdateSSLCipherSuite(ctx context.Context, request loadbalancer.UpdateSSLCipherSuiteRequest) (response loadbalancer.UpdateSSLCipherSuiteResponse, err error) {
+	return
+}
+func (c *MockLoadBalancerClient) DeleteSSLCipherSuite(ctx context.Context, request loadbalancer.DeleteSSLCipherSuiteRequest) (response loadbalancer.DeleteSSLCipherSuiteResponse, err error) {
+	return
+}
+func (c *MockLoadBalancerClient) CreateRuleSet(ctx context.Context, request loadbalancer.CreateRuleSetRequest) (response loadbalancer.CreateRuleSetResponse, err error) {
+	return
+}
+func (c *MockLoadBalancerClient) UpdateRuleSet(ctx context.Context, request loadbalancer.UpdateRuleSetRequest) (response loadbalancer.UpdateRuleSetResponse, err error) {
+	return
+}
+func (c *MockLoadBalancerClient) DeleteRuleSet(ctx context.Context, request loadbalancer.DeleteRuleSetRequest) (response loadbalancer.DeleteRuleSetResponse, err error) {
+	return
+}
+func (c *MockLoadBalancerClient) CreateRoutingPolicy(ctx context.Context, request loadbalancer.CreateRoutingPolicyRequest) (response loadbalancer.CreateRoutingPolicyResponse, err error) {
+	return
+}
+func (c *MockLoadBalancerClient) UpdateRoutingPolicy(ctx context.Context, request loadbalancer.UpdateRoutingPolicyRequest) (response loadbalancer.UpdateRoutingPolicyResponse, err error) {
+	return
+}
+func (c *MockLoadBalancerClient) DeleteRoutingPolicy(ctx context.Context, request loadbalancer.DeleteRoutingPolicyRequest) (response loadbalancer.DeleteRoutingPolicyResponse, err error) {
+	return
+}
 func (c *MockLoadBalancerClient) CreateBackendSet(ctx context.Context, request loadbalancer.CreateBackendSetRequest) (response loadbalancer.CreateBackendSetResponse, err error) {
 	return
 }
@@ -45,6 +45,9 @@ const (
 	listenerResource            resource = "load_balancer_listener"
 	shapeResource               resource = "load_balancer_shape"
 	certificateResource         resource = "load_balancer_certificate"
+	sslCipherSuiteResource      resource = "load_balancer_ssl_cipher_suite"
+	ruleSetResource             resource = "load_balancer_rule_set"
+	routingPolicyResource       resource = "load_balancer_routing_policy"
 	workRequestResource         resource = "load_balancer_work_request"
 	nlbWorkRequestResource      resource = "network_load_balancer_work_request"
 	securityListResource        resource = "security_list"
@@ -53,12 +56,20 @@ const (
 	fileSystemResource          resource = "file_system"
 	mountTargetResource         resource = "mount_target"
 	exportResource              resource = "export"
+	fssSnapshotResource         resource = "file_system_snapshot"
+	fssQuotaRuleResource        resource = "file_system_quota_rule"
 	privateIPResource           resource = "private_ip"
+	ipv6Resource                resource = "ipv6"
 	availabilityDomainResource  resource = "availability_domain"
 	nsgResource                 resource = "network_security_groups"
 	nsgRuleResource             resource = "network_security_group_rules"
 	publicReservedIPResource    resource = "public_reserved_ip"
 	volumeBackupResource        resource = "volumeBackup"
+	volumeGroupResource         resource = "volumeGroup"
+	volumeGroupBackupResource   resource = "volumeGroupBackup"
+	volumeBackupPolicyResource  resource = "volumeBackupPolicy"
+	policyAssignmentResource    resource = "volumeBackupPolicyAssignment"
+	secretBundleResource        resource = "secret_bundle"
 )
 
 type verb string
@@ -96,6 +96,7 @@ func (c *networkLoadbalancer) CreateLoadBalancer(ctx context.Context, details *G
 			SubnetId:                    &details.SubnetIds[0],
 			IsPreserveSourceDestination: details.IsPreserveSourceDestination,
 			ReservedIps:                 c.genericReservedIpToReservedIps(details.ReservedIps),
+			AssignedPrivateIpv4:         details.AssignedPrivateIpv4,
 			IsPrivate:                   details.IsPrivate,
 			NetworkSecurityGroupIds:     details.NetworkSecurityGroupIds,
 			Listeners:                   c.genericListenerDetailsToListenerDetails(details.Listeners),
@@ -137,6 +138,46 @@ func (c *networkLoadbalancer) CreateCertificate(ctx context.Context, lbID string
 	return "", nil
 }
 
+func (c *networkLoadbalancer) DeleteCertificate(ctx context.Context, lbID, name string) (string, error) {
+	return "", nil
+}
+
+func (c *networkLoadbalancer) CreateSSLCipherSuite(ctx context.Context, lbID string, suite *GenericSslCipherSuite) (string, error) {
+	return "", nil
+}
+
+func (c *networkLoadbalancer) UpdateSSLCipherSuite(ctx context.Context, lbID string, suite *GenericSslCipherSuite) (string, error) {
+	return "", nil
+}
+
+func (c *networkLoadbalancer) DeleteSSLCipherSuite(ctx context.Context, lbID, name string) (string, error) {
+	return "", nil
+}
+
+func (c *networkLoadbalancer) CreateRuleSet(ctx context.Context, lbID string, ruleSet *GenericRuleSet) (string, error) {
+	return "", nil
+}
+
+func (c *networkLoadbalancer) UpdateRuleSet(ctx context.Context, lbID string, ruleSet *GenericRuleSet) (string, error) {
+	return "", nil
+}
+
+func (c *networkLoadbalancer) DeleteRuleSet(ctx context.Context, lbID, name string) (string, error) {
+	return "", nil
+}
+
+func (c *networkLoadbalancer) CreateRoutingPolicy(ctx context.Context, lbID string, policy *GenericRoutingPolicy) (string, error) {
+	return "", nil
+}
+
+func (c *networkLoadbalancer) UpdateRoutingPolicy(ctx context.Context, lbID string, policy *GenericRoutingPolicy) (string, error) {
+	return "", nil
+}
+
+func (c *networkLoadbalancer) DeleteRoutingPolicy(ctx context.Context, lbID, name string) (string, error) {
+	return "", nil
+}
+
 func (c *networkLoadbalancer) GetWorkRequest(ctx context.Context, id string) (*networkloadbalancer.WorkRequest, error) {
 	if !c.rateLimiter.Reader.TryAccept() {
 		return nil, RateLimitError(false, "GetWorkRequest")
@@ -415,6 +456,8 @@ func backendsToBackendDetails(backends []GenericBackend) []networkloadbalancer.B
 			IpAddress: backend.IpAddress,
 			TargetId:  backend.TargetId,
 			Weight:    backend.Weight,
+			IsDrain:   backend.Drain,
+			IsOffline: backend.Offline,
 		})
 
 	}
@@ -508,13 +551,14 @@ func (c *networkLoadbalancer) backendSetsToGenericBackendSetDetails(backendSets
 		policyString := string(v.Policy)
 		genericBackendSetDetails[k] = GenericBackendSetDetails{
 			HealthChecker: &GenericHealthChecker{
-				Protocol:         string(v.HealthChecker.Protocol),
-				Port:             v.HealthChecker.Port,
-				UrlPath:          v.HealthChecker.UrlPath,
-				Retries:          v.HealthChecker.Retries,
-				ReturnCode:       v.HealthChecker.ReturnCode,
-				TimeoutInMillis:  v.HealthChecker.TimeoutInMillis,
-				IntervalInMillis: v.HealthChecker.IntervalInMillis,
+				Protocol:          string(v.HealthChecker.Protocol),
+				Port:              v.HealthChecker.Port,
+				UrlPath:           v.HealthChecker.UrlPath,
+				Retries:           v.HealthChecker.Retries,
+				ReturnCode:        v.HealthChecker.ReturnCode,
+				ResponseBodyRegex: v.HealthChecker.ResponseBodyRegex,
+				TimeoutInMillis:   v.HealthChecker.TimeoutInMillis,
+				IntervalInMillis:  v.HealthChecker.IntervalInMillis,
 			},
 			Name:             v.Name,
 			Policy:           &policyString,
@@ -535,6 +579,8 @@ func (c *networkLoadbalancer) backendDetailsToGenericBackendDetails(details []ne
 			Port:      backends.Port,
 			Weight:    backends.Weight,
 			TargetId:  backends.TargetId,
+			Drain:     backends.IsDrain,
+			Offline:   backends.IsOffline,
 		})
 	}
 	return genericBackendDetails
@@ -595,13 +641,14 @@ func (c *networkLoadbalancer) genericBackendSetDetailsToBackendSets(backendSets
 	for k, v := range backendSets {
 		backendSetDetails[k] = networkloadbalancer.BackendSetDetails{
 			HealthChecker: &networkloadbalancer.HealthChecker{
-				Protocol:         networkloadbalancer.HealthCheckProtocolsEnum(v.HealthChecker.Protocol),
-				Port:             v.HealthChecker.Port,
-				UrlPath:          v.HealthChecker.UrlPath,
-				Retries:          v.HealthChecker.Retries,
-				ReturnCode:       v.HealthChecker.ReturnCode,
-				TimeoutInMillis:  v.HealthChecker.TimeoutInMillis,
-				IntervalInMillis: v.HealthChecker.IntervalInMillis,
+				Protocol:          networkloadbalancer.HealthCheckProtocolsEnum(v.HealthChecker.Protocol),
+				Port:              v.HealthChecker.Port,
+				UrlPath:           v.HealthChecker.UrlPath,
+				Retries:           v.HealthChecker.Retries,
+				ReturnCode:        v.HealthChecker.ReturnCode,
+				ResponseBodyRegex: v.HealthChecker.ResponseBodyRegex,
+				TimeoutInMillis:   v.HealthChecker.TimeoutInMillis,
+				IntervalInMillis:  v.HealthChecker.IntervalInMillis,
 			},
 			Policy:           networkloadbalancer.NetworkLoadBalancingPolicyEnum(*v.Policy),
 			Backends:         c.genericBackendDetailsToBackendDetails(v.Backends),
@@ -620,6 +667,8 @@ func (c *networkLoadbalancer) genericBackendDetailsToBackendDetails(details []Ge
 			Port:      backends.Port,
 			Weight:    backends.Weight,
 			TargetId:  backends.TargetId,
+			IsDrain:   backends.Drain,
+			IsOffline: backends.Offline,
 		})
 	}
 	return backendDetails
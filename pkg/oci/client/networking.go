@@ -36,6 +36,7 @@ type NetworkingInterface interface {
 	UpdateSecurityList(ctx context.Context, id string, etag string, ingressRules []core.IngressSecurityRule, egressRules []core.EgressSecurityRule) (core.UpdateSecurityListResponse, error)
 
 	GetPrivateIp(ctx context.Context, id string) (*core.PrivateIp, error)
+	ListIpv6s(ctx context.Context, vnicId string) ([]core.Ipv6, error)
 
 	GetPublicIpByIpAddress(ctx context.Context, id string) (*core.PublicIp, error)
 
@@ -200,6 +201,35 @@ func (c *client) GetPrivateIp(ctx context.Context, id string) (*core.PrivateIp,
 	return &resp.PrivateIp, nil
 }
 
+// ListIpv6s lists the IPv6 addresses assigned to the given VNIC.
+func (c *client) ListIpv6s(ctx context.Context, vnicId string) ([]core.Ipv6, error) {
+	var page *string
+	ipv6s := make([]core.Ipv6, 0)
+	for {
+		if !c.rateLimiter.Reader.TryAccept() {
+			return nil, RateLimitError(false, "ListIpv6s")
+		}
+
+		resp, err := c.network.ListIpv6s(ctx, core.ListIpv6sRequest{
+			VnicId:          &vnicId,
+			Page:            page,
+			RequestMetadata: c.requestMetadata,
+		})
+		incRequestCounter(err, listVerb, ipv6Resource)
+
+		if err != nil {
+			c.logger.With(vnicId).Infof("ListIpv6s failed %s", pointer.StringDeref(resp.OpcRequestId, ""))
+			return nil, errors.WithStack(err)
+		}
+		ipv6s = append(ipv6s, resp.Items...)
+		if page = resp.OpcNextPage; resp.OpcNextPage == nil {
+			break
+		}
+	}
+
+	return ipv6s, nil
+}
+
 func (c *client) GetPublicIpByIpAddress(ctx context.Context, ip string) (*core.PublicIp, error) {
 	if !c.rateLimiter.Reader.TryAccept() {
 		return nil, RateLimitError(false, "GetPublicIpByIpAddress")
@@ -0,0 +1,81 @@
+// Copyright 2024 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/secrets"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// SecretsInterface defines the interface to the OCI Vault secret retrieval
+// service consumed by the node driver.
+type SecretsInterface interface {
+	GetSecretContent(ctx context.Context, secretID string) ([]byte, error)
+}
+
+type secretsClientStruct struct {
+	secrets         secretsClient
+	requestMetadata common.RequestMetadata
+	rateLimiter     RateLimiter
+}
+
+// NewSecretsClient constructs an OCI Vault secret retrieval API client.
+func NewSecretsClient(logger *zap.SugaredLogger, cp common.ConfigurationProvider, opRateLimiter *RateLimiter) (SecretsInterface, error) {
+	s, err := secrets.NewSecretsClientWithConfigurationProvider(cp)
+	if err != nil {
+		return nil, errors.Wrap(err, "NewSecretsClientWithConfigurationProvider")
+	}
+
+	err = configureCustomTransport(logger, &s.BaseClient)
+	if err != nil {
+		return nil, errors.Wrap(err, "configuring secrets service client custom transport")
+	}
+
+	return &secretsClientStruct{
+		secrets: &s,
+		requestMetadata: common.RequestMetadata{
+			RetryPolicy: newRetryPolicy(),
+		},
+		rateLimiter: *opRateLimiter,
+	}, nil
+}
+
+// GetSecretContent returns the decoded content of the current version of the
+// given secret.
+func (c *secretsClientStruct) GetSecretContent(ctx context.Context, secretID string) ([]byte, error) {
+	if !c.rateLimiter.Reader.TryAccept() {
+		return nil, RateLimitError(false, "GetSecretBundle")
+	}
+
+	resp, err := c.secrets.GetSecretBundle(ctx, secrets.GetSecretBundleRequest{
+		SecretId:        &secretID,
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, getVerb, secretBundleResource)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	content, ok := resp.SecretBundleContent.(secrets.Base64SecretBundleContentDetails)
+	if !ok || content.Content == nil {
+		return nil, errors.Errorf("unsupported content of secret %s", secretID)
+	}
+	return base64.StdEncoding.DecodeString(*content.Content)
+}
@@ -0,0 +1,93 @@
+// Copyright 2024 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/secrets"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+type mockSecretsClient struct {
+	bundles map[string]secrets.SecretBundleContentDetails
+}
+
+func (c *mockSecretsClient) GetSecretBundle(ctx context.Context, request secrets.GetSecretBundleRequest) (secrets.GetSecretBundleResponse, error) {
+	content, ok := c.bundles[*request.SecretId]
+	if !ok {
+		return secrets.GetSecretBundleResponse{}, errors.New("secret not found")
+	}
+	return secrets.GetSecretBundleResponse{
+		SecretBundle: secrets.SecretBundle{SecretId: request.SecretId, SecretBundleContent: content},
+	}, nil
+}
+
+func TestGetSecretContent(t *testing.T) {
+	c := &secretsClientStruct{
+		secrets: &mockSecretsClient{bundles: map[string]secrets.SecretBundleContentDetails{
+			"base64":         secrets.Base64SecretBundleContentDetails{Content: common.String("cGFzc3BocmFzZQ==")},
+			"invalid-base64": secrets.Base64SecretBundleContentDetails{Content: common.String("not base64!")},
+			"no-content":     nil,
+		}},
+		rateLimiter: RateLimiter{
+			Reader: flowcontrol.NewFakeAlwaysRateLimiter(),
+			Writer: flowcontrol.NewFakeAlwaysRateLimiter(),
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		secretID string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "base64 content",
+			secretID: "base64",
+			expected: "passphrase",
+		},
+		{
+			name:     "invalid base64 content",
+			secretID: "invalid-base64",
+			wantErr:  true,
+		},
+		{
+			name:     "no content",
+			secretID: "no-content",
+			wantErr:  true,
+		},
+		{
+			name:     "missing secret",
+			secretID: "missing",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.GetSecretContent(context.Background(), tt.secretID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetSecretContent() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if string(got) != tt.expected {
+				t.Errorf("GetSecretContent() = %q, expected %q", string(got), tt.expected)
+			}
+		})
+	}
+}
@@ -36,10 +36,12 @@ type VolumeAttachmentInterface interface {
 	FindVolumeAttachment(ctx context.Context, compartmentID, volumeID string) (core.VolumeAttachment, error)
 
 	// AttachVolume attaches a block storage volume to the specified instance.
+	// A shareable attachment lets the volume be attached to other instances
+	// at the same time.
 	// See https://docs.us-phoenix-1.oraclecloud.com/api/#/en/iaas/20160918/VolumeAttachment/AttachVolume
-	AttachVolume(ctx context.Context, instanceID, volumeID string) (core.VolumeAttachment, error)
+	AttachVolume(ctx context.Context, instanceID, volumeID string, isReadOnly, isShareable bool) (core.VolumeAttachment, error)
 
-	AttachParavirtualizedVolume(ctx context.Context, instanceID, volumeID string, isPvEncryptionInTransitEnabled bool) (core.VolumeAttachment, error)
+	AttachParavirtualizedVolume(ctx context.Context, instanceID, volumeID string, isPvEncryptionInTransitEnabled, isReadOnly, isShareable bool) (core.VolumeAttachment, error)
 
 	// WaitForVolumeAttached polls waiting for a OCI block volume to be in the
 	// ATTACHED state.
@@ -53,7 +55,14 @@ type VolumeAttachmentInterface interface {
 	// DETACHED state.
 	WaitForVolumeDetached(ctx context.Context, attachmentID string) error
 
-	FindActiveVolumeAttachment(ctx context.Context, compartmentID, volumeID string) (core.VolumeAttachment, error)
+	// FindActiveVolumeAttachment searches for an attachment of the volume to
+	// the instance in the state ATTACHING, ATTACHED or DETACHING. An empty
+	// instanceID matches an attachment to any instance.
+	FindActiveVolumeAttachment(ctx context.Context, compartmentID, volumeID, instanceID string) (core.VolumeAttachment, error)
+
+	// ListVolumeAttachments returns all the attachments of the volume in any
+	// state. A shareable volume can be attached to several instances.
+	ListVolumeAttachments(ctx context.Context, compartmentID, volumeID string) ([]core.VolumeAttachment, error)
 }
 
 var _ VolumeAttachmentInterface = &client{}
@@ -126,7 +135,7 @@ func (c *client) GetVolumeAttachment(ctx context.Context, id string) (core.Volum
 	return resp.VolumeAttachment, nil
 }
 
-func (c *client) AttachVolume(ctx context.Context, instanceID, volumeID string) (core.VolumeAttachment, error) {
+func (c *client) AttachVolume(ctx context.Context, instanceID, volumeID string, isReadOnly, isShareable bool) (core.VolumeAttachment, error) {
 	if !c.rateLimiter.Writer.TryAccept() {
 		return nil, RateLimitError(false, "")
 	}
@@ -138,9 +147,11 @@ func (c *client) AttachVolume(ctx context.Context, instanceID, volumeID string)
 
 	resp, err := c.compute.AttachVolume(ctx, core.AttachVolumeRequest{
 		AttachVolumeDetails: core.AttachIScsiVolumeDetails{
-			InstanceId: &instanceID,
-			VolumeId:   &volumeID,
-			Device:     device,
+			InstanceId:  &instanceID,
+			VolumeId:    &volumeID,
+			Device:      device,
+			IsReadOnly:  &isReadOnly,
+			IsShareable: &isShareable,
 		},
 		RequestMetadata: c.requestMetadata,
 	})
@@ -195,7 +206,7 @@ func (c *client) getDevicePath(ctx context.Context, instanceID string) (*string,
 	return device, nil
 }
 
-func (c *client) AttachParavirtualizedVolume(ctx context.Context, instanceID, volumeID string, isPvEncryptionInTransitEnabled bool) (core.VolumeAttachment, error) {
+func (c *client) AttachParavirtualizedVolume(ctx context.Context, instanceID, volumeID string, isPvEncryptionInTransitEnabled, isReadOnly, isShareable bool) (core.VolumeAttachment, error) {
 	if !c.rateLimiter.Writer.TryAccept() {
 		return nil, RateLimitError(false, "")
 	}
@@ -211,6 +222,8 @@ func (c *client) AttachParavirtualizedVolume(ctx context.Context, instanceID, vo
 			VolumeId:                       &volumeID,
 			IsPvEncryptionInTransitEnabled: &isPvEncryptionInTransitEnabled,
 			Device:                         device,
+			IsReadOnly:                     &isReadOnly,
+			IsShareable:                    &isShareable,
 		},
 		RequestMetadata: c.requestMetadata,
 	})
@@ -297,7 +310,12 @@ func (c *client) WaitForVolumeDetached(ctx context.Context, id string) error {
 	return nil
 }
 
-func (c *client) FindActiveVolumeAttachment(ctx context.Context, compartmentID, volumeID string) (core.VolumeAttachment, error) {
+func (c *client) FindActiveVolumeAttachment(ctx context.Context, compartmentID, volumeID, instanceID string) (core.VolumeAttachment, error) {
+	var instance *string
+	if instanceID != "" {
+		instance = &instanceID
+	}
+
 	var page *string
 	for {
 		if !c.rateLimiter.Reader.TryAccept() {
@@ -307,6 +325,7 @@ func (c *client) FindActiveVolumeAttachment(ctx context.Context, compartmentID,
 		resp, err := c.compute.ListVolumeAttachments(ctx, core.ListVolumeAttachmentsRequest{
 			CompartmentId:   &compartmentID,
 			VolumeId:        &volumeID,
+			InstanceId:      instance,
 			Page:            page,
 			RequestMetadata: c.requestMetadata,
 		})
@@ -339,3 +358,40 @@ func (c *client) FindActiveVolumeAttachment(ctx context.Context, compartmentID,
 
 	return nil, errors.WithStack(errNotFound)
 }
+
+func (c *client) ListVolumeAttachments(ctx context.Context, compartmentID, volumeID string) ([]core.VolumeAttachment, error) {
+	var attachments []core.VolumeAttachment
+	var page *string
+	for {
+		if !c.rateLimiter.Reader.TryAccept() {
+			return nil, RateLimitError(false, "ListVolumeAttachments")
+		}
+
+		resp, err := c.compute.ListVolumeAttachments(ctx, core.ListVolumeAttachmentsRequest{
+			CompartmentId:   &compartmentID,
+			VolumeId:        &volumeID,
+			Page:            page,
+			RequestMetadata: c.requestMetadata,
+		})
+
+		if resp.OpcRequestId != nil {
+			c.logger.With("service", "compute", "verb", listVerb, "resource", volumeAttachmentResource).
+				With("volumeID", volumeID, "OpcRequestId", *(resp.OpcRequestId)).With("statusCode", util.GetHttpStatusCode(err)).
+				Info("OPC Request ID recorded for ListVolumeAttachments call.")
+		}
+
+		incRequestCounter(err, listVerb, volumeAttachmentResource)
+
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		attachments = append(attachments, resp.Items...)
+
+		if page = resp.OpcNextPage; page == nil {
+			break
+		}
+	}
+
+	return attachments, nil
+}
@@ -33,9 +33,17 @@ const (
 // local OCI instance metadata API endpoint.
 // https://docs.us-phoenix-1.oraclecloud.com/Content/Compute/Tasks/gettingmetadata.htm
 type InstanceMetadata struct {
-	CompartmentID       string `json:"compartmentId"`
-	Region              string `json:"region"`
-	CanonicalRegionName string `json:"canonicalRegionName"`
+	CompartmentID       string      `json:"compartmentId"`
+	Region              string      `json:"region"`
+	CanonicalRegionName string      `json:"canonicalRegionName"`
+	Shape               string      `json:"shape"`
+	ShapeConfig         ShapeConfig `json:"shapeConfig"`
+}
+
+// ShapeConfig holds the subset of the shape configuration of the instance
+// retrieved from the instance metadata.
+type ShapeConfig struct {
+	Ocpus float32 `json:"ocpus"`
 }
 
 // Interface defines how consumers access OCI instance metadata.
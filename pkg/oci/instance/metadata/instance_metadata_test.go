@@ -35,6 +35,10 @@ const exampleResponse = `{
   "region" : "phx",
   "canonicalRegionName" : "us-phoenix-1",
   "shape" : "VM.Standard1.1",
+  "shapeConfig" : {
+    "ocpus" : 1.0,
+    "memoryInGBs" : 7.0
+  },
   "state" : "Provisioning",
   "timeCreated" : 1496415602152
 }`
@@ -59,6 +63,8 @@ func TestGetMetadata(t *testing.T) {
 					CompartmentID:       "ocid1.compartment.oc1..abc",
 					Region:              "phx",
 					CanonicalRegionName: "us-phoenix-1",
+					Shape:               "VM.Standard1.1",
+					ShapeConfig:         ShapeConfig{Ocpus: 1},
 				},
 				err: "",
 			},
@@ -50,8 +50,11 @@ const (
 	pathPollTimeout   = 3 * time.Minute
 	waitForPathDelay  = 1 * time.Second
 
-	LIST_PATHS_COMMAND  = "ls -f /dev/disk/by-path"
-	DISK_BY_PATH_FOLDER = "/dev/disk/by-path/"
+	LIST_PATHS_COMMAND   = "ls -f /dev/disk/by-path"
+	DISK_BY_PATH_FOLDER  = "/dev/disk/by-path/"
+	DEVICE_MAPPER_FOLDER = "/dev/mapper/"
+
+	procMountInfoPath = "/proc/self/mountinfo"
 )
 
 // ErrMountPointNotFound is returned when a given path does not appear to be
@@ -248,6 +251,56 @@ func GetDiskPathFromMountPath(logger *zap.SugaredLogger, mountPath string) ([]st
 	return diskByPaths, nil
 }
 
+// GetDiskPathFromBindDeviceFilePath returns the disk paths of the block device
+// that is bind mounted onto the given file. Raw block volumes are exposed as
+// bind mounts of the device node, for which /proc/mounts only reports devtmpfs
+// as the source, so the device is resolved from the mount root instead.
+func GetDiskPathFromBindDeviceFilePath(logger *zap.SugaredLogger, mountPath string) ([]string, error) {
+	mountInfos, err := mount.ParseMountInfo(procMountInfoPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, mountInfo := range mountInfos {
+		if mountInfo.MountPoint != mountPath {
+			continue
+		}
+		devicePath := filepath.Join("/dev", mountInfo.Root)
+		if strings.HasPrefix(filepath.Base(devicePath), "dm-") {
+			mapperPath, err := deviceMapperPathForDevice(devicePath)
+			if err != nil {
+				return nil, err
+			}
+			return []string{mapperPath}, nil
+		}
+		diskByPaths, err := diskByPathsForMountPoint(mount.MountPoint{Device: devicePath})
+		if err != nil {
+			return nil, err
+		}
+		logger.Infof("diskByPaths is %v", diskByPaths)
+		return diskByPaths, nil
+	}
+	return nil, ErrMountPointNotFound
+}
+
+// deviceMapperPathForDevice returns the /dev/mapper/ name of a device mapper
+// device (e.g. /dev/dm-3 -> /dev/mapper/mpatha).
+func deviceMapperPathForDevice(devicePath string) (string, error) {
+	mapperPaths, err := filepath.Glob(DEVICE_MAPPER_FOLDER + "*")
+	if err != nil {
+		return "", err
+	}
+	for _, mapperPath := range mapperPaths {
+		target, err := filepath.EvalSymlinks(mapperPath)
+		if err != nil {
+			continue
+		}
+		if target == devicePath {
+			return mapperPath, nil
+		}
+	}
+	return "", fmt.Errorf("device mapper path not found for %s", devicePath)
+}
+
 // getISCSIAdmPath gets the absolute path to the iscsiadm executable on the
 // $PATH.
 func (c *iSCSIMounter) getISCSIAdmPath() (string, error) {
@@ -0,0 +1,140 @@
+// Copyright 2024 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disk
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"go.uber.org/zap"
+	"k8s.io/mount-utils"
+)
+
+const (
+	cryptsetupCommand = "cryptsetup"
+
+	// LuksFsType is the type blkid reports for a LUKS formatted device.
+	LuksFsType = "crypto_LUKS"
+
+	luksMapperPrefix = "luks-"
+)
+
+// LuksMapperName returns the device mapper name used for the LUKS device of
+// the given volume. The unique part of the volume OCID keeps the name within
+// the device mapper name length limit.
+func LuksMapperName(volumeID string) string {
+	return luksMapperPrefix + volumeID[strings.LastIndex(volumeID, ".")+1:]
+}
+
+// LuksMapperPath returns the path of the LUKS device of the given volume.
+func LuksMapperPath(volumeID string) string {
+	return DEVICE_MAPPER_FOLDER + LuksMapperName(volumeID)
+}
+
+// LuksFormat formats the device as a LUKS2 device protected by the passphrase.
+// The passphrase is passed on stdin so that it never shows up in the process list.
+func LuksFormat(logger *zap.SugaredLogger, devicePath string, passphrase string) error {
+	logger.With("devicePath", devicePath).Info("Formatting device with LUKS.")
+	_, err := runCryptsetup(passphrase, "luksFormat", "--batch-mode", "--type", "luks2", "--key-file", "-", devicePath)
+	return err
+}
+
+// LuksOpen opens the LUKS device at devicePath as /dev/mapper/<mapperName>.
+// The volume key is kept in the dm-crypt table rather than the kernel keyring
+// so that the device can later be resized without the passphrase.
+func LuksOpen(logger *zap.SugaredLogger, devicePath string, mapperName string, passphrase string, readOnly bool) error {
+	if exists, err := mount.PathExists(DEVICE_MAPPER_FOLDER + mapperName); err != nil {
+		return err
+	} else if exists {
+		logger.With("mapperName", mapperName).Info("LUKS device is already open.")
+		return nil
+	}
+	args := []string{"open", "--type", "luks", "--disable-keyring", "--key-file", "-"}
+	if readOnly {
+		args = append(args, "--readonly")
+	}
+	args = append(args, devicePath, mapperName)
+	logger.With("devicePath", devicePath, "mapperName", mapperName).Info("Opening LUKS device.")
+	_, err := runCryptsetup(passphrase, args...)
+	return err
+}
+
+// LuksClose closes the LUKS device with the given mapper name if it is open.
+func LuksClose(logger *zap.SugaredLogger, mapperName string) error {
+	if exists, err := mount.PathExists(DEVICE_MAPPER_FOLDER + mapperName); err != nil {
+		return err
+	} else if !exists {
+		return nil
+	}
+	logger.With("mapperName", mapperName).Info("Closing LUKS device.")
+	_, err := runCryptsetup("", "close", mapperName)
+	return err
+}
+
+// LuksResize grows an open LUKS device to the size of its backing device.
+func LuksResize(logger *zap.SugaredLogger, mapperName string) error {
+	logger.With("mapperName", mapperName).Info("Resizing LUKS device.")
+	_, err := runCryptsetup("", "resize", mapperName)
+	return err
+}
+
+// GetDiskPathFromLuksDevice resolves the disk paths of the block device that
+// backs an open LUKS device.
+func GetDiskPathFromLuksDevice(logger *zap.SugaredLogger, mapperName string) ([]string, error) {
+	output, err := runCryptsetup("", "status", mapperName)
+	if err != nil {
+		return nil, err
+	}
+	devicePath, err := parseLuksBackingDevice(output)
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasPrefix(devicePath, DEVICE_MAPPER_FOLDER) {
+		return []string{devicePath}, nil
+	}
+	diskByPaths, err := diskByPathsForMountPoint(mount.MountPoint{Device: devicePath})
+	if err != nil {
+		return nil, err
+	}
+	logger.Infof("diskByPaths is %v", diskByPaths)
+	return diskByPaths, nil
+}
+
+// parseLuksBackingDevice returns the backing device reported by
+// `cryptsetup status`.
+func parseLuksBackingDevice(output string) (string, error) {
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[0] == "device:" {
+			return fields[1], nil
+		}
+	}
+	return "", fmt.Errorf("backing device not found in cryptsetup status output: %s", output)
+}
+
+func runCryptsetup(stdin string, args ...string) (string, error) {
+	cmd := exec.Command(cryptsetupCommand, args...)
+	if stdin != "" {
+		cmd.Stdin = strings.NewReader(stdin)
+	}
+	cmd.Env = append(os.Environ(), "LC_ALL=C")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("command failed: %v\ncommand: %s %s\nOutput: %s", err, cryptsetupCommand, args[0], string(output))
+	}
+	return string(output), nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2024 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disk
+
+import (
+	"testing"
+)
+
+func TestLuksMapperPath(t *testing.T) {
+	testCases := []struct {
+		name     string
+		volumeID string
+		expected string
+	}{
+		{
+			name:     "volume OCID",
+			volumeID: "ocid1.volume.oc1.phx.abyhqljrxyzexample",
+			expected: "/dev/mapper/luks-abyhqljrxyzexample",
+		},
+		{
+			name:     "volume ID without dots",
+			volumeID: "volume-1",
+			expected: "/dev/mapper/luks-volume-1",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LuksMapperPath(tt.volumeID); got != tt.expected {
+				t.Errorf("LuksMapperPath() = %q, expected %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseLuksBackingDevice(t *testing.T) {
+	testCases := []struct {
+		name     string
+		output   string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name: "paravirtualized device",
+			output: `/dev/mapper/luks-abc is active and is in use.
+  type:    LUKS2
+  cipher:  aes-xts-plain64
+  keysize: 512 bits
+  key location: dm-crypt
+  device:  /dev/sdb
+  sector size:  512
+  offset:  32768 sectors
+  size:    104824832 sectors
+  mode:    read/write
+`,
+			expected: "/dev/sdb",
+		},
+		{
+			name: "multipath device",
+			output: `/dev/mapper/luks-abc is active.
+  type:    LUKS2
+  device:  /dev/mapper/mpatha
+`,
+			expected: "/dev/mapper/mpatha",
+		},
+		{
+			name:    "no device",
+			output:  "/dev/mapper/luks-abc is inactive.\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseLuksBackingDevice(tt.output)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseLuksBackingDevice() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.expected {
+				t.Errorf("parseLuksBackingDevice() = %q, expected %q", got, tt.expected)
+			}
+		})
+	}
+}
@@ -72,6 +72,17 @@ func (c MockOCIFileStorageClient) ListExports(ctx context.Context, request files
 			}, nil
 		}
 	}
+	if *request.FileSystemId == "file-system-with-deleting-export" {
+		return filestorage.ListExportsResponse{
+			Items: []filestorage.ExportSummary{
+				{
+					Id:             common.String("export-deleting"),
+					Path:           common.String("/export-path"),
+					LifecycleState: filestorage.ExportSummaryLifecycleStateDeleting,
+				},
+			},
+		}, nil
+	}
 	return filestorage.ListExportsResponse{}, nil
 }
 
@@ -111,10 +111,88 @@ func (c *MockBlockStorageClient) GetVolumeBackup(ctx context.Context, id string)
 	}, nil
 }
 
+func (c *MockBlockStorageClient) GetVolumeBackupInRegion(ctx context.Context, id, region string) (*core.VolumeBackup, error) {
+	return &core.VolumeBackup{
+		Id: &id,
+	}, nil
+}
+
 func (c *MockBlockStorageClient) GetVolumeBackupsByName(ctx context.Context, snapshotName, compartmentID string) ([]core.VolumeBackup, error) {
 	return []core.VolumeBackup{}, nil
 }
 
+func (c *MockBlockStorageClient) ListVolumeBackups(ctx context.Context, compartmentID, volumeID string, limit int, page string) ([]core.VolumeBackup, string, error) {
+	return []core.VolumeBackup{}, "", nil
+}
+
+func (c *MockBlockStorageClient) CopyVolumeBackup(ctx context.Context, id, destinationRegion, displayName string) (*core.VolumeBackup, error) {
+	return &core.VolumeBackup{}, nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeBackupCopy(ctx context.Context, sourceVolumeBackup *core.VolumeBackup, region string) (*core.VolumeBackup, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeBackupPolicy(ctx context.Context, id string) (*core.VolumeBackupPolicy, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeBackupPoliciesByName(ctx context.Context, policyName, compartmentID string) ([]core.VolumeBackupPolicy, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) CreateVolumeBackupPolicyAssignment(ctx context.Context, volumeID, policyID string) (*core.VolumeBackupPolicyAssignment, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) DeleteVolumeBackupPolicyAssignment(ctx context.Context, id string) error {
+	return nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeBackupPolicyAssignments(ctx context.Context, volumeID string) ([]core.VolumeBackupPolicyAssignment, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) AwaitVolumeGroupAvailableOrTimeout(ctx context.Context, id string) (*core.VolumeGroup, error) {
+	return &core.VolumeGroup{}, nil
+}
+
+func (c *MockBlockStorageClient) CreateVolumeGroup(ctx context.Context, details core.CreateVolumeGroupDetails) (*core.VolumeGroup, error) {
+	return &core.VolumeGroup{}, nil
+}
+
+func (c *MockBlockStorageClient) DeleteVolumeGroup(ctx context.Context, id string) error {
+	return nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeGroup(ctx context.Context, id string) (*core.VolumeGroup, error) {
+	return &core.VolumeGroup{}, nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeGroupsByName(ctx context.Context, volumeGroupName, compartmentID string) ([]core.VolumeGroup, error) {
+	return []core.VolumeGroup{}, nil
+}
+
+func (c *MockBlockStorageClient) AwaitVolumeGroupBackupAvailableOrTimeout(ctx context.Context, id string) (*core.VolumeGroupBackup, error) {
+	return &core.VolumeGroupBackup{}, nil
+}
+
+func (c *MockBlockStorageClient) CreateVolumeGroupBackup(ctx context.Context, details core.CreateVolumeGroupBackupDetails) (*core.VolumeGroupBackup, error) {
+	return &core.VolumeGroupBackup{}, nil
+}
+
+func (c *MockBlockStorageClient) DeleteVolumeGroupBackup(ctx context.Context, id string) error {
+	return nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeGroupBackup(ctx context.Context, id string) (*core.VolumeGroupBackup, error) {
+	return &core.VolumeGroupBackup{}, nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeGroupBackupsByName(ctx context.Context, groupSnapshotName, compartmentID string) ([]core.VolumeGroupBackup, error) {
+	return []core.VolumeGroupBackup{}, nil
+}
+
 // MockFileStorageClient mocks FileStorage client implementation.
 type MockFileStorageClient struct{}
 
@@ -126,6 +204,42 @@ func (c *MockFileStorageClient) GetMountTargetSummaryByDisplayName(ctx context.C
 	return false, nil, nil
 }
 
+func (c *MockFileStorageClient) CreateSnapshot(ctx context.Context, details filestorage.CreateSnapshotDetails) (*filestorage.Snapshot, error) {
+	return nil, nil
+}
+
+func (c *MockFileStorageClient) GetSnapshot(ctx context.Context, id string) (*filestorage.Snapshot, error) {
+	return nil, nil
+}
+
+func (c *MockFileStorageClient) GetSnapshotsByName(ctx context.Context, fileSystemID, name string) ([]filestorage.SnapshotSummary, error) {
+	return nil, nil
+}
+
+func (c *MockFileStorageClient) ListSnapshots(ctx context.Context, fileSystemID string, limit int, page string) ([]filestorage.SnapshotSummary, string, error) {
+	return nil, "", nil
+}
+
+func (c *MockFileStorageClient) DeleteSnapshot(ctx context.Context, id string) error {
+	return nil
+}
+
+func (c *MockFileStorageClient) EnableQuotaRules(ctx context.Context, fileSystemID string) error {
+	return nil
+}
+
+func (c *MockFileStorageClient) CreateFileSystemQuotaRule(ctx context.Context, fileSystemID string, limitInGigabytes int) (*filestorage.QuotaRule, error) {
+	return &filestorage.QuotaRule{}, nil
+}
+
+func (c *MockFileStorageClient) GetFileSystemQuotaRule(ctx context.Context, fileSystemID string) (*filestorage.QuotaRuleSummary, error) {
+	return nil, nil
+}
+
+func (c *MockFileStorageClient) UpdateQuotaRule(ctx context.Context, fileSystemID, quotaRuleID string, limitInGigabytes int) (*filestorage.QuotaRule, error) {
+	return &filestorage.QuotaRule{}, nil
+}
+
 // CreateMountTarget mocks the FileStorage CreateMountTarget implementation
 func (c *MockFileStorageClient) CreateMountTarget(ctx context.Context, details filestorage.CreateMountTargetDetails) (*filestorage.MountTarget, error) {
 	return nil, nil
@@ -250,11 +364,11 @@ func (c *MockComputeClient) FindVolumeAttachment(ctx context.Context, compartmen
 	return nil, nil
 }
 
-func (c *MockComputeClient) AttachParavirtualizedVolume(ctx context.Context, instanceID, volumeID string, isPvEncryptionInTransitEnabled bool) (core.VolumeAttachment, error) {
+func (c *MockComputeClient) AttachParavirtualizedVolume(ctx context.Context, instanceID, volumeID string, isPvEncryptionInTransitEnabled, isReadOnly, isShareable bool) (core.VolumeAttachment, error) {
 	return nil, nil
 }
 
-func (c *MockComputeClient) AttachVolume(ctx context.Context, instanceID, volumeID string) (core.VolumeAttachment, error) {
+func (c *MockComputeClient) AttachVolume(ctx context.Context, instanceID, volumeID string, isReadOnly, isShareable bool) (core.VolumeAttachment, error) {
 	return nil, nil
 }
 
@@ -268,7 +382,11 @@ func (c *MockComputeClient) WaitForVolumeDetached(ctx context.Context, attachmen
 	return nil
 }
 
-func (c *MockComputeClient) FindActiveVolumeAttachment(ctx context.Context, compartmentID, volumeID string) (core.VolumeAttachment, error) {
+func (c *MockComputeClient) FindActiveVolumeAttachment(ctx context.Context, compartmentID, volumeID, instanceID string) (core.VolumeAttachment, error) {
+	return nil, nil
+}
+
+func (c *MockComputeClient) ListVolumeAttachments(ctx context.Context, compartmentID, volumeID string) ([]core.VolumeAttachment, error) {
 	return nil, nil
 }
 
@@ -325,6 +443,10 @@ func (c *MockVirtualNetworkClient) UpdateSecurityList(ctx context.Context, id st
 	return core.UpdateSecurityListResponse{}, nil
 }
 
+func (c *MockVirtualNetworkClient) ListIpv6s(ctx context.Context, vnicId string) ([]core.Ipv6, error) {
+	return nil, nil
+}
+
 func (c *MockVirtualNetworkClient) GetPublicIpByIpAddress(ctx context.Context, id string) (*core.PublicIp, error) {
 	return nil, nil
 }
@@ -422,6 +544,46 @@ func (c *MockLoadBalancerClient) CreateCertificate(ctx context.Context, lbID str
 	return "", nil
 }
 
+func (c *MockLoadBalancerClient) DeleteCertificate(ctx context.Context, lbID string, name string) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) CreateSSLCipherSuite(ctx context.Context, lbID string, suite *client.GenericSslCipherSuite) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) UpdateSSLCipherSuite(ctx context.Context, lbID string, suite *client.GenericSslCipherSuite) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) DeleteSSLCipherSuite(ctx context.Context, lbID string, name string) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) CreateRuleSet(ctx context.Context, lbID string, ruleSet *client.GenericRuleSet) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) UpdateRuleSet(ctx context.Context, lbID string, ruleSet *client.GenericRuleSet) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) DeleteRuleSet(ctx context.Context, lbID string, name string) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) CreateRoutingPolicy(ctx context.Context, lbID string, policy *client.GenericRoutingPolicy) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) UpdateRoutingPolicy(ctx context.Context, lbID string, policy *client.GenericRoutingPolicy) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) DeleteRoutingPolicy(ctx context.Context, lbID string, name string) (string, error) {
+	return "", nil
+}
+
 func (c *MockLoadBalancerClient) CreateBackendSet(ctx context.Context, lbID string, name string, details *client.GenericBackendSetDetails) (string, error) {
 	return "", nil
 }